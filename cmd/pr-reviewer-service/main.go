@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/common/api"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
@@ -17,6 +18,10 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		os.Exit(runReconcile(os.Args[2:]))
+	}
+
 	cfg := configs.MustLoad()
 
 	l, err := logger.New(cfg.Logger)
@@ -34,9 +39,15 @@ func main() {
 	}
 
 	r := repository.New(store)
-	s := service.New(r, &cfg.BussinesLogic)
+	s := service.New(r, &cfg.BussinesLogic, l)
 	t := transport.New(s, l)
 
+	deliveries := make(chan struct{})
+	go func() {
+		defer close(deliveries)
+		s.RunDeliveries(rCtx)
+	}()
+
 	srv := server.New(&cfg.Servers)
 	srv.REST().HTTPErrorHandler = resttransport.HTTPErrorHandler
 	api.RegisterRoutes(srv, t, cfg.Servers.REST.HealthCheckRoute)
@@ -52,6 +63,8 @@ func main() {
 	if err := srv.GracefulShutdown(server.ShutdownNoTimeout); err != nil {
 		l.Errorw("error during server shutdown", "cause", err)
 	}
+	// attempts in flight are recorded before the store is closed
+	<-deliveries
 	if err := store.GracefulShutdown(); err != nil {
 		l.Errorw("error disconnect store", "cause", err)
 	}
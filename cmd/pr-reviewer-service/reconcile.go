@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/logger"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/storage"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/repository"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service"
+	clitransport "github.com/eragon-mdi/pr-reviewer-service/internal/transport/cli"
+
+	rootctx "github.com/eragon-mdi/go-playground/server/root-ctx"
+)
+
+// runReconcile is the reconcile subcommand, it uses the configuration and storage of the server.
+// Events of the applied plan are logged as pending deliveries, the server sends them.
+func runReconcile(args []string) int {
+	cfg := configs.MustLoad()
+
+	l, err := logger.New(cfg.Logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "reconcile: failed to set logger: %v\n", err)
+		return clitransport.ExitError
+	}
+
+	rCtx, cancelAppCtx := rootctx.NotifyBackgroundCtxToShutdownSignal()
+	defer cancelAppCtx()
+
+	store, err := storage.Conn(rCtx, &cfg.Storages, storage.ConnTimeoutDefault)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "reconcile: failed to connect storage: %v\n", err)
+		return clitransport.ExitError
+	}
+	defer store.GracefulShutdown()
+
+	s := service.New(repository.New(store), &cfg.BussinesLogic, l)
+	return clitransport.Reconcile(rCtx, s, args, os.Stdout, os.Stderr)
+}
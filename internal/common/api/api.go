@@ -11,22 +11,67 @@ type Transport interface {
 	TeamTransport
 	UserTransport
 	PullRequestTransport
+	StatsTransport
+	WebhookTransport
+	SubscriptionTransport
 }
 
 type TeamTransport interface {
 	AddTeam(echo.Context) error
 	GetTeamByName(echo.Context) error
+	SetTeamReviewCapacity(echo.Context) error
+	SetTeamRequiredReviewers(echo.Context) error
+	SetTeamMergePolicy(echo.Context) error
+	DeactivateTeam(echo.Context) error
+	GetTeamFairness(echo.Context) error
+	AddTeamMembers(echo.Context) error
+	RemoveTeamMember(echo.Context) error
+	RenameTeam(echo.Context) error
+	DeleteTeam(echo.Context) error
+	ReconcileTeams(echo.Context) error
 }
 
 type UserTransport interface {
 	UserSetIsActive(echo.Context) error
 	GetUserPeviewsById(echo.Context) error
+	UserSetReviewCapacity(echo.Context) error
+	UserSetPrimaryTeam(echo.Context) error
+	GetUser(echo.Context) error
+	ListUsers(echo.Context) error
+	UpdateUser(echo.Context) error
+	UserLinkIdentity(echo.Context) error
+	UserUnlinkIdentity(echo.Context) error
+	GetUserIdentities(echo.Context) error
 }
 
 type PullRequestTransport interface {
 	CreatePullRequest(echo.Context) error
 	MergePullRequest(echo.Context) error
 	ReassignUserForPullRequest(echo.Context) error
+	ReviewPullRequest(echo.Context) error
+	GetPullRequestHistory(echo.Context) error
+	GetPullRequest(echo.Context) error
+	ListPullRequests(echo.Context) error
+	ReadyPullRequest(echo.Context) error
+	ClosePullRequest(echo.Context) error
+	ReopenPullRequest(echo.Context) error
+}
+
+type StatsTransport interface {
+	GetAssignmentStats(echo.Context) error
+}
+
+type WebhookTransport interface {
+	GithubWebhook(echo.Context) error
+	GitlabWebhook(echo.Context) error
+}
+
+type SubscriptionTransport interface {
+	CreateSubscription(echo.Context) error
+	ListSubscriptions(echo.Context) error
+	DeleteSubscription(echo.Context) error
+	GetSubscriptionDeliveries(echo.Context) error
+	RedeliverWebhook(echo.Context) error
 }
 
 func RegisterRoutes(s server.Server, t Transport, healthCheckRoute string) {
@@ -35,15 +80,55 @@ func RegisterRoutes(s server.Server, t Transport, healthCheckRoute string) {
 	teams := s.REST().Group("/teams")
 	teams.POST("/add", t.AddTeam)
 	teams.GET("/get/:team_name", t.GetTeamByName)
+	teams.POST("/setReviewCapacity", t.SetTeamReviewCapacity)
+	teams.POST("/setRequiredReviewers", t.SetTeamRequiredReviewers)
+	teams.POST("/setMergePolicy", t.SetTeamMergePolicy)
+	teams.POST("/deactivate", t.DeactivateTeam)
+	teams.GET("/:team_name/fairness", t.GetTeamFairness)
+	teams.POST("/addMembers", t.AddTeamMembers)
+	teams.POST("/removeMember", t.RemoveTeamMember)
+	teams.POST("/rename", t.RenameTeam)
+	teams.POST("/delete", t.DeleteTeam)
 
 	users := s.REST().Group("/users")
 	users.POST("/setIsActive", t.UserSetIsActive)
 	users.GET("/getReview/:id", t.GetUserPeviewsById)
+	users.POST("/setReviewCapacity", t.UserSetReviewCapacity)
+	users.POST("/setPrimaryTeam", t.UserSetPrimaryTeam)
+	users.POST("/linkIdentity", t.UserLinkIdentity)
+	users.POST("/unlinkIdentity", t.UserUnlinkIdentity)
+	users.GET("", t.ListUsers)
+	users.GET("/:id", t.GetUser)
+	users.PATCH("/:id", t.UpdateUser)
+	users.GET("/:id/identities", t.GetUserIdentities)
 
 	pullRequest := s.REST().Group("/pullRequest")
 	pullRequest.POST("/create", t.CreatePullRequest)
 	pullRequest.POST("/merge", t.MergePullRequest)
 	pullRequest.POST("/reassign", t.ReassignUserForPullRequest)
+	pullRequest.POST("/review", t.ReviewPullRequest)
+	pullRequest.POST("/ready", t.ReadyPullRequest)
+	pullRequest.POST("/close", t.ClosePullRequest)
+	pullRequest.POST("/reopen", t.ReopenPullRequest)
+	pullRequest.GET("/:id", t.GetPullRequest)
+	pullRequest.GET("/:id/history", t.GetPullRequestHistory)
+
+	s.REST().GET("/pullRequests", t.ListPullRequests)
+
+	admin := s.REST().Group("/admin")
+	admin.POST("/reconcile", t.ReconcileTeams)
+
+	stats := s.REST().Group("/stats")
+	stats.GET("/assignments", t.GetAssignmentStats)
+
+	webhooks := s.REST().Group("/webhooks")
+	webhooks.POST("/github", t.GithubWebhook)
+	webhooks.POST("/gitlab", t.GitlabWebhook)
+	webhooks.POST("/subscriptions", t.CreateSubscription)
+	webhooks.GET("/subscriptions", t.ListSubscriptions)
+	webhooks.DELETE("/subscriptions/:id", t.DeleteSubscription)
+	webhooks.GET("/subscriptions/:id/deliveries", t.GetSubscriptionDeliveries)
+	webhooks.POST("/deliveries/:id/redeliver", t.RedeliverWebhook)
 }
 
 func healthCheck(c echo.Context) error {
@@ -64,4 +64,24 @@ type BussinesLogic struct {
 	AllowedReuseToReasign   bool     `envconfig:"ALLOWED_REUSE_TO_REASIGN" default:"false"`
 	AlloweStatusesToReasign []string `envconfig:"ALLOWE_STATUSES_TO_REASIGN"`
 	AllowedRolesToReasign   []string `envconfig:"ALLOWED_ROLES_TO_REASIGN"`
+	ReviewerSelector        string   `envconfig:"REVIEWER_SELECTOR" default:"least_loaded"`
+
+	MergeMinApprovals            int  `envconfig:"MERGE_MIN_APPROVALS" default:"0"`
+	MergeBlockOnChangesRequested bool `envconfig:"MERGE_BLOCK_ON_CHANGES_REQUESTED" default:"true"`
+	MergeRequireActiveReviewers  bool `envconfig:"MERGE_REQUIRE_ACTIVE_REVIEWERS" default:"false"`
+
+	DeactivationFallbackTeam string `envconfig:"DEACTIVATION_FALLBACK_TEAM"`
+	ReassignOnDeactivate     bool   `envconfig:"REASSIGN_ON_DEACTIVATE" default:"false"`
+
+	FairnessWindowDays int     `envconfig:"FAIRNESS_WINDOW_DAYS" default:"30"`
+	FairnessTolerance  float64 `envconfig:"FAIRNESS_TOLERANCE" default:"0.5"`
+
+	GithubWebhookSecret string `envconfig:"GITHUB_WEBHOOK_SECRET"`
+	GitlabWebhookToken  string `envconfig:"GITLAB_WEBHOOK_TOKEN"`
+
+	SubscriptionMaxAttempts  int           `envconfig:"SUBSCRIPTION_MAX_ATTEMPTS" default:"5"`
+	SubscriptionBackoff      time.Duration `envconfig:"SUBSCRIPTION_BACKOFF" default:"1s"`
+	SubscriptionTimeout      time.Duration `envconfig:"SUBSCRIPTION_TIMEOUT" default:"5s"`
+	SubscriptionPollInterval time.Duration `envconfig:"SUBSCRIPTION_POLL_INTERVAL" default:"1s"`
+	SubscriptionAllowPrivate bool          `envconfig:"SUBSCRIPTION_ALLOW_PRIVATE_NETWORKS" default:"false"`
 }
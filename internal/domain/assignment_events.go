@@ -0,0 +1,59 @@
+package domain
+
+import "time"
+
+type AssignmentEventType string
+
+const (
+	AssignmentEventAssigned   AssignmentEventType = "assigned"
+	AssignmentEventUnassigned AssignmentEventType = "unassigned"
+	AssignmentEventReassigned AssignmentEventType = "reassigned"
+)
+
+// Actors and reasons recorded when the caller does not provide its own.
+const (
+	ActorSystem    = "system"
+	ActorAnonymous = "anonymous"
+
+	AssignmentReasonPrCreated         = "pr_created"
+	AssignmentReasonManual            = "manual"
+	AssignmentReasonTeamDeactivated   = "team_deactivated"
+	AssignmentReasonMemberDeactivated = "member_deactivated"
+	AssignmentReasonPrReady           = "pr_ready"
+	AssignmentReasonPrClosed          = "pr_closed"
+	AssignmentReasonPrReopened        = "pr_reopened"
+	AssignmentReasonMemberRemoved     = "member_removed"
+	AssignmentReasonTeamDeleted       = "team_deleted"
+)
+
+// AssignmentAudit tells who changed the reviewers and why.
+type AssignmentAudit struct {
+	Actor  string
+	Reason string
+}
+
+func SystemAudit(reason string) AssignmentAudit {
+	return AssignmentAudit{Actor: ActorSystem, Reason: reason}
+}
+
+func (a AssignmentAudit) WithDefaults(actor, reason string) AssignmentAudit {
+	if a.Actor == "" {
+		a.Actor = actor
+	}
+	if a.Reason == "" {
+		a.Reason = reason
+	}
+	return a
+}
+
+// AssignmentEvent is an audit record of a reviewer change on a PR,
+// OldMemberId is empty for assignments and NewMemberId for unassignments.
+type AssignmentEvent struct {
+	Type        AssignmentEventType
+	OldMemberId MemberId
+	NewMemberId MemberId
+	AssignmentAudit
+	CreatedAt time.Time
+}
+
+type AssignmentEvents []AssignmentEvent
@@ -0,0 +1,40 @@
+package domain
+
+// ReviewCapacity is a maximum of concurrent open reviews, zero value means unlimited.
+type ReviewCapacity struct {
+	limit   int
+	limited bool
+}
+
+func NewReviewCapacity(limit int) ReviewCapacity {
+	if limit < 0 {
+		limit = 0
+	}
+	return ReviewCapacity{
+		limit:   limit,
+		limited: true,
+	}
+}
+
+func UnlimitedReviewCapacity() ReviewCapacity {
+	return ReviewCapacity{}
+}
+
+func (c ReviewCapacity) Limit() (int, bool) {
+	return c.limit, c.limited
+}
+
+func (c ReviewCapacity) IsLimited() bool {
+	return c.limited
+}
+
+func (c ReviewCapacity) Allows(load int) bool {
+	return !c.limited || load < c.limit
+}
+
+func (c ReviewCapacity) Or(fallback ReviewCapacity) ReviewCapacity {
+	if c.limited {
+		return c
+	}
+	return fallback
+}
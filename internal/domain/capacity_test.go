@@ -0,0 +1,58 @@
+package domain
+
+import "testing"
+
+func TestReviewCapacity_Allows(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity ReviewCapacity
+		load     int
+		want     bool
+	}{
+		{name: "unlimited zero value", capacity: ReviewCapacity{}, load: 100, want: true},
+		{name: "unlimited constructor", capacity: UnlimitedReviewCapacity(), load: 100, want: true},
+		{name: "below limit", capacity: NewReviewCapacity(3), load: 2, want: true},
+		{name: "at limit", capacity: NewReviewCapacity(3), load: 3, want: false},
+		{name: "above limit", capacity: NewReviewCapacity(3), load: 5, want: false},
+		{name: "zero limit", capacity: NewReviewCapacity(0), load: 0, want: false},
+		{name: "negative limit clamps to zero", capacity: NewReviewCapacity(-1), load: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.capacity.Allows(tt.load); got != tt.want {
+				t.Errorf("ReviewCapacity.Allows(%d) = %v, want %v", tt.load, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviewCapacity_Or(t *testing.T) {
+	teamDefault := NewReviewCapacity(4)
+
+	if got := NewReviewCapacity(2).Or(teamDefault); got != NewReviewCapacity(2) {
+		t.Errorf("ReviewCapacity.Or() = %v, want member capacity", got)
+	}
+	if got := UnlimitedReviewCapacity().Or(teamDefault); got != teamDefault {
+		t.Errorf("ReviewCapacity.Or() = %v, want fallback capacity", got)
+	}
+	if got := UnlimitedReviewCapacity().Or(UnlimitedReviewCapacity()); got.IsLimited() {
+		t.Errorf("ReviewCapacity.Or() = %v, want unlimited", got)
+	}
+}
+
+func TestMembersHistories_WithFreeCapacity(t *testing.T) {
+	mh := MembersHistories{
+		NewMemberHistory("free", MemberStatusActive, MemberRoleDefault, false).WithLoad(1).WithCapacity(NewReviewCapacity(2)),
+		NewMemberHistory("full", MemberStatusActive, MemberRoleDefault, false).WithLoad(2).WithCapacity(NewReviewCapacity(2)),
+		NewMemberHistory("unlimited", MemberStatusActive, MemberRoleDefault, false).WithLoad(10),
+	}
+
+	got := mh.WithFreeCapacity()
+	if len(got) != 2 {
+		t.Fatalf("MembersHistories.WithFreeCapacity() length = %v, want 2", len(got))
+	}
+	if got[0].Id != "free" || got[1].Id != "unlimited" {
+		t.Errorf("MembersHistories.WithFreeCapacity() = %v, want free and unlimited", got)
+	}
+}
@@ -10,4 +10,12 @@ var (
 	ErrInternal   = errors.New("internal service err. try again later")
 	ErrConflict   = errors.New("business conflict")
 	ErrForbidden  = errors.New("err forbidden")
+
+	ErrCapacityExceeded = errors.New("all candidates are at review capacity")
+	ErrMergeBlocked     = errors.New("merge blocked by policy")
+	ErrInvalidPrState   = errors.New("not allowed in the current pull request status")
+	ErrVersionMismatch  = errors.New("pull request version mismatch")
+	ErrOpenReviews      = errors.New("leaving members still review open pull requests of the team")
+	ErrUnknownIdentity  = errors.New("external identity is not linked to a member")
+	ErrBadSignature     = errors.New("webhook signature mismatch")
 )
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ExternalPrKey identifies a pull request within a VCS, written as provider:repository#number,
+// e.g. github:acme/api#42. Repository is matched case-insensitively, a key belongs to one pull request only.
+type ExternalPrKey struct {
+	Provider   IdentityProvider
+	Repository string
+	Number     int
+}
+
+// PrRef points at a pull request in API requests: its UUID or its external key.
+type PrRef string
+
+// externalPrNamespace keeps ids of external pull requests apart from other UUIDv5 names.
+var externalPrNamespace = uuid.MustParse("5b0c7a52-3f0e-4d7c-9a43-2d1b6f1e8c61")
+
+// ExternalPrId derives the PR id from the provider, repository and number,
+// so every delivery about the same pull request lands on the same id.
+func ExternalPrId(provider IdentityProvider, repository string, number int) PrId {
+	name := provider.String() + ":" + strings.ToLower(repository) + "#" + strconv.Itoa(number)
+	return PrId(uuid.NewSHA1(externalPrNamespace, []byte(name)).String())
+}
+
+func NewExternalPrKey(provider IdentityProvider, repository string, number int) ExternalPrKey {
+	return ExternalPrKey{Provider: provider, Repository: strings.TrimSpace(repository), Number: number}
+}
+
+// ParseExternalPrKey reads provider:repository#number, the repository may itself contain # and :.
+func ParseExternalPrKey(s string) (ExternalPrKey, bool) {
+	provider, rest, ok := strings.Cut(s, ":")
+	if !ok {
+		return ExternalPrKey{}, false
+	}
+	i := strings.LastIndex(rest, "#")
+	if i < 0 {
+		return ExternalPrKey{}, false
+	}
+	number, err := strconv.Atoi(rest[i+1:])
+	if err != nil {
+		return ExternalPrKey{}, false
+	}
+
+	key := NewExternalPrKey(IdentityProvider(provider), rest[:i], number)
+	return key, key.Valid()
+}
+
+func (k ExternalPrKey) Valid() bool {
+	return k.Provider.IsValid() && k.Repository != "" && len(k.Repository) <= 255 && k.Number > 0
+}
+
+func (k ExternalPrKey) Empty() bool {
+	return k == ExternalPrKey{}
+}
+
+func (k ExternalPrKey) String() string {
+	if k.Empty() {
+		return ""
+	}
+	return k.Provider.String() + ":" + k.Repository + "#" + strconv.Itoa(k.Number)
+}
+
+// PrId is the internal id of a pull request created under the key.
+func (k ExternalPrKey) PrId() PrId {
+	return ExternalPrId(k.Provider, k.Repository, k.Number)
+}
+
+// PrId returns the referenced id when the reference is a UUID.
+func (r PrRef) PrId() (PrId, bool) {
+	id := PrId(r)
+	return id, id.IsValid()
+}
+
+// ExternalKey parses a provider:repository#number reference.
+func (r PrRef) ExternalKey() (ExternalPrKey, bool) {
+	return ParseExternalPrKey(string(r))
+}
+
+func (r PrRef) Valid() bool {
+	if _, ok := r.PrId(); ok {
+		return true
+	}
+	_, ok := r.ExternalKey()
+	return ok
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestExternalPrId(t *testing.T) {
+	id := ExternalPrId(ProviderGithub, "acme/api", 42)
+
+	if uuid.Validate(id.String()) != nil {
+		t.Fatalf("ExternalPrId() = %v, want a valid uuid", id)
+	}
+	if again := ExternalPrId(ProviderGithub, "ACME/api", 42); again != id {
+		t.Errorf("ExternalPrId() = %v, want %v for the same repository in another case", again, id)
+	}
+	if other := ExternalPrId(ProviderGithub, "acme/api", 43); other == id {
+		t.Errorf("ExternalPrId() = %v for another number, want a different id", other)
+	}
+	if other := ExternalPrId(ProviderGitlab, "acme/api", 42); other == id {
+		t.Errorf("ExternalPrId() = %v for another provider, want a different id", other)
+	}
+}
+
+func TestParseExternalPrKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     string
+		want   ExternalPrKey
+		wantOk bool
+	}{
+		{
+			name:   "github",
+			in:     "github:acme/api#42",
+			want:   ExternalPrKey{Provider: ProviderGithub, Repository: "acme/api", Number: 42},
+			wantOk: true,
+		},
+		{
+			name:   "number after the last hash",
+			in:     "gitlab:group/c#sharp#7",
+			want:   ExternalPrKey{Provider: ProviderGitlab, Repository: "group/c#sharp", Number: 7},
+			wantOk: true,
+		},
+		{name: "uuid", in: uuid.New().String()},
+		{name: "no number", in: "github:acme/api"},
+		{name: "zero number", in: "github:acme/api#0"},
+		{name: "not a number", in: "github:acme/api#abc"},
+		{name: "no repository", in: "github: #1"},
+		{name: "bad provider", in: "Git Hub:acme/api#1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseExternalPrKey(tt.in)
+			if ok != tt.wantOk {
+				t.Fatalf("ParseExternalPrKey(%q) ok = %v, want %v", tt.in, ok, tt.wantOk)
+			}
+			if ok && got != tt.want {
+				t.Errorf("ParseExternalPrKey(%q) = %+v, want %+v", tt.in, got, tt.want)
+			}
+			if ok && got.String() != tt.in {
+				t.Errorf("ExternalPrKey.String() = %q, want %q", got.String(), tt.in)
+			}
+		})
+	}
+}
+
+func TestPrRef(t *testing.T) {
+	id := PrId(uuid.New().String())
+
+	if got, ok := PrRef(id).PrId(); !ok || got != id {
+		t.Errorf("PrRef.PrId() = %v, %v, want %v", got, ok, id)
+	}
+	if _, ok := PrRef(id).ExternalKey(); ok {
+		t.Errorf("PrRef.ExternalKey() ok for a uuid")
+	}
+
+	ref := PrRef("github:acme/api#42")
+	if _, ok := ref.PrId(); ok {
+		t.Errorf("PrRef.PrId() ok for an external key")
+	}
+	key, ok := ref.ExternalKey()
+	if !ok || key.PrId() != ExternalPrId(ProviderGithub, "acme/api", 42) {
+		t.Errorf("PrRef.ExternalKey() = %+v, %v", key, ok)
+	}
+
+	for _, ref := range []PrRef{"", "pr-1", "github:acme/api"} {
+		if ref.Valid() {
+			t.Errorf("PrRef(%q).Valid() = true, want false", ref)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+type LoadFlag string
+
+const (
+	LoadFlagNone  LoadFlag = ""
+	LoadFlagOver  LoadFlag = "overloaded"
+	LoadFlagUnder LoadFlag = "underloaded"
+)
+
+// MemberLoad is the number of reviews assigned to an active team member within the window.
+type MemberLoad struct {
+	MemberId MemberId
+	Name     string
+	Load     int
+	Flag     LoadFlag
+}
+
+type FairnessReport struct {
+	Team    TeamName
+	Since   time.Time
+	Members []MemberLoad
+	Min     int
+	Max     int
+	Mean    float64
+	StdDev  float64
+	Gini    float64
+}
+
+// NewFairnessReport flags members whose load differs from the team mean by more than tolerance (a share of the mean).
+func NewFairnessReport(team TeamName, since time.Time, loads []MemberLoad, tolerance float64) FairnessReport {
+	report := FairnessReport{
+		Team:    team,
+		Since:   since,
+		Members: make([]MemberLoad, 0, len(loads)),
+	}
+	if len(loads) == 0 {
+		return report
+	}
+
+	n := float64(len(loads))
+	sorted := make([]int, 0, len(loads))
+	sum := 0
+	report.Min, report.Max = loads[0].Load, loads[0].Load
+	for _, l := range loads {
+		sum += l.Load
+		report.Min = min(report.Min, l.Load)
+		report.Max = max(report.Max, l.Load)
+		sorted = append(sorted, l.Load)
+	}
+	report.Mean = float64(sum) / n
+
+	variance := 0.0
+	for _, l := range loads {
+		d := float64(l.Load) - report.Mean
+		variance += d * d
+	}
+	report.StdDev = math.Sqrt(variance / n)
+
+	if sum > 0 {
+		sort.Ints(sorted)
+		weighted := 0.0
+		for i, x := range sorted {
+			weighted += float64(i+1) * float64(x)
+		}
+		report.Gini = 2*weighted/(n*float64(sum)) - (n+1)/n
+	}
+
+	for _, l := range loads {
+		l.Flag = LoadFlagNone
+		switch {
+		case report.Mean == 0:
+		case float64(l.Load) > report.Mean*(1+tolerance):
+			l.Flag = LoadFlagOver
+		case float64(l.Load) < report.Mean*(1-tolerance):
+			l.Flag = LoadFlagUnder
+		}
+		report.Members = append(report.Members, l)
+	}
+
+	return report
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewFairnessReport(t *testing.T) {
+	since := time.Now()
+
+	tests := []struct {
+		name       string
+		loads      []int
+		tolerance  float64
+		wantMin    int
+		wantMax    int
+		wantMean   float64
+		wantStdDev float64
+		wantGini   float64
+		wantFlags  []LoadFlag
+	}{
+		{
+			name:      "empty team",
+			loads:     nil,
+			wantFlags: []LoadFlag{},
+		},
+		{
+			name:      "no reviews",
+			loads:     []int{0, 0, 0},
+			tolerance: 0.5,
+			wantFlags: []LoadFlag{LoadFlagNone, LoadFlagNone, LoadFlagNone},
+		},
+		{
+			name:      "equal load",
+			loads:     []int{2, 2, 2, 2},
+			tolerance: 0.5,
+			wantMin:   2,
+			wantMax:   2,
+			wantMean:  2,
+			wantFlags: []LoadFlag{LoadFlagNone, LoadFlagNone, LoadFlagNone, LoadFlagNone},
+		},
+		{
+			name:       "single member takes everything",
+			loads:      []int{0, 0, 0, 8},
+			tolerance:  0.5,
+			wantMax:    8,
+			wantMean:   2,
+			wantStdDev: math.Sqrt(12),
+			wantGini:   0.75,
+			wantFlags:  []LoadFlag{LoadFlagUnder, LoadFlagUnder, LoadFlagUnder, LoadFlagOver},
+		},
+		{
+			name:       "within tolerance",
+			loads:      []int{3, 4, 5},
+			tolerance:  0.5,
+			wantMin:    3,
+			wantMax:    5,
+			wantMean:   4,
+			wantStdDev: math.Sqrt(2.0 / 3.0),
+			wantGini:   1.0 / 9.0,
+			wantFlags:  []LoadFlag{LoadFlagNone, LoadFlagNone, LoadFlagNone},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loads := make([]MemberLoad, 0, len(tt.loads))
+			for _, l := range tt.loads {
+				loads = append(loads, MemberLoad{Load: l})
+			}
+
+			got := NewFairnessReport("backend", since, loads, tt.tolerance)
+
+			if got.Min != tt.wantMin || got.Max != tt.wantMax {
+				t.Errorf("min/max = %v/%v, want %v/%v", got.Min, got.Max, tt.wantMin, tt.wantMax)
+			}
+			if !almostEqual(got.Mean, tt.wantMean) {
+				t.Errorf("Mean = %v, want %v", got.Mean, tt.wantMean)
+			}
+			if !almostEqual(got.StdDev, tt.wantStdDev) {
+				t.Errorf("StdDev = %v, want %v", got.StdDev, tt.wantStdDev)
+			}
+			if !almostEqual(got.Gini, tt.wantGini) {
+				t.Errorf("Gini = %v, want %v", got.Gini, tt.wantGini)
+			}
+			if len(got.Members) != len(tt.wantFlags) {
+				t.Fatalf("len(Members) = %v, want %v", len(got.Members), len(tt.wantFlags))
+			}
+			for i, m := range got.Members {
+				if m.Flag != tt.wantFlags[i] {
+					t.Errorf("Members[%d].Flag = %q, want %q", i, m.Flag, tt.wantFlags[i])
+				}
+			}
+		})
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
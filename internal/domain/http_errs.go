@@ -5,18 +5,27 @@ import "net/http"
 type ErrorCode string
 
 const (
-	CodeTeamExists  ErrorCode = "TEAM_EXISTS"
-	CodePRExists    ErrorCode = "PR_EXISTS"
-	CodePRMerged    ErrorCode = "PR_MERGED"
-	CodeNotAssigned ErrorCode = "NOT_ASSIGNED"
-	CodeNoCandidate ErrorCode = "NO_CANDIDATE"
-	CodeNotFound    ErrorCode = "NOT_FOUND"
+	CodeTeamExists      ErrorCode = "TEAM_EXISTS"
+	CodePRExists        ErrorCode = "PR_EXISTS"
+	CodePRMerged        ErrorCode = "PR_MERGED"
+	CodeNotAssigned     ErrorCode = "NOT_ASSIGNED"
+	CodeNoCandidate     ErrorCode = "NO_CANDIDATE"
+	CodeNoCapacity      ErrorCode = "NO_CAPACITY"
+	CodeMergeBlocked    ErrorCode = "MERGE_BLOCKED"
+	CodeNotFound        ErrorCode = "NOT_FOUND"
+	CodePRState         ErrorCode = "PR_INVALID_STATE"
+	CodePRVersion       ErrorCode = "PR_VERSION_MISMATCH"
+	CodeOpenReviews     ErrorCode = "OPEN_REVIEWS"
+	CodeUnknownIdentity ErrorCode = "UNKNOWN_IDENTITY"
+	CodeBadSignature    ErrorCode = "BAD_SIGNATURE"
+	CodeIdentityExists  ErrorCode = "IDENTITY_EXISTS"
 )
 
 type CustomHttpError struct {
 	HttpCode int
 	Code     ErrorCode `json:"code"`
 	Message  string    `json:"message"`
+	Details  []string  `json:"details,omitempty"`
 }
 
 func (e *CustomHttpError) Error() string {
@@ -43,6 +52,10 @@ func HttpErrPRMerged() *CustomHttpError {
 	return NewCustomHttpError(http.StatusConflict, CodePRMerged, "cannot reassign on merged PR")
 }
 
+func HttpErrReviewOnMerged() *CustomHttpError {
+	return NewCustomHttpError(http.StatusConflict, CodePRMerged, "cannot review merged PR")
+}
+
 func HttpErrNotAssigned() *CustomHttpError {
 	return NewCustomHttpError(http.StatusConflict, CodeNotAssigned, "reviewer is not assigned to this PR")
 }
@@ -51,6 +64,42 @@ func HttpErrNoCandidate() *CustomHttpError {
 	return NewCustomHttpError(http.StatusConflict, CodeNoCandidate, "no active replacement candidate in team")
 }
 
+func HttpErrNoCapacity() *CustomHttpError {
+	return NewCustomHttpError(http.StatusConflict, CodeNoCapacity, "all candidates in team are at review capacity")
+}
+
+func HttpErrMergeBlocked(unmet []string) *CustomHttpError {
+	err := NewCustomHttpError(http.StatusConflict, CodeMergeBlocked, "merge policy conditions are not met")
+	err.Details = unmet
+	return err
+}
+
 func HttpErrNotFound() *CustomHttpError {
 	return NewCustomHttpError(http.StatusNotFound, CodeNotFound, "resource not found")
 }
+
+func HttpErrPRState() *CustomHttpError {
+	return NewCustomHttpError(http.StatusConflict, CodePRState, "operation is not allowed in the current PR status")
+}
+
+func HttpErrPRVersion() *CustomHttpError {
+	return NewCustomHttpError(http.StatusPreconditionFailed, CodePRVersion, "PR was changed, refetch it and retry")
+}
+
+func HttpErrOpenReviews() *CustomHttpError {
+	return NewCustomHttpError(http.StatusConflict, CodeOpenReviews, "team members still review OPEN PRs of the team")
+}
+
+func HttpErrUnknownIdentity(identity string) *CustomHttpError {
+	err := NewCustomHttpError(http.StatusUnprocessableEntity, CodeUnknownIdentity, "external account is not linked to a user")
+	err.Details = []string{identity}
+	return err
+}
+
+func HttpErrBadSignature() *CustomHttpError {
+	return NewCustomHttpError(http.StatusUnauthorized, CodeBadSignature, "webhook signature is missing or invalid")
+}
+
+func HttpErrIdentityExists() *CustomHttpError {
+	return NewCustomHttpError(http.StatusConflict, CodeIdentityExists, "external account is linked to another user")
+}
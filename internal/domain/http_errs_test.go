@@ -26,6 +26,11 @@ func TestCustomHttpError_Error(t *testing.T) {
 			err:  HttpErrPRMerged(),
 			want: "PR_MERGED: cannot reassign on merged PR",
 		},
+		{
+			name: "review on merged error",
+			err:  HttpErrReviewOnMerged(),
+			want: "PR_MERGED: cannot review merged PR",
+		},
 		{
 			name: "not assigned error",
 			err:  HttpErrNotAssigned(),
@@ -36,11 +41,46 @@ func TestCustomHttpError_Error(t *testing.T) {
 			err:  HttpErrNoCandidate(),
 			want: "NO_CANDIDATE: no active replacement candidate in team",
 		},
+		{
+			name: "no capacity error",
+			err:  HttpErrNoCapacity(),
+			want: "NO_CAPACITY: all candidates in team are at review capacity",
+		},
+		{
+			name: "merge blocked error",
+			err:  HttpErrMergeBlocked([]string{"approvals: 0 of 1 required"}),
+			want: "MERGE_BLOCKED: merge policy conditions are not met",
+		},
 		{
 			name: "not found error",
 			err:  HttpErrNotFound(),
 			want: "NOT_FOUND: resource not found",
 		},
+		{
+			name: "pr state error",
+			err:  HttpErrPRState(),
+			want: "PR_INVALID_STATE: operation is not allowed in the current PR status",
+		},
+		{
+			name: "pr version error",
+			err:  HttpErrPRVersion(),
+			want: "PR_VERSION_MISMATCH: PR was changed, refetch it and retry",
+		},
+		{
+			name: "open reviews error",
+			err:  HttpErrOpenReviews(),
+			want: "OPEN_REVIEWS: team members still review OPEN PRs of the team",
+		},
+		{
+			name: "bad signature error",
+			err:  HttpErrBadSignature(),
+			want: "BAD_SIGNATURE: webhook signature is missing or invalid",
+		},
+		{
+			name: "identity exists error",
+			err:  HttpErrIdentityExists(),
+			want: "IDENTITY_EXISTS: external account is linked to another user",
+		},
 	}
 
 	for _, tt := range tests {
@@ -124,12 +164,48 @@ func TestHttpErrFunctions(t *testing.T) {
 			wantCode: http.StatusConflict,
 			wantErr:  CodeNoCandidate,
 		},
+		{
+			name:     "HttpErrNoCapacity",
+			fn:       HttpErrNoCapacity,
+			wantCode: http.StatusConflict,
+			wantErr:  CodeNoCapacity,
+		},
 		{
 			name:     "HttpErrNotFound",
 			fn:       HttpErrNotFound,
 			wantCode: http.StatusNotFound,
 			wantErr:  CodeNotFound,
 		},
+		{
+			name:     "HttpErrPRState",
+			fn:       HttpErrPRState,
+			wantCode: http.StatusConflict,
+			wantErr:  CodePRState,
+		},
+		{
+			name:     "HttpErrPRVersion",
+			fn:       HttpErrPRVersion,
+			wantCode: http.StatusPreconditionFailed,
+			wantErr:  CodePRVersion,
+		},
+		{
+			name:     "HttpErrOpenReviews",
+			fn:       HttpErrOpenReviews,
+			wantCode: http.StatusConflict,
+			wantErr:  CodeOpenReviews,
+		},
+		{
+			name:     "HttpErrBadSignature",
+			fn:       HttpErrBadSignature,
+			wantCode: http.StatusUnauthorized,
+			wantErr:  CodeBadSignature,
+		},
+		{
+			name:     "HttpErrIdentityExists",
+			fn:       HttpErrIdentityExists,
+			wantCode: http.StatusConflict,
+			wantErr:  CodeIdentityExists,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	ProviderGithub IdentityProvider = "github"
+	ProviderGitlab IdentityProvider = "gitlab"
+)
+
+// IdentityProvider names an external system the members have accounts in.
+type IdentityProvider string
+
+// Identity is the account of a member in an external system, ExternalId is matched case-insensitively.
+type Identity struct {
+	Provider   IdentityProvider
+	ExternalId string
+}
+
+// MemberIdentity links an external account to a member, an account belongs to one member only.
+type MemberIdentity struct {
+	MemberId MemberId
+	Identity Identity
+}
+
+// MemberRef points at a member in API requests: its UUID or a linked identity written as provider:external_id.
+type MemberRef string
+
+var providerPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}$`)
+
+func (p IdentityProvider) String() string {
+	return string(p)
+}
+
+func (p IdentityProvider) IsValid() bool {
+	return providerPattern.MatchString(string(p))
+}
+
+func NewIdentity(provider IdentityProvider, externalId string) Identity {
+	return Identity{Provider: provider, ExternalId: strings.TrimSpace(externalId)}
+}
+
+func (i Identity) Valid() bool {
+	return i.Provider.IsValid() && i.ExternalId != "" && len(i.ExternalId) <= 255
+}
+
+func (i Identity) String() string {
+	return i.Provider.String() + ":" + i.ExternalId
+}
+
+// MemberId returns the referenced id when the reference is a UUID.
+func (r MemberRef) MemberId() (MemberId, bool) {
+	id := MemberId(r)
+	return id, id.IsValid()
+}
+
+// Identity parses a provider:external_id reference, a UUID never contains the colon.
+func (r MemberRef) Identity() (Identity, bool) {
+	provider, externalId, ok := strings.Cut(string(r), ":")
+	if !ok {
+		return Identity{}, false
+	}
+	identity := NewIdentity(IdentityProvider(provider), externalId)
+	return identity, identity.Valid()
+}
+
+func (r MemberRef) Valid() bool {
+	if _, ok := r.MemberId(); ok {
+		return true
+	}
+	_, ok := r.Identity()
+	return ok
+}
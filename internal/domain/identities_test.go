@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestIdentity_Valid(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity Identity
+		want     bool
+	}{
+		{name: "valid", identity: NewIdentity(ProviderGithub, " octocat "), want: true},
+		{name: "blank external id", identity: NewIdentity(ProviderGithub, "  ")},
+		{name: "upper case provider", identity: NewIdentity("GitHub", "octocat")},
+		{name: "empty provider", identity: NewIdentity("", "octocat")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.identity.Valid(); got != tt.want {
+				t.Errorf("Identity.Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemberRef(t *testing.T) {
+	id := uuid.New().String()
+
+	tests := []struct {
+		name         string
+		ref          MemberRef
+		wantId       MemberId
+		wantIdentity Identity
+		wantValid    bool
+	}{
+		{name: "uuid", ref: MemberRef(id), wantId: MemberId(id), wantValid: true},
+		{name: "github login", ref: "github:octocat", wantIdentity: Identity{Provider: ProviderGithub, ExternalId: "octocat"}, wantValid: true},
+		{name: "email with colon free value", ref: "email:jane@example.com", wantIdentity: Identity{Provider: "email", ExternalId: "jane@example.com"}, wantValid: true},
+		{name: "colon in external id", ref: "slack:T01:U02", wantIdentity: Identity{Provider: "slack", ExternalId: "T01:U02"}, wantValid: true},
+		{name: "plain login", ref: "octocat"},
+		{name: "blank external id", ref: "github: "},
+		{name: "bad provider", ref: "Git Hub:octocat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := tt.ref.MemberId(); ok && got != tt.wantId || !ok && tt.wantId != "" {
+				t.Errorf("MemberRef.MemberId() = %v, %v, want %v", got, ok, tt.wantId)
+			}
+			if got, ok := tt.ref.Identity(); ok && got != tt.wantIdentity || !ok && tt.wantIdentity != (Identity{}) {
+				t.Errorf("MemberRef.Identity() = %v, %v, want %v", got, ok, tt.wantIdentity)
+			}
+			if got := tt.ref.Valid(); got != tt.wantValid {
+				t.Errorf("MemberRef.Valid() = %v, want %v", got, tt.wantValid)
+			}
+		})
+	}
+}
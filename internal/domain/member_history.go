@@ -6,6 +6,8 @@ type MemberHistory struct {
 	Id                MemberId
 	Status            MemberStatus
 	Role              MemberRole
+	Load              int
+	Capacity          ReviewCapacity
 	wasAssignedBefore bool
 }
 
@@ -25,3 +27,35 @@ func NewMemberHistory(id MemberId, status MemberStatus, role MemberRole, wasAssi
 		wasAssignedBefore: wasAssignedBefore,
 	}
 }
+
+func (mh MemberHistory) WithLoad(load int) MemberHistory {
+	mh.Load = load
+	return mh
+}
+
+func (mh MemberHistory) WithCapacity(c ReviewCapacity) MemberHistory {
+	mh.Capacity = c
+	return mh
+}
+
+func (mh MemberHistory) HasFreeCapacity() bool {
+	return mh.Capacity.Allows(mh.Load)
+}
+
+func (mh MembersHistories) WithFreeCapacity() MembersHistories {
+	res := make([]MemberHistory, 0, len(mh))
+	for _, h := range mh {
+		if h.HasFreeCapacity() {
+			res = append(res, h)
+		}
+	}
+	return MembersHistories(res)
+}
+
+func (mh MembersHistories) Members() Members {
+	res := make([]Member, 0, len(mh))
+	for _, h := range mh {
+		res = append(res, MemberBuilder(h.Id).Status(h.Status).Build())
+	}
+	return Members(res)
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
 
 const MemberStatusDefault = MemberStatusActive
 
@@ -17,12 +21,47 @@ var MemberStatusNames = map[MemberStatus]string{
 type MemberId string
 type MemberStatus int
 
+// Member.Reviews may hold only a page of the reviews, OpenReviews counts all open ones.
 type Member struct {
-	Id      MemberId
-	Name    string
-	Status  MemberStatus
-	Reviews PullRequests
-	Team    TeamName
+	Id          MemberId
+	Name        string
+	Status      MemberStatus
+	Reviews     PullRequests
+	OpenReviews int
+	Team        TeamName
+	Teams       TeamMemberships
+	Capacity    ReviewCapacity
+	Profile     MemberProfile
+}
+
+// MemberProfile holds the optional directory fields, empty means not set.
+type MemberProfile struct {
+	Email string
+	Title string
+}
+
+// MemberPatch changes only the set fields, an empty email or title clears it.
+type MemberPatch struct {
+	Name  *string
+	Email *string
+	Title *string
+}
+
+func (p MemberPatch) Empty() bool {
+	return p.Name == nil && p.Email == nil && p.Title == nil
+}
+
+// Valid rejects a blank display name, the other fields may be cleared.
+func (p MemberPatch) Valid() bool {
+	return p.Name == nil || strings.TrimSpace(*p.Name) != ""
+}
+
+// MemberFilter selects members of the directory, zero fields match any.
+// Name matches a case-insensitive substring of the display name.
+type MemberFilter struct {
+	Name   string
+	Team   TeamName
+	Active *bool
 }
 
 func (mId MemberId) IsValid() bool {
@@ -37,6 +76,10 @@ type memberBuilder interface {
 	Name(string) memberBuilder
 	Status(MemberStatus) memberBuilder
 	Reviews([]PullRequestShort) memberBuilder
+	Capacity(ReviewCapacity) memberBuilder
+	OpenReviews(int) memberBuilder
+	Profile(MemberProfile) memberBuilder
+	Teams(TeamMemberships) memberBuilder
 	Build() Member
 }
 
@@ -67,6 +110,28 @@ func (mb *memBuilder) Reviews(rs []PullRequestShort) memberBuilder {
 	return mb
 }
 
+func (mb *memBuilder) Capacity(c ReviewCapacity) memberBuilder {
+	mb.m.Capacity = c
+	return mb
+}
+
+func (mb *memBuilder) OpenReviews(n int) memberBuilder {
+	mb.m.OpenReviews = n
+	return mb
+}
+
+func (mb *memBuilder) Profile(p MemberProfile) memberBuilder {
+	mb.m.Profile = p
+	return mb
+}
+
+// Teams sets all memberships, the primary one becomes Member.Team.
+func (mb *memBuilder) Teams(ts TeamMemberships) memberBuilder {
+	mb.m.Teams = ts
+	mb.m.Team = ts.Primary()
+	return mb
+}
+
 func (mb *memBuilder) Build() Member {
 	return mb.m
 }
@@ -80,6 +145,28 @@ func (tm Members) Slice() []Member {
 	return []Member(tm)
 }
 
+func (tm Members) Ids() []MemberId {
+	res := make([]MemberId, 0, len(tm))
+	for _, m := range tm {
+		res = append(res, m.Id)
+	}
+	return res
+}
+
+// MembersPage is one page of the member directory, NextCursor is empty on the last page.
+type MembersPage struct {
+	Items      Members
+	NextCursor string
+}
+
+// NewMembersPage builds a page from rows read with MemberPageRequest.Fetch.
+func NewMembersPage(ms Members, limit int) MembersPage {
+	items, next := page(ms, limit, func(m Member) MemberCursor {
+		return MemberCursor{Name: m.Name, Id: m.Id}
+	})
+	return MembersPage{Items: items, NextCursor: next}
+}
+
 func (s MemberStatus) String() string {
 	if name, ok := MemberStatusNames[s]; ok {
 		return name
@@ -398,3 +398,30 @@ func TestMembers_Slice(t *testing.T) {
 		})
 	}
 }
+
+func TestMemberPatch(t *testing.T) {
+	name, blank, empty := "Alice", " ", ""
+
+	tests := []struct {
+		name      string
+		patch     MemberPatch
+		wantEmpty bool
+		wantValid bool
+	}{
+		{name: "nothing set", patch: MemberPatch{}, wantEmpty: true, wantValid: true},
+		{name: "name set", patch: MemberPatch{Name: &name}, wantValid: true},
+		{name: "blank name", patch: MemberPatch{Name: &blank}},
+		{name: "email cleared", patch: MemberPatch{Email: &empty}, wantValid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.patch.Empty(); got != tt.wantEmpty {
+				t.Errorf("MemberPatch.Empty() = %v, want %v", got, tt.wantEmpty)
+			}
+			if got := tt.patch.Valid(); got != tt.wantValid {
+				t.Errorf("MemberPatch.Valid() = %v, want %v", got, tt.wantValid)
+			}
+		})
+	}
+}
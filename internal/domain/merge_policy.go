@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type MergePolicy struct {
+	MinApprovals            int
+	BlockOnChangesRequested bool
+	RequireActiveReviewers  bool
+}
+
+// MergePolicyOverride is the team level policy, nil fields inherit the global one.
+type MergePolicyOverride struct {
+	MinApprovals            *int
+	BlockOnChangesRequested *bool
+	RequireActiveReviewers  *bool
+}
+
+func (o MergePolicyOverride) Apply(p MergePolicy) MergePolicy {
+	if o.MinApprovals != nil {
+		p.MinApprovals = *o.MinApprovals
+	}
+	if o.BlockOnChangesRequested != nil {
+		p.BlockOnChangesRequested = *o.BlockOnChangesRequested
+	}
+	if o.RequireActiveReviewers != nil {
+		p.RequireActiveReviewers = *o.RequireActiveReviewers
+	}
+	return p
+}
+
+// Unmet describes every condition of the policy the pull request does not satisfy, empty means it can be merged.
+func (p MergePolicy) Unmet(pr PullRequest) []string {
+	var unmet []string
+
+	approvals := 0
+	for _, r := range pr.Reviews.Slice() {
+		switch r.State {
+		case ReviewStateApproved:
+			approvals++
+		case ReviewStateChangesRequested:
+			if p.BlockOnChangesRequested {
+				unmet = append(unmet, fmt.Sprintf("changes requested by %s", r.MemberId))
+			}
+		}
+	}
+	if approvals < p.MinApprovals {
+		unmet = append(unmet, fmt.Sprintf("approvals: %d of %d required", approvals, p.MinApprovals))
+	}
+
+	if p.RequireActiveReviewers {
+		for _, m := range pr.AssignedReviews.Slice() {
+			if !m.Status.IsActive() {
+				unmet = append(unmet, fmt.Sprintf("reviewer %s is inactive", m.Id))
+			}
+		}
+	}
+
+	return unmet
+}
+
+type MergeBlockedError struct {
+	Unmet []string
+}
+
+func (e *MergeBlockedError) Error() string {
+	return ErrMergeBlocked.Error() + ": " + strings.Join(e.Unmet, "; ")
+}
+
+func (e *MergeBlockedError) Unwrap() error {
+	return ErrMergeBlocked
+}
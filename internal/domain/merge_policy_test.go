@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMergePolicyOverride_Apply(t *testing.T) {
+	base := MergePolicy{MinApprovals: 1, BlockOnChangesRequested: true, RequireActiveReviewers: false}
+	two := 2
+	no := false
+	yes := true
+
+	tests := []struct {
+		name     string
+		override MergePolicyOverride
+		want     MergePolicy
+	}{
+		{
+			name:     "empty override inherits everything",
+			override: MergePolicyOverride{},
+			want:     base,
+		},
+		{
+			name:     "all fields overridden",
+			override: MergePolicyOverride{MinApprovals: &two, BlockOnChangesRequested: &no, RequireActiveReviewers: &yes},
+			want:     MergePolicy{MinApprovals: 2, BlockOnChangesRequested: false, RequireActiveReviewers: true},
+		},
+		{
+			name:     "partial override",
+			override: MergePolicyOverride{MinApprovals: &two},
+			want:     MergePolicy{MinApprovals: 2, BlockOnChangesRequested: true, RequireActiveReviewers: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.override.Apply(base); got != tt.want {
+				t.Errorf("MergePolicyOverride.Apply() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergePolicy_Unmet(t *testing.T) {
+	pr := PullRequest{
+		AssignedReviews: Members{
+			{Id: "a", Status: MemberStatusActive},
+			{Id: "b", Status: MemberStatusInactive},
+		},
+		Reviews: Reviews{
+			{MemberId: "a", State: ReviewStateApproved},
+			{MemberId: "b", State: ReviewStateChangesRequested},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		policy MergePolicy
+		want   []string
+	}{
+		{
+			name:   "permissive policy",
+			policy: MergePolicy{},
+			want:   nil,
+		},
+		{
+			name:   "enough approvals",
+			policy: MergePolicy{MinApprovals: 1},
+			want:   nil,
+		},
+		{
+			name:   "not enough approvals",
+			policy: MergePolicy{MinApprovals: 2},
+			want:   []string{"approvals: 1 of 2 required"},
+		},
+		{
+			name:   "changes requested",
+			policy: MergePolicy{BlockOnChangesRequested: true},
+			want:   []string{"changes requested by b"},
+		},
+		{
+			name:   "inactive reviewer",
+			policy: MergePolicy{RequireActiveReviewers: true},
+			want:   []string{"reviewer b is inactive"},
+		},
+		{
+			name:   "everything unmet",
+			policy: MergePolicy{MinApprovals: 2, BlockOnChangesRequested: true, RequireActiveReviewers: true},
+			want: []string{
+				"changes requested by b",
+				"approvals: 1 of 2 required",
+				"reviewer b is inactive",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Unmet(pr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergePolicy.Unmet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeBlockedError(t *testing.T) {
+	var err error = &MergeBlockedError{Unmet: []string{"approvals: 0 of 1 required"}}
+
+	if !errors.Is(err, ErrMergeBlocked) {
+		t.Errorf("MergeBlockedError must wrap ErrMergeBlocked")
+	}
+	if want := "merge blocked by policy: approvals: 0 of 1 required"; err.Error() != want {
+		t.Errorf("MergeBlockedError.Error() = %v, want %v", err.Error(), want)
+	}
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// PageCursor points at the last pull request of a page, listings are ordered by creation time then id, newest first.
+type PageCursor struct {
+	CreatedAt time.Time
+	Id        PrId
+}
+
+// PageRequest asks for up to Limit items after the cursor, a nil cursor starts from the first page.
+type PageRequest struct {
+	After *PageCursor
+	Limit int
+}
+
+// NewPageRequest parses an opaque cursor, zero limit means DefaultPageLimit.
+func NewPageRequest(cursor string, limit int) (PageRequest, error) {
+	limit, err := pageLimit(limit)
+	if err != nil {
+		return PageRequest{}, err
+	}
+
+	page := PageRequest{Limit: limit}
+	if cursor == "" {
+		return page, nil
+	}
+
+	c, err := DecodePageCursor(cursor)
+	if err != nil {
+		return PageRequest{}, err
+	}
+	page.After = &c
+
+	return page, nil
+}
+
+func (c PageCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.Id.String()))
+}
+
+func DecodePageCursor(s string) (PageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return PageCursor{}, ErrValidation
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || uuid.Validate(id) != nil {
+		return PageCursor{}, ErrValidation
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return PageCursor{}, ErrValidation
+	}
+
+	return PageCursor{CreatedAt: t, Id: PrId(id)}, nil
+}
+
+// Fetch is the number of rows to read: one extra row tells whether there is a next page.
+func (p PageRequest) Fetch() int {
+	return p.Limit + 1
+}
+
+// MemberCursor points at the last member of a directory page, members are ordered by name then id.
+type MemberCursor struct {
+	Name string
+	Id   MemberId
+}
+
+// MemberPageRequest asks for up to Limit members after the cursor, a nil cursor starts from the first page.
+type MemberPageRequest struct {
+	After *MemberCursor
+	Limit int
+}
+
+// NewMemberPageRequest parses an opaque member cursor, zero limit means DefaultPageLimit.
+func NewMemberPageRequest(cursor string, limit int) (MemberPageRequest, error) {
+	limit, err := pageLimit(limit)
+	if err != nil {
+		return MemberPageRequest{}, err
+	}
+
+	page := MemberPageRequest{Limit: limit}
+	if cursor == "" {
+		return page, nil
+	}
+
+	c, err := DecodeMemberCursor(cursor)
+	if err != nil {
+		return MemberPageRequest{}, err
+	}
+	page.After = &c
+
+	return page, nil
+}
+
+// String puts the id first, names may contain the separator.
+func (c MemberCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.Id.String() + "|" + c.Name))
+}
+
+func DecodeMemberCursor(s string) (MemberCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return MemberCursor{}, ErrValidation
+	}
+
+	id, name, ok := strings.Cut(string(raw), "|")
+	if !ok || uuid.Validate(id) != nil {
+		return MemberCursor{}, ErrValidation
+	}
+
+	return MemberCursor{Name: name, Id: MemberId(id)}, nil
+}
+
+func (p MemberPageRequest) Fetch() int {
+	return p.Limit + 1
+}
+
+func pageLimit(limit int) (int, error) {
+	if limit == 0 {
+		return DefaultPageLimit, nil
+	}
+	if limit < 0 || limit > MaxPageLimit {
+		return 0, ErrValidation
+	}
+	return limit, nil
+}
+
+// page cuts the extra row read beyond the limit and returns the cursor of the next page, empty on the last one.
+func page[T any, C fmt.Stringer](items []T, limit int, cursor func(T) C) ([]T, string) {
+	if len(items) <= limit {
+		return items, ""
+	}
+
+	items = items[:limit]
+	return items, cursor(items[limit-1]).String()
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPageCursor_RoundTrip(t *testing.T) {
+	c := PageCursor{
+		CreatedAt: time.Date(2025, 11, 20, 10, 0, 0, 123456789, time.UTC),
+		Id:        PrId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001"),
+	}
+
+	got, err := DecodePageCursor(c.String())
+	if err != nil {
+		t.Fatalf("DecodePageCursor() error = %v", err)
+	}
+	if got.Id != c.Id || !got.CreatedAt.Equal(c.CreatedAt) {
+		t.Errorf("DecodePageCursor() = %+v, want %+v", got, c)
+	}
+}
+
+func TestNewPageRequest(t *testing.T) {
+	cursor := PageCursor{
+		CreatedAt: time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC),
+		Id:        PrId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001"),
+	}.String()
+
+	tests := []struct {
+		name       string
+		cursor     string
+		limit      int
+		wantLimit  int
+		wantCursor bool
+		wantErr    bool
+	}{
+		{name: "defaults", wantLimit: DefaultPageLimit},
+		{name: "explicit limit", limit: 5, wantLimit: 5},
+		{name: "max limit", limit: MaxPageLimit, wantLimit: MaxPageLimit},
+		{name: "with cursor", cursor: cursor, limit: 10, wantLimit: 10, wantCursor: true},
+		{name: "negative limit", limit: -1, wantErr: true},
+		{name: "limit above max", limit: MaxPageLimit + 1, wantErr: true},
+		{name: "not base64", cursor: "%%%", wantErr: true},
+		{name: "no separator", cursor: "bm9wZQ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPageRequest(tt.cursor, tt.limit)
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("NewPageRequest() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewPageRequest() error = %v", err)
+			}
+			if got.Limit != tt.wantLimit {
+				t.Errorf("NewPageRequest() limit = %d, want %d", got.Limit, tt.wantLimit)
+			}
+			if (got.After != nil) != tt.wantCursor {
+				t.Errorf("NewPageRequest() cursor = %v, want cursor %v", got.After, tt.wantCursor)
+			}
+			if got.Fetch() != got.Limit+1 {
+				t.Errorf("PageRequest.Fetch() = %d, want %d", got.Fetch(), got.Limit+1)
+			}
+		})
+	}
+}
+
+func TestNewPullRequestsPage(t *testing.T) {
+	createdAt := time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC)
+	prs := []PullRequest{
+		{Id: PrId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001"), CreatedAt: createdAt.Add(time.Minute)},
+		{Id: PrId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0002"), CreatedAt: createdAt},
+	}
+
+	page := NewPullRequestsPage(prs, 1)
+	if len(page.Items) != 1 || page.NextCursor == "" {
+		t.Fatalf("NewPullRequestsPage() = %+v, want one item and a next cursor", page)
+	}
+
+	last := NewPullRequestsPage(prs, 2)
+	if len(last.Items) != 2 || last.NextCursor != "" {
+		t.Errorf("NewPullRequestsPage() = %+v, want two items and no next cursor", last)
+	}
+}
+
+func TestMemberCursor_RoundTrip(t *testing.T) {
+	c := MemberCursor{Name: "Alice | Ops", Id: MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001")}
+
+	got, err := DecodeMemberCursor(c.String())
+	if err != nil {
+		t.Fatalf("DecodeMemberCursor() error = %v", err)
+	}
+	if got != c {
+		t.Errorf("DecodeMemberCursor() = %+v, want %+v", got, c)
+	}
+
+	if _, err := DecodeMemberCursor("bm9wZQ"); !errors.Is(err, ErrValidation) {
+		t.Errorf("DecodeMemberCursor() error = %v, want ErrValidation", err)
+	}
+}
+
+func TestNewMembersPage(t *testing.T) {
+	ms := Members{
+		{Id: MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001"), Name: "Alice"},
+		{Id: MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0002"), Name: "Bob"},
+	}
+
+	page := NewMembersPage(ms, 1)
+	if len(page.Items) != 1 || page.NextCursor != (MemberCursor{Name: "Alice", Id: ms[0].Id}).String() {
+		t.Fatalf("NewMembersPage() = %+v, want one item and a cursor at Alice", page)
+	}
+
+	last := NewMembersPage(ms, 2)
+	if len(last.Items) != 2 || last.NextCursor != "" {
+		t.Errorf("NewMembersPage() = %+v, want two items and no next cursor", last)
+	}
+}
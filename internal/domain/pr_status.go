@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type PrStatus int
+
+const (
+	PrStatusDefault = PrStatusOpen
+	PrStatusOpen    = iota
+	PrStatusMerged
+	PrStatusDraft
+	PrStatusClosed
+)
+
+var PrStatusNames = map[PrStatus]string{
+	PrStatusOpen:   "OPEN",
+	PrStatusMerged: "MERGED",
+	PrStatusDraft:  "DRAFT",
+	PrStatusClosed: "CLOSED",
+}
+
+// PrTransition is an edge of the PR lifecycle: a draft gets reviewers when it is marked ready,
+// a closed PR released its reviewers and can be reopened, a merged PR is final.
+type PrTransition struct {
+	Name string
+	From []PrStatus
+	To   PrStatus
+}
+
+var (
+	PrTransitionReady  = PrTransition{Name: "ready", From: []PrStatus{PrStatusDraft}, To: PrStatusOpen}
+	PrTransitionMerge  = PrTransition{Name: "merge", From: []PrStatus{PrStatusOpen}, To: PrStatusMerged}
+	PrTransitionClose  = PrTransition{Name: "close", From: []PrStatus{PrStatusDraft, PrStatusOpen}, To: PrStatusClosed}
+	PrTransitionReopen = PrTransition{Name: "reopen", From: []PrStatus{PrStatusClosed}, To: PrStatusOpen}
+)
+
+func (s PrStatus) String() string {
+	if name, ok := PrStatusNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// PrStatusFromString parses a status name, ok is false for an unknown name.
+func PrStatusFromString(s string) (PrStatus, bool) {
+	for k, v := range PrStatusNames {
+		if v == s {
+			return k, true
+		}
+	}
+	return PrStatusOpen, false
+}
+
+// ParsePrStatuses parses a comma separated list of status names, an empty list means any status.
+func ParsePrStatuses(list string) ([]PrStatus, error) {
+	if list == "" {
+		return nil, nil
+	}
+
+	names := strings.Split(list, ",")
+	statuses := make([]PrStatus, 0, len(names))
+	for _, name := range names {
+		status, ok := PrStatusFromString(strings.ToUpper(strings.TrimSpace(name)))
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown pull request status %q", ErrValidation, name)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func (t PrTransition) Allowed(from PrStatus) bool {
+	return slices.Contains(t.From, from)
+}
+
+// Validate returns ErrInvalidPrState when the PR in status from cannot take the transition.
+func (t PrTransition) Validate(from PrStatus) error {
+	if !t.Allowed(from) {
+		return fmt.Errorf("%w: cannot %s %s pull request", ErrInvalidPrState, t.Name, from)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPrStatusFromString(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		want   PrStatus
+		wantOk bool
+	}{
+		{name: "open", s: "OPEN", want: PrStatusOpen, wantOk: true},
+		{name: "merged", s: "MERGED", want: PrStatusMerged, wantOk: true},
+		{name: "draft", s: "DRAFT", want: PrStatusDraft, wantOk: true},
+		{name: "closed", s: "CLOSED", want: PrStatusClosed, wantOk: true},
+		{name: "unknown falls back to open", s: "ABANDONED", want: PrStatusOpen, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := PrStatusFromString(tt.s)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("PrStatusFromString() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+			if ok && got.String() != tt.s {
+				t.Errorf("PrStatus.String() = %v, want %v", got.String(), tt.s)
+			}
+		})
+	}
+}
+
+func TestPrTransition_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		transition PrTransition
+		from       PrStatus
+		wantErr    bool
+	}{
+		{name: "draft ready", transition: PrTransitionReady, from: PrStatusDraft},
+		{name: "draft closed", transition: PrTransitionClose, from: PrStatusDraft},
+		{name: "open merged", transition: PrTransitionMerge, from: PrStatusOpen},
+		{name: "open closed", transition: PrTransitionClose, from: PrStatusOpen},
+		{name: "closed reopened", transition: PrTransitionReopen, from: PrStatusClosed},
+		{name: "draft cannot be merged", transition: PrTransitionMerge, from: PrStatusDraft, wantErr: true},
+		{name: "closed cannot be merged", transition: PrTransitionMerge, from: PrStatusClosed, wantErr: true},
+		{name: "closed cannot be marked ready", transition: PrTransitionReady, from: PrStatusClosed, wantErr: true},
+		{name: "draft cannot be reopened", transition: PrTransitionReopen, from: PrStatusDraft, wantErr: true},
+		{name: "merged cannot be closed", transition: PrTransitionClose, from: PrStatusMerged, wantErr: true},
+		{name: "merged cannot be reopened", transition: PrTransitionReopen, from: PrStatusMerged, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.transition.Validate(tt.from)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("PrTransition.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidPrState) {
+				t.Errorf("PrTransition.Validate() error = %v, want ErrInvalidPrState", err)
+			}
+			if got := tt.transition.Allowed(tt.from); got == tt.wantErr {
+				t.Errorf("PrTransition.Allowed() = %v, want %v", got, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePrStatuses(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    string
+		want    []PrStatus
+		wantErr bool
+	}{
+		{name: "empty means any", list: "", want: nil},
+		{name: "single", list: "MERGED", want: []PrStatus{PrStatusMerged}},
+		{name: "several with spaces and case", list: "open, draft", want: []PrStatus{PrStatusOpen, PrStatusDraft}},
+		{name: "unknown status", list: "OPEN,REVIEWED", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePrStatuses(tt.list)
+			if tt.wantErr {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("ParsePrStatuses() error = %v, want ErrValidation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePrStatuses() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePrStatuses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
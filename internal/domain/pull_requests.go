@@ -1,50 +1,81 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type PrId string
 type PrName string
-type PrStatus int
 
-const (
-	PrStatusDefault = PrStatusOpen
-	PrStatusOpen    = iota
-	PrStatusMerged
-)
+const DefaultRequiredReviewers = 2
 
 type PullRequest struct {
 	Id              PrId
+	External        ExternalPrKey
 	Name            PrName
 	AuthorId        MemberId
+	Team            TeamName
 	Status          PrStatus
 	CreatedAt       time.Time
 	MergedAt        time.Time
-	version         int
+	Version         int
 	AssignedReviews Members
+	Reviews         Reviews
+	Candidates      MembersHistories
 }
 
+// AnyVersion skips the optimistic concurrency check of a pull request mutation.
+const AnyVersion = 0
+
 type PullRequests []PullRequestShort
 
+// PullRequestShort External is the optional key of the PR in a VCS, Id must then be External.PrId().
 type PullRequestShort struct {
-	Id       PrId
-	Name     PrName
-	AuthorId MemberId
-	Status   PrStatus
+	Id        PrId
+	External  ExternalPrKey
+	Name      PrName
+	AuthorId  MemberId
+	Team      TeamName
+	Status    PrStatus
+	Draft     bool
+	CreatedAt time.Time
 }
 
 func (prs *PullRequestShort) Create() PullRequest {
+	status := PrStatus(PrStatusDefault)
+	if prs.Draft {
+		status = PrStatusDraft
+	}
+
 	return PullRequest{
 		Id:              prs.Id,
+		External:        prs.External,
 		Name:            prs.Name,
 		AuthorId:        prs.AuthorId,
-		Status:          PrStatusDefault,
+		Team:            prs.Team,
+		Status:          status,
 		CreatedAt:       time.Now(),
 		MergedAt:        time.Time{},
-		version:         0,
+		Version:         0,
 		AssignedReviews: nil,
 	}
 }
 
+// CheckPrVersion guards a mutation made against the expected version of a pull request.
+func CheckPrVersion(expected, current int) error {
+	if expected == AnyVersion || expected == current {
+		return nil
+	}
+	return fmt.Errorf("%w: expected %d, current %d", ErrVersionMismatch, expected, current)
+}
+
+func (pId PrId) IsValid() bool {
+	return uuid.Validate(string(pId)) == nil
+}
+
 func (pId PrId) String() string {
 	return string(pId)
 }
@@ -57,12 +88,59 @@ func (mr PullRequests) Empty() bool {
 	return len(mr) == 0
 }
 
+func (mr PullRequests) OpenCount() int {
+	cnt := 0
+	for _, pr := range mr {
+		if pr.Status == PrStatusOpen {
+			cnt++
+		}
+	}
+	return cnt
+}
+
 type PrReasignMember struct {
 	PrId     PrId
 	MemberId MemberId
+	Audit    AssignmentAudit
+	Version  int
 }
 
 type PrWithReasignMember struct {
 	PullRequest
 	MemberId MemberId
 }
+
+// PrFilter narrows a pull request listing, zero values match any and the created range is [From, To).
+type PrFilter struct {
+	Statuses   []PrStatus
+	AuthorId   MemberId
+	ReviewerId MemberId
+	Team       TeamName
+	From       time.Time
+	To         time.Time
+}
+
+func (f PrFilter) Valid() bool {
+	return f.From.IsZero() || f.To.IsZero() || f.From.Before(f.To)
+}
+
+// PullRequestsPage is one page of a listing, NextCursor is empty on the last page.
+type PullRequestsPage struct {
+	Items      []PullRequest
+	NextCursor string
+}
+
+// NewPullRequestsPage builds a page from rows read with PageRequest.Fetch.
+func NewPullRequestsPage(prs []PullRequest, limit int) PullRequestsPage {
+	items, next := page(prs, limit, func(pr PullRequest) PageCursor {
+		return PageCursor{CreatedAt: pr.CreatedAt, Id: pr.Id}
+	})
+	return PullRequestsPage{Items: items, NextCursor: next}
+}
+
+// Page cuts reviews read with PageRequest.Fetch to the limit and returns the next cursor.
+func (mr PullRequests) Page(limit int) (PullRequests, string) {
+	return page(mr, limit, func(pr PullRequestShort) PageCursor {
+		return PageCursor{CreatedAt: pr.CreatedAt, Id: pr.Id}
+	})
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -103,8 +104,8 @@ func TestPullRequestShort_Create(t *testing.T) {
 				if !pr.MergedAt.IsZero() {
 					t.Error("PullRequest.MergedAt should be zero")
 				}
-				if pr.version != 0 {
-					t.Errorf("PullRequest.version = %v, want 0", pr.version)
+				if pr.Version != 0 {
+					t.Errorf("PullRequest.Version = %v, want 0", pr.Version)
 				}
 				if pr.AssignedReviews != nil {
 					t.Error("PullRequest.AssignedReviews should be nil")
@@ -125,6 +126,20 @@ func TestPullRequestShort_Create(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "create draft",
+			prs: PullRequestShort{
+				Id:       PrId("pr-789"),
+				Name:     PrName("Draft PR"),
+				AuthorId: MemberId(uuid.New().String()),
+				Draft:    true,
+			},
+			check: func(t *testing.T, pr PullRequest) {
+				if pr.Status != PrStatusDraft {
+					t.Errorf("PullRequest.Status = %v, want %v", pr.Status, PrStatus(PrStatusDraft))
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -169,6 +184,77 @@ func TestPullRequests_Empty(t *testing.T) {
 	}
 }
 
+func TestPullRequests_Page(t *testing.T) {
+	createdAt := time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC)
+	prs := PullRequests{
+		{Id: PrId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001"), CreatedAt: createdAt.Add(2 * time.Minute)},
+		{Id: PrId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0002"), CreatedAt: createdAt.Add(time.Minute)},
+		{Id: PrId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0003"), CreatedAt: createdAt},
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		wantLen  int
+		wantNext bool
+	}{
+		{name: "extra row gives next cursor", limit: 2, wantLen: 2, wantNext: true},
+		{name: "last page", limit: 3, wantLen: 3, wantNext: false},
+		{name: "short last page", limit: 5, wantLen: 3, wantNext: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, next := prs.Page(tt.limit)
+			if len(got) != tt.wantLen {
+				t.Errorf("PullRequests.Page() len = %d, want %d", len(got), tt.wantLen)
+			}
+			if (next != "") != tt.wantNext {
+				t.Errorf("PullRequests.Page() next = %q, want next %v", next, tt.wantNext)
+			}
+			if next == "" {
+				return
+			}
+
+			c, err := DecodePageCursor(next)
+			if err != nil {
+				t.Fatalf("DecodePageCursor() error = %v", err)
+			}
+			last := got[len(got)-1]
+			if c.Id != last.Id || !c.CreatedAt.Equal(last.CreatedAt) {
+				t.Errorf("PullRequests.Page() cursor = %+v, want last item %v", c, last.Id)
+			}
+		})
+	}
+}
+
+func TestCheckPrVersion(t *testing.T) {
+	current := 3
+
+	tests := []struct {
+		name     string
+		expected int
+		wantErr  bool
+	}{
+		{name: "any version", expected: AnyVersion},
+		{name: "current version", expected: 3},
+		{name: "stale version", expected: 2, wantErr: true},
+		{name: "future version", expected: 4, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckPrVersion(tt.expected, current)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("CheckPrVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrVersionMismatch) {
+				t.Errorf("CheckPrVersion() error = %v, want ErrVersionMismatch", err)
+			}
+		})
+	}
+}
+
 func TestPrReasignMember(t *testing.T) {
 	tests := []struct {
 		name     string
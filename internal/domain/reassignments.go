@@ -0,0 +1,172 @@
+package domain
+
+// ReviewAssignment is an OPEN PR reviewed by a member that has to be replaced.
+type ReviewAssignment struct {
+	PrId         PrId
+	AuthorId     MemberId
+	Team         TeamName
+	MemberId     MemberId
+	Participants []MemberId
+}
+
+type ReviewAssignments []ReviewAssignment
+
+type Reassignment struct {
+	PrId        PrId
+	OldMemberId MemberId
+	NewMemberId MemberId
+}
+
+type DeactivationReport struct {
+	Team        TeamName
+	Deactivated []MemberId
+	Reassigned  []Reassignment
+	Unfilled    []Reassignment
+}
+
+// OpenReviewsPolicy decides what happens to OPEN PR reviews of members leaving a team.
+type OpenReviewsPolicy string
+
+const (
+	// OpenReviewsKeep leaves the members assigned.
+	OpenReviewsKeep OpenReviewsPolicy = "keep"
+	// OpenReviewsReassign replaces the members from the PR team, then from the fallback team.
+	OpenReviewsReassign OpenReviewsPolicy = "reassign"
+	// OpenReviewsReject refuses the change while such reviews exist.
+	OpenReviewsReject OpenReviewsPolicy = "reject"
+)
+
+func (p OpenReviewsPolicy) Valid() bool {
+	switch p {
+	case OpenReviewsKeep, OpenReviewsReassign, OpenReviewsReject:
+		return true
+	}
+	return false
+}
+
+// TeamRemovalReport lists members that left the team and their OPEN reviews in it,
+// reviews kept by the policy or left without a replacement are Unfilled.
+type TeamRemovalReport struct {
+	Team       TeamName
+	Removed    []MemberId
+	Reassigned []Reassignment
+	Unfilled   []Reassignment
+}
+
+func (ra ReviewAssignments) Empty() bool {
+	return len(ra) == 0
+}
+
+func (ra ReviewAssignments) Teams() []TeamName {
+	seen := make(map[TeamName]struct{}, len(ra))
+	res := make([]TeamName, 0, len(ra))
+	for _, a := range ra {
+		if _, ok := seen[a.Team]; ok || a.Team == "" {
+			continue
+		}
+		seen[a.Team] = struct{}{}
+		res = append(res, a.Team)
+	}
+	return res
+}
+
+// With appends the assignments of other missing from ra.
+func (ra ReviewAssignments) With(other ReviewAssignments) ReviewAssignments {
+	type key struct {
+		pr     PrId
+		member MemberId
+	}
+	seen := make(map[key]struct{}, len(ra))
+	for _, a := range ra {
+		seen[key{a.PrId, a.MemberId}] = struct{}{}
+	}
+	for _, a := range other {
+		if _, ok := seen[key{a.PrId, a.MemberId}]; ok {
+			continue
+		}
+		seen[key{a.PrId, a.MemberId}] = struct{}{}
+		ra = append(ra, a)
+	}
+	return ra
+}
+
+// Unfilled lists the assignments as reassignments without a replacement.
+func (ra ReviewAssignments) Unfilled() []Reassignment {
+	res := make([]Reassignment, 0, len(ra))
+	for _, a := range ra {
+		res = append(res, Reassignment{PrId: a.PrId, OldMemberId: a.MemberId})
+	}
+	return res
+}
+
+// Excludes reports whether the member already takes part in the PR.
+func (a ReviewAssignment) Excludes(id MemberId) bool {
+	if id == a.AuthorId {
+		return true
+	}
+	for _, p := range a.Participants {
+		if p == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Plan picks a replacement for every assignment from the PR team, then from the fallback team,
+// counting replacements picked earlier in the plan towards the candidates load.
+func (ra ReviewAssignments) Plan(
+	pools map[TeamName]MembersHistories,
+	fallback TeamName,
+	pick func(MembersHistories) MembersHistories,
+) (reassigned, unfilled []Reassignment) {
+	reassigned = make([]Reassignment, 0, len(ra))
+	unfilled = make([]Reassignment, 0)
+
+	extraLoad := make(map[MemberId]int)
+	picked := make(map[PrId][]MemberId)
+
+	free := func(a ReviewAssignment, team TeamName) MembersHistories {
+		res := make([]MemberHistory, 0, len(pools[team]))
+		for _, c := range pools[team] {
+			if a.Excludes(c.Id) || containsMemberId(picked[a.PrId], c.Id) {
+				continue
+			}
+			c = c.WithLoad(c.Load + extraLoad[c.Id])
+			if c.HasFreeCapacity() {
+				res = append(res, c)
+			}
+		}
+		return MembersHistories(res)
+	}
+
+	for _, a := range ra {
+		candidates := free(a, a.Team)
+		if candidates.Empty() && fallback != "" && fallback != a.Team {
+			candidates = free(a, fallback)
+		}
+
+		r := Reassignment{PrId: a.PrId, OldMemberId: a.MemberId}
+
+		chosen := pick(candidates)
+		if chosen.Empty() {
+			unfilled = append(unfilled, r)
+			continue
+		}
+
+		r.NewMemberId = chosen[0].Id
+		extraLoad[r.NewMemberId]++
+		picked[a.PrId] = append(picked[a.PrId], r.NewMemberId)
+		reassigned = append(reassigned, r)
+	}
+
+	return reassigned, unfilled
+}
+
+func containsMemberId(ids []MemberId, id MemberId) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
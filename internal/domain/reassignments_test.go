@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReviewAssignment_Excludes(t *testing.T) {
+	a := ReviewAssignment{
+		AuthorId:     "author",
+		MemberId:     "old",
+		Participants: []MemberId{"old", "rev"},
+	}
+
+	tests := []struct {
+		name string
+		id   MemberId
+		want bool
+	}{
+		{name: "author", id: "author", want: true},
+		{name: "replaced reviewer", id: "old", want: true},
+		{name: "other reviewer", id: "rev", want: true},
+		{name: "outsider", id: "new", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := a.Excludes(tt.id); got != tt.want {
+				t.Errorf("ReviewAssignment.Excludes(%s) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviewAssignments_Teams(t *testing.T) {
+	ra := ReviewAssignments{
+		{PrId: "pr-1", Team: "backend"},
+		{PrId: "pr-2", Team: ""},
+		{PrId: "pr-3", Team: "platform"},
+		{PrId: "pr-4", Team: "backend"},
+	}
+
+	want := []TeamName{"backend", "platform"}
+	if got := ra.Teams(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ReviewAssignments.Teams() = %v, want %v", got, want)
+	}
+}
+
+func TestReviewAssignments_Unfilled(t *testing.T) {
+	ra := ReviewAssignments{
+		{PrId: "pr-1", MemberId: "rev-1", Team: "backend"},
+		{PrId: "pr-2", MemberId: "rev-1", Team: "backend"},
+	}
+
+	want := []Reassignment{
+		{PrId: "pr-1", OldMemberId: "rev-1"},
+		{PrId: "pr-2", OldMemberId: "rev-1"},
+	}
+	if got := ra.Unfilled(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ReviewAssignments.Unfilled() = %v, want %v", got, want)
+	}
+	if got := (ReviewAssignments{}).Unfilled(); len(got) != 0 {
+		t.Errorf("ReviewAssignments{}.Unfilled() = %v, want empty", got)
+	}
+}
+
+func TestOpenReviewsPolicy_Valid(t *testing.T) {
+	tests := []struct {
+		policy OpenReviewsPolicy
+		want   bool
+	}{
+		{policy: OpenReviewsKeep, want: true},
+		{policy: OpenReviewsReassign, want: true},
+		{policy: OpenReviewsReject, want: true},
+		{policy: "", want: false},
+		{policy: "KEEP", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			if got := tt.policy.Valid(); got != tt.want {
+				t.Errorf("OpenReviewsPolicy(%q).Valid() = %v, want %v", tt.policy, got, tt.want)
+			}
+		})
+	}
+}
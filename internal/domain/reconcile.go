@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// OrgSpec is the desired state of the managed teams. Teams missing from it are left untouched,
+// a member listed in several teams must have the same name and status in each of them.
+type OrgSpec struct {
+	Teams []Team
+}
+
+// MembershipChange is a member joining or leaving a team.
+type MembershipChange struct {
+	Team     TeamName
+	MemberId MemberId
+}
+
+type MembershipChanges []MembershipChange
+
+// ReconcilePlan is the diff between an OrgSpec and the database. Affected lists OPEN reviews
+// of removed members in PRs of the team they leave, the reviewers stay assigned, and OPEN reviews
+// of deactivated members, which are moved once applied as listed in Reassigned and Unfilled.
+type ReconcilePlan struct {
+	CreateTeams   []TeamName
+	CreateMembers []MemberId
+	AddMembers    MembershipChanges
+	RemoveMembers MembershipChanges
+	Activate      []MemberId
+	Deactivate    []MemberId
+	Rename        []MemberId
+	Affected      ReviewAssignments
+	Reassigned    []Reassignment
+	Unfilled      []Reassignment
+	Applied       bool
+}
+
+func (s OrgSpec) Valid() bool {
+	teams := make(map[TeamName]struct{}, len(s.Teams))
+	members := make(map[MemberId]Member)
+
+	for _, t := range s.Teams {
+		if strings.TrimSpace(t.Name.String()) == "" {
+			return false
+		}
+		if _, ok := teams[t.Name]; ok {
+			return false
+		}
+		teams[t.Name] = struct{}{}
+
+		inTeam := make(map[MemberId]struct{}, len(t.Members))
+		for _, m := range t.Members {
+			if !m.Id.IsValid() || strings.TrimSpace(m.Name) == "" {
+				return false
+			}
+			if _, ok := inTeam[m.Id]; ok {
+				return false
+			}
+			inTeam[m.Id] = struct{}{}
+
+			if seen, ok := members[m.Id]; ok && (seen.Name != m.Name || seen.Status != m.Status) {
+				return false
+			}
+			members[m.Id] = m
+		}
+	}
+
+	return true
+}
+
+func (s OrgSpec) TeamNames() []TeamName {
+	res := make([]TeamName, 0, len(s.Teams))
+	for _, t := range s.Teams {
+		res = append(res, t.Name)
+	}
+	return res
+}
+
+// Members lists every member of the spec once, in order of first appearance.
+func (s OrgSpec) Members() Members {
+	seen := make(map[MemberId]struct{})
+	res := make(Members, 0)
+	for _, t := range s.Teams {
+		for _, m := range t.Members {
+			if _, ok := seen[m.Id]; ok {
+				continue
+			}
+			seen[m.Id] = struct{}{}
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+// NewReconcilePlan diffs the spec against the current managed teams and the known spec members.
+func NewReconcilePlan(spec OrgSpec, current []Team, known Members) ReconcilePlan {
+	plan := ReconcilePlan{
+		CreateTeams:   []TeamName{},
+		CreateMembers: []MemberId{},
+		AddMembers:    MembershipChanges{},
+		RemoveMembers: MembershipChanges{},
+		Activate:      []MemberId{},
+		Deactivate:    []MemberId{},
+		Rename:        []MemberId{},
+		Affected:      ReviewAssignments{},
+		Reassigned:    []Reassignment{},
+		Unfilled:      []Reassignment{},
+	}
+
+	existing := make(map[TeamName]map[MemberId]struct{}, len(current))
+	for _, t := range current {
+		ids := make(map[MemberId]struct{}, len(t.Members))
+		for _, m := range t.Members {
+			ids[m.Id] = struct{}{}
+		}
+		existing[t.Name] = ids
+	}
+
+	for _, t := range spec.Teams {
+		have, ok := existing[t.Name]
+		if !ok {
+			plan.CreateTeams = append(plan.CreateTeams, t.Name)
+		}
+
+		want := make(map[MemberId]struct{}, len(t.Members))
+		for _, m := range t.Members {
+			want[m.Id] = struct{}{}
+			if _, ok := have[m.Id]; !ok {
+				plan.AddMembers = append(plan.AddMembers, MembershipChange{Team: t.Name, MemberId: m.Id})
+			}
+		}
+
+		removed := make([]MemberId, 0)
+		for id := range have {
+			if _, ok := want[id]; !ok {
+				removed = append(removed, id)
+			}
+		}
+		slices.Sort(removed)
+		for _, id := range removed {
+			plan.RemoveMembers = append(plan.RemoveMembers, MembershipChange{Team: t.Name, MemberId: id})
+		}
+	}
+
+	byId := make(map[MemberId]Member, len(known))
+	for _, m := range known {
+		byId[m.Id] = m
+	}
+	for _, m := range spec.Members() {
+		cur, ok := byId[m.Id]
+		switch {
+		case !ok:
+			plan.CreateMembers = append(plan.CreateMembers, m.Id)
+			continue
+		case cur.Status.IsActive() && !m.Status.IsActive():
+			plan.Deactivate = append(plan.Deactivate, m.Id)
+		case !cur.Status.IsActive() && m.Status.IsActive():
+			plan.Activate = append(plan.Activate, m.Id)
+		}
+		if cur.Name != m.Name {
+			plan.Rename = append(plan.Rename, m.Id)
+		}
+	}
+
+	return plan
+}
+
+// Empty tells whether applying the plan changes nothing.
+func (p ReconcilePlan) Empty() bool {
+	return len(p.CreateTeams) == 0 && len(p.CreateMembers) == 0 &&
+		len(p.AddMembers) == 0 && len(p.RemoveMembers) == 0 &&
+		len(p.Activate) == 0 && len(p.Deactivate) == 0 && len(p.Rename) == 0
+}
+
+// Events announces the applied deactivations and the moved reviews.
+func (p ReconcilePlan) Events() []Event {
+	if !p.Applied {
+		return nil
+	}
+	return DeactivationReport{Deactivated: p.Deactivate, Reassigned: p.Reassigned}.Events()
+}
+
+// ByTeam groups member ids per team, teams keep the order of first appearance.
+func (mc MembershipChanges) ByTeam() ([]TeamName, map[TeamName][]MemberId) {
+	teams := make([]TeamName, 0)
+	ids := make(map[TeamName][]MemberId)
+	for _, c := range mc {
+		if _, ok := ids[c.Team]; !ok {
+			teams = append(teams, c.Team)
+		}
+		ids[c.Team] = append(ids[c.Team], c.MemberId)
+	}
+	return teams, ids
+}
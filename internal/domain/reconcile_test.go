@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+const (
+	reconcileAlice = MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001")
+	reconcileBob   = MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0002")
+	reconcileCarol = MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0003")
+)
+
+func reconcileMember(id MemberId, name string, active bool) Member {
+	return MemberBuilder(id).Name(name).Status(MemberStatusIsActiveByBool(active)).Build()
+}
+
+func TestOrgSpec_Valid(t *testing.T) {
+	alice := reconcileMember(reconcileAlice, "Alice", true)
+
+	tests := []struct {
+		name string
+		spec OrgSpec
+		want bool
+	}{
+		{
+			name: "member in two teams",
+			spec: OrgSpec{Teams: []Team{NewTeam("backend", alice), NewTeam("platform", alice)}},
+			want: true,
+		},
+		{name: "empty spec", spec: OrgSpec{}, want: true},
+		{name: "blank team name", spec: OrgSpec{Teams: []Team{NewTeam(" ")}}},
+		{name: "duplicate team", spec: OrgSpec{Teams: []Team{NewTeam("backend"), NewTeam("backend")}}},
+		{name: "invalid member id", spec: OrgSpec{Teams: []Team{NewTeam("backend", reconcileMember("u1", "Alice", true))}}},
+		{name: "blank member name", spec: OrgSpec{Teams: []Team{NewTeam("backend", reconcileMember(reconcileAlice, "", true))}}},
+		{name: "member twice in team", spec: OrgSpec{Teams: []Team{NewTeam("backend", alice, alice)}}},
+		{
+			name: "conflicting member status",
+			spec: OrgSpec{Teams: []Team{
+				NewTeam("backend", alice),
+				NewTeam("platform", reconcileMember(reconcileAlice, "Alice", false)),
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.spec.Valid(); got != tt.want {
+				t.Errorf("OrgSpec.Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewReconcilePlan(t *testing.T) {
+	spec := OrgSpec{Teams: []Team{
+		NewTeam("backend",
+			reconcileMember(reconcileAlice, "Alice Smith", true),
+			reconcileMember(reconcileCarol, "Carol", false),
+		),
+		NewTeam("platform", reconcileMember(reconcileAlice, "Alice Smith", true)),
+	}}
+	current := []Team{
+		NewTeam("backend",
+			reconcileMember(reconcileAlice, "Alice", false),
+			reconcileMember(reconcileBob, "Bob", true),
+		),
+	}
+	known := Members{
+		reconcileMember(reconcileAlice, "Alice", false),
+		reconcileMember(reconcileCarol, "Carol", true),
+	}
+
+	plan := NewReconcilePlan(spec, current, known)
+
+	want := ReconcilePlan{
+		CreateTeams:   []TeamName{"platform"},
+		CreateMembers: []MemberId{},
+		AddMembers: MembershipChanges{
+			{Team: "backend", MemberId: reconcileCarol},
+			{Team: "platform", MemberId: reconcileAlice},
+		},
+		RemoveMembers: MembershipChanges{{Team: "backend", MemberId: reconcileBob}},
+		Activate:      []MemberId{reconcileAlice},
+		Deactivate:    []MemberId{reconcileCarol},
+		Rename:        []MemberId{reconcileAlice},
+		Affected:      ReviewAssignments{},
+		Reassigned:    []Reassignment{},
+		Unfilled:      []Reassignment{},
+	}
+	if !reflect.DeepEqual(plan, want) {
+		t.Errorf("NewReconcilePlan() = %+v, want %+v", plan, want)
+	}
+	if plan.Empty() {
+		t.Errorf("ReconcilePlan.Empty() = true, want false")
+	}
+}
+
+func TestNewReconcilePlan_InSync(t *testing.T) {
+	alice := reconcileMember(reconcileAlice, "Alice", true)
+	spec := OrgSpec{Teams: []Team{NewTeam("backend", alice)}}
+
+	plan := NewReconcilePlan(spec, []Team{NewTeam("backend", alice)}, Members{alice})
+	if !plan.Empty() {
+		t.Errorf("NewReconcilePlan() = %+v, want empty plan", plan)
+	}
+
+	fresh := NewReconcilePlan(spec, nil, nil)
+	if !reflect.DeepEqual(fresh.CreateMembers, []MemberId{reconcileAlice}) {
+		t.Errorf("NewReconcilePlan() create members = %v, want %v", fresh.CreateMembers, []MemberId{reconcileAlice})
+	}
+}
+
+func TestMembershipChanges_ByTeam(t *testing.T) {
+	changes := MembershipChanges{
+		{Team: "platform", MemberId: reconcileAlice},
+		{Team: "backend", MemberId: reconcileBob},
+		{Team: "platform", MemberId: reconcileCarol},
+	}
+
+	teams, ids := changes.ByTeam()
+	if !reflect.DeepEqual(teams, []TeamName{"platform", "backend"}) {
+		t.Errorf("ByTeam() teams = %v", teams)
+	}
+	if !reflect.DeepEqual(ids["platform"], []MemberId{reconcileAlice, reconcileCarol}) {
+		t.Errorf("ByTeam() platform = %v", ids["platform"])
+	}
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "time"
+
+type ReviewState int
+
+const (
+	ReviewStatePending = iota
+	ReviewStateApproved
+	ReviewStateChangesRequested
+	ReviewStateCommented
+)
+
+var ReviewStateNames = map[ReviewState]string{
+	ReviewStatePending:          "PENDING",
+	ReviewStateApproved:         "APPROVED",
+	ReviewStateChangesRequested: "CHANGES_REQUESTED",
+	ReviewStateCommented:        "COMMENTED",
+}
+
+type Review struct {
+	PrId       PrId
+	MemberId   MemberId
+	State      ReviewState
+	ReviewedAt time.Time
+}
+
+type Reviews []Review
+
+func (s ReviewState) String() string {
+	if name, ok := ReviewStateNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// IsVerdict reports whether the state can be submitted by a reviewer, pending is only the initial state.
+func (s ReviewState) IsVerdict() bool {
+	switch s {
+	case ReviewStateApproved, ReviewStateChangesRequested, ReviewStateCommented:
+		return true
+	default:
+		return false
+	}
+}
+
+func ReviewStateFromString(s string) ReviewState {
+	for k, v := range ReviewStateNames {
+		if v == s {
+			return k
+		}
+	}
+	return ReviewStatePending
+}
+
+func (rs Reviews) Empty() bool {
+	return len(rs) == 0
+}
+
+func (rs Reviews) Slice() []Review {
+	return []Review(rs)
+}
+
+// Pending returns ids of reviewers that have not submitted a verdict yet.
+func (rs Reviews) Pending() []MemberId {
+	res := make([]MemberId, 0, len(rs))
+	for _, r := range rs {
+		if !r.State.IsVerdict() {
+			res = append(res, r.MemberId)
+		}
+	}
+	return res
+}
@@ -0,0 +1,61 @@
+package domain
+
+import "testing"
+
+func TestReviewStateFromString(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want ReviewState
+	}{
+		{name: "approved", s: "APPROVED", want: ReviewStateApproved},
+		{name: "changes requested", s: "CHANGES_REQUESTED", want: ReviewStateChangesRequested},
+		{name: "commented", s: "COMMENTED", want: ReviewStateCommented},
+		{name: "pending", s: "PENDING", want: ReviewStatePending},
+		{name: "unknown falls back to pending", s: "LGTM", want: ReviewStatePending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReviewStateFromString(tt.s); got != tt.want {
+				t.Errorf("ReviewStateFromString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviewState_IsVerdict(t *testing.T) {
+	tests := []struct {
+		state ReviewState
+		want  bool
+	}{
+		{state: ReviewStatePending, want: false},
+		{state: ReviewStateApproved, want: true},
+		{state: ReviewStateChangesRequested, want: true},
+		{state: ReviewStateCommented, want: true},
+		{state: ReviewState(42), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state.String(), func(t *testing.T) {
+			if got := tt.state.IsVerdict(); got != tt.want {
+				t.Errorf("ReviewState.IsVerdict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviews_Pending(t *testing.T) {
+	rs := Reviews{
+		{MemberId: "a", State: ReviewStatePending},
+		{MemberId: "b", State: ReviewStateApproved},
+		{MemberId: "c", State: ReviewStateChangesRequested},
+		{MemberId: "d", State: ReviewStatePending},
+	}
+
+	got := rs.Pending()
+
+	if len(got) != 2 || got[0] != "a" || got[1] != "d" {
+		t.Errorf("Reviews.Pending() = %v, want [a d]", got)
+	}
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "time"
+
+// StatsFilter narrows assignment statistics to a team and a [From, To) range, zero values leave them open.
+type StatsFilter struct {
+	Team TeamName
+	From time.Time
+	To   time.Time
+}
+
+func (f StatsFilter) Valid() bool {
+	return f.From.IsZero() || f.To.IsZero() || f.From.Before(f.To)
+}
+
+type MemberAssignmentStats struct {
+	MemberId       MemberId
+	Name           string
+	Total          int
+	Open           int
+	Merged         int
+	ReassignedAway int
+}
+
+type PrAssignmentStats struct {
+	PrId          PrId
+	Name          PrName
+	AuthorId      MemberId
+	Status        PrStatus
+	Team          TeamName
+	CreatedAt     time.Time
+	Reviewers     int
+	Reassignments int
+}
+
+// StatsPageRequest pages the users and the pull requests of the statistics independently.
+type StatsPageRequest struct {
+	Members      MemberPageRequest
+	PullRequests PageRequest
+}
+
+// AssignmentStats next cursors are empty on the last page of each list.
+type AssignmentStats struct {
+	Members                []MemberAssignmentStats
+	PullRequests           []PrAssignmentStats
+	NextMembersCursor      string
+	NextPullRequestsCursor string
+}
+
+// NewAssignmentStats builds the pages from rows read with the Fetch of each page request.
+func NewAssignmentStats(members []MemberAssignmentStats, prs []PrAssignmentStats, p StatsPageRequest) AssignmentStats {
+	var stats AssignmentStats
+	stats.Members, stats.NextMembersCursor = page(members, p.Members.Limit, func(m MemberAssignmentStats) MemberCursor {
+		return MemberCursor{Name: m.Name, Id: m.MemberId}
+	})
+	stats.PullRequests, stats.NextPullRequestsCursor = page(prs, p.PullRequests.Limit, func(pr PrAssignmentStats) PageCursor {
+		return PageCursor{CreatedAt: pr.CreatedAt, Id: pr.PrId}
+	})
+	return stats
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStatsFilter_Valid(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		filter StatsFilter
+		want   bool
+	}{
+		{name: "no range", filter: StatsFilter{}, want: true},
+		{name: "only from", filter: StatsFilter{From: now}, want: true},
+		{name: "only to", filter: StatsFilter{To: now}, want: true},
+		{name: "from before to", filter: StatsFilter{From: now, To: now.Add(time.Hour)}, want: true},
+		{name: "empty range", filter: StatsFilter{From: now, To: now}, want: false},
+		{name: "from after to", filter: StatsFilter{From: now.Add(time.Hour), To: now}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Valid(); got != tt.want {
+				t.Errorf("StatsFilter.Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
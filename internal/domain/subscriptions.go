@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventPrCreated          EventType = "pr.created"
+	EventReviewerAssigned   EventType = "reviewer.assigned"
+	EventReviewerReassigned EventType = "reviewer.reassigned"
+	EventPrMerged           EventType = "pr.merged"
+	EventMemberDeactivated  EventType = "member.deactivated"
+)
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+)
+
+// maxDeliveryDelay caps the backoff between delivery attempts.
+const maxDeliveryDelay = time.Hour
+
+type EventType string
+type EventId string
+type SubscriptionId string
+type DeliveryId string
+type DeliveryStatus string
+
+// Event is a committed change announced to the subscribers. PrId, AuthorId and Team describe the PR
+// of the PR events; MemberId is the deactivated member or the replaced reviewer of reviewer.reassigned;
+// Reviewers are the assigned reviewers, the replacement for reviewer.reassigned.
+type Event struct {
+	Id         EventId
+	Type       EventType
+	OccurredAt time.Time
+	PrId       PrId
+	AuthorId   MemberId
+	Team       TeamName
+	MemberId   MemberId
+	Reviewers  []MemberId
+}
+
+// Subscription receives the events of the listed types at Url, signed with Secret.
+type Subscription struct {
+	Id        SubscriptionId
+	Url       string
+	Secret    string
+	Events    []EventType
+	CreatedAt time.Time
+}
+
+// Delivery is one event sent to one subscription, kept in the delivery log with the outcome of the last attempt.
+// A pending delivery is attempted once NextAttemptAt has passed.
+type Delivery struct {
+	Id             DeliveryId
+	SubscriptionId SubscriptionId
+	EventId        EventId
+	EventType      EventType
+	Payload        []byte
+	Status         DeliveryStatus
+	Attempts       int
+	ResponseCode   int
+	LastError      string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// DueDelivery is a pending delivery claimed for an attempt, with the subscription it goes to.
+type DueDelivery struct {
+	Delivery     Delivery
+	Subscription Subscription
+}
+
+// DeliveryRetry retries a failed delivery up to MaxAttempts attempts in total,
+// waiting BaseDelay before the second attempt and twice as long before each next one.
+type DeliveryRetry struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+func (t EventType) IsValid() bool {
+	switch t {
+	case EventPrCreated, EventReviewerAssigned, EventReviewerReassigned, EventPrMerged, EventMemberDeactivated:
+		return true
+	}
+	return false
+}
+
+func (t EventType) String() string {
+	return string(t)
+}
+
+func (id SubscriptionId) IsValid() bool {
+	return uuid.Validate(string(id)) == nil
+}
+
+func (id SubscriptionId) String() string {
+	return string(id)
+}
+
+func (id DeliveryId) IsValid() bool {
+	return uuid.Validate(string(id)) == nil
+}
+
+func (id DeliveryId) String() string {
+	return string(id)
+}
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
+
+func newEvent(t EventType) Event {
+	return Event{
+		Id:         EventId(uuid.NewString()),
+		Type:       t,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func newPrEvent(t EventType, pr PullRequest) Event {
+	e := newEvent(t)
+	e.PrId = pr.Id
+	e.AuthorId = pr.AuthorId
+	e.Team = pr.Team
+	e.Reviewers = pr.AssignedReviews.Ids()
+	return e
+}
+
+func NewPrCreatedEvent(pr PullRequest) Event {
+	return newPrEvent(EventPrCreated, pr)
+}
+
+func NewPrMergedEvent(pr PullRequest) Event {
+	return newPrEvent(EventPrMerged, pr)
+}
+
+// NewReviewersAssignedEvents announces the reviewers of the PR, nothing when it has none.
+func NewReviewersAssignedEvents(pr PullRequest) []Event {
+	if pr.AssignedReviews.Empty() {
+		return nil
+	}
+	return []Event{newPrEvent(EventReviewerAssigned, pr)}
+}
+
+func NewReviewerReassignedEvent(r Reassignment) Event {
+	e := newEvent(EventReviewerReassigned)
+	e.PrId = r.PrId
+	e.MemberId = r.OldMemberId
+	e.Reviewers = []MemberId{r.NewMemberId}
+	return e
+}
+
+func NewReviewerReassignedEvents(rs []Reassignment) []Event {
+	events := make([]Event, 0, len(rs))
+	for _, r := range rs {
+		events = append(events, NewReviewerReassignedEvent(r))
+	}
+	return events
+}
+
+func NewMemberDeactivatedEvent(id MemberId) Event {
+	e := newEvent(EventMemberDeactivated)
+	e.MemberId = id
+	return e
+}
+
+// Events announces the deactivated members and the reviews moved away from them.
+func (r DeactivationReport) Events() []Event {
+	events := make([]Event, 0, len(r.Deactivated)+len(r.Reassigned))
+	for _, id := range r.Deactivated {
+		events = append(events, NewMemberDeactivatedEvent(id))
+	}
+	return append(events, NewReviewerReassignedEvents(r.Reassigned)...)
+}
+
+// Valid requires an absolute http(s) url and at least one event, all of the known types.
+func (s Subscription) Valid() bool {
+	u, err := url.Parse(s.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return false
+	}
+	if len(s.Events) == 0 {
+		return false
+	}
+	for _, t := range s.Events {
+		if !t.IsValid() {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Subscription) Matches(t EventType) bool {
+	return slices.Contains(s.Events, t)
+}
+
+// Delay is the wait before the attempt following the given one, false when no attempts are left.
+func (r DeliveryRetry) Delay(attempt int) (time.Duration, bool) {
+	if attempt >= r.MaxAttempts {
+		return 0, false
+	}
+	delay := r.BaseDelay
+	for i := 1; i < attempt && delay < maxDeliveryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDeliveryDelay), true
+}
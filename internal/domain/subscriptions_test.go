@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubscriptionValid(t *testing.T) {
+	tests := []struct {
+		name string
+		sub  Subscription
+		want bool
+	}{
+		{
+			name: "valid",
+			sub:  Subscription{Url: "https://hooks.example.com/reviews", Events: []EventType{EventPrCreated, EventReviewerAssigned}},
+			want: true,
+		},
+		{name: "no events", sub: Subscription{Url: "https://hooks.example.com"}},
+		{name: "unknown event", sub: Subscription{Url: "https://hooks.example.com", Events: []EventType{"pr.closed"}}},
+		{name: "relative url", sub: Subscription{Url: "/hooks", Events: []EventType{EventPrMerged}}},
+		{name: "not http", sub: Subscription{Url: "ftp://hooks.example.com", Events: []EventType{EventPrMerged}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sub.Valid(); got != tt.want {
+				t.Errorf("Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeliveryRetryDelay(t *testing.T) {
+	r := DeliveryRetry{MaxAttempts: 4, BaseDelay: time.Second}
+
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second} {
+		got, ok := r.Delay(attempt)
+		if !ok || got != want {
+			t.Errorf("Delay(%d) = %v, %v, want %v, true", attempt, got, ok, want)
+		}
+	}
+	if _, ok := r.Delay(4); ok {
+		t.Errorf("Delay(4) ok = true, want no attempts left")
+	}
+
+	long := DeliveryRetry{MaxAttempts: 100, BaseDelay: time.Minute}
+	if got, _ := long.Delay(90); got != maxDeliveryDelay {
+		t.Errorf("Delay(90) = %v, want the cap %v", got, maxDeliveryDelay)
+	}
+}
+
+func TestDeactivationReportEvents(t *testing.T) {
+	report := DeactivationReport{
+		Deactivated: []MemberId{"u1", "u2"},
+		Reassigned:  []Reassignment{{PrId: "pr1", OldMemberId: "u1", NewMemberId: "u3"}},
+	}
+
+	events := report.Events()
+	if len(events) != 3 {
+		t.Fatalf("Events() = %d events, want 3", len(events))
+	}
+	if events[0].Type != EventMemberDeactivated || events[0].MemberId != "u1" {
+		t.Errorf("Events()[0] = %+v, want member.deactivated of u1", events[0])
+	}
+	ra := events[2]
+	if ra.Type != EventReviewerReassigned || ra.PrId != "pr1" || ra.MemberId != "u1" || len(ra.Reviewers) != 1 || ra.Reviewers[0] != "u3" {
+		t.Errorf("Events()[2] = %+v, want reviewer.reassigned of pr1 from u1 to u3", ra)
+	}
+}
@@ -3,8 +3,38 @@ package domain
 type TeamName string
 
 type Team struct {
-	Name    TeamName
-	Members Members
+	Name     TeamName
+	Members  Members
+	Settings TeamSettings
+}
+
+type TeamSettings struct {
+	RequiredReviewers     int
+	DefaultReviewCapacity ReviewCapacity
+	MergePolicy           MergePolicyOverride
+}
+
+func DefaultTeamSettings() TeamSettings {
+	return TeamSettings{
+		RequiredReviewers:     DefaultRequiredReviewers,
+		DefaultReviewCapacity: UnlimitedReviewCapacity(),
+	}
+}
+
+type TeamMembership struct {
+	Team    TeamName
+	Primary bool
+}
+
+type TeamMemberships []TeamMembership
+
+func (tms TeamMemberships) Primary() TeamName {
+	for _, tm := range tms {
+		if tm.Primary {
+			return tm.Team
+		}
+	}
+	return TeamName("")
 }
 
 func NewTeam(name TeamName, members ...Member) Team {
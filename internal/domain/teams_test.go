@@ -96,3 +96,38 @@ func TestNewTeam(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamMemberships_Primary(t *testing.T) {
+	tests := []struct {
+		name string
+		tms  TeamMemberships
+		want TeamName
+	}{
+		{
+			name: "primary among several teams",
+			tms: TeamMemberships{
+				{Team: "backend"},
+				{Team: "platform", Primary: true},
+			},
+			want: "platform",
+		},
+		{
+			name: "no primary team",
+			tms:  TeamMemberships{{Team: "backend"}},
+			want: "",
+		},
+		{
+			name: "no teams",
+			tms:  nil,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tms.Primary(); got != tt.want {
+				t.Errorf("TeamMemberships.Primary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "strings"
+
+const (
+	PrEventOpened   PrEventAction = "opened"
+	PrEventReady    PrEventAction = "ready"
+	PrEventClosed   PrEventAction = "closed"
+	PrEventMerged   PrEventAction = "merged"
+	PrEventReopened PrEventAction = "reopened"
+)
+
+// PrEventAction is a change of a pull request in a VCS, mapped onto the PR lifecycle.
+type PrEventAction string
+
+// PullRequestEvent is a pull request change delivered by a VCS webhook.
+// Repository and Number identify the pull request within the provider: owner/repo and the PR number
+// on GitHub, the numeric project id and the MR iid on GitLab, where paths change on rename or transfer.
+// Author and Sender are logins of the provider, Sender is the one who made the change.
+type PullRequestEvent struct {
+	Provider   IdentityProvider
+	Action     PrEventAction
+	Repository string
+	Number     int
+	Title      PrName
+	Author     string
+	Sender     string
+	Draft      bool
+}
+
+// ExternalKey is the key of the pull request in the provider, the PR is stored under ExternalKey().PrId().
+func (e PullRequestEvent) ExternalKey() ExternalPrKey {
+	return NewExternalPrKey(e.Provider, e.Repository, e.Number)
+}
+
+func (e PullRequestEvent) PrId() PrId {
+	return e.ExternalKey().PrId()
+}
+
+func (e PullRequestEvent) AuthorIdentity() Identity {
+	return NewIdentity(e.Provider, e.Author)
+}
+
+// Audit attributes the change to the sender, prefixed with the provider.
+func (e PullRequestEvent) Audit() AssignmentAudit {
+	if e.Sender == "" {
+		return AssignmentAudit{}
+	}
+	return AssignmentAudit{Actor: NewIdentity(e.Provider, e.Sender).String()}
+}
+
+func (e PullRequestEvent) Valid() bool {
+	switch e.Action {
+	case PrEventOpened, PrEventReady, PrEventClosed, PrEventMerged, PrEventReopened:
+	default:
+		return false
+	}
+	return e.Provider.IsValid() && strings.TrimSpace(e.Repository) != "" && e.Number > 0
+}
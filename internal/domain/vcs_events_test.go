@@ -0,0 +1,40 @@
+package domain
+
+import "testing"
+
+func TestPullRequestEvent_Valid(t *testing.T) {
+	valid := PullRequestEvent{Provider: ProviderGithub, Action: PrEventOpened, Repository: "acme/api", Number: 1}
+
+	tests := []struct {
+		name  string
+		patch func(*PullRequestEvent)
+		want  bool
+	}{
+		{name: "valid", patch: func(*PullRequestEvent) {}, want: true},
+		{name: "unknown action", patch: func(e *PullRequestEvent) { e.Action = "labeled" }},
+		{name: "no repository", patch: func(e *PullRequestEvent) { e.Repository = " " }},
+		{name: "no number", patch: func(e *PullRequestEvent) { e.Number = 0 }},
+		{name: "bad provider", patch: func(e *PullRequestEvent) { e.Provider = "Git Hub" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := valid
+			tt.patch(&e)
+			if got := e.Valid(); got != tt.want {
+				t.Errorf("PullRequestEvent.Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestEvent_Audit(t *testing.T) {
+	e := PullRequestEvent{Provider: ProviderGithub, Sender: "octocat"}
+	if got := e.Audit(); got.Actor != "github:octocat" || got.Reason != "" {
+		t.Errorf("PullRequestEvent.Audit() = %+v, want actor github:octocat", got)
+	}
+
+	if got := (PullRequestEvent{Provider: ProviderGithub}).Audit(); got != (AssignmentAudit{}) {
+		t.Errorf("PullRequestEvent.Audit() = %+v, want empty audit without sender", got)
+	}
+}
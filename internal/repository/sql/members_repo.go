@@ -3,11 +3,14 @@ package sqlrepo
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	sqlstore "github.com/eragon-mdi/go-playground/storage/sql"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/repository/sql/queries"
+	servmembers "github.com/eragon-mdi/pr-reviewer-service/internal/service/members"
 	"github.com/go-faster/errors"
+	"github.com/lib/pq"
 )
 
 type membersRepo struct {
@@ -18,15 +21,63 @@ func NewMembersRepo(s sqlstore.Storage) *membersRepo {
 	return &membersRepo{s: s}
 }
 
-func (r *membersRepo) UpdateMemberStatus(memberId domain.MemberId, status domain.MemberStatus) (domain.Member, error) {
+func (r *membersRepo) UpdateMemberStatus(memberId domain.MemberId, status domain.MemberStatus) (domain.Member, bool, error) {
+	return updateMemberStatus(context.Background(), r.s, memberId, status)
+}
+
+func (r *membersRepo) BeginMemberStatusTx(ctx context.Context) (servmembers.MemberStatusTx, error) {
+	tx, err := r.s.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedStartTX)
+	}
+	return &memberStatusTx{deactivationTx: &deactivationTx{tx: tx}}, nil
+}
+
+// memberStatusTx reuses the team deactivation queries to move open reviews of a single member.
+type memberStatusTx struct {
+	*deactivationTx
+}
+
+func (mtx *memberStatusTx) UpdateMemberStatus(ctx context.Context, memberId domain.MemberId, status domain.MemberStatus) (domain.Member, bool, error) {
+	return updateMemberStatus(ctx, mtx.tx, memberId, status)
+}
+
+// updateMemberStatus reports whether the member had another status before.
+func updateMemberStatus(ctx context.Context, q querier, memberId domain.MemberId, status domain.MemberStatus) (domain.Member, bool, error) {
+	var row memberRow
+	var changed bool
+
+	err := q.QueryRowContext(ctx, queries.UpdateMemberStatus, memberId.String(), status.IsActive()).Scan(append(row.dest(), &changed)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Member{}, false, domain.ErrNotFound
+		}
+		return domain.Member{}, false, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	teams, err := getMemberTeams(ctx, q, domain.MemberId(row.uuid))
+	if err != nil {
+		return domain.Member{}, false, err
+	}
+
+	return row.member(teams), changed, nil
+}
+
+func (r *membersRepo) UpdateMemberReviewCapacity(memberId domain.MemberId, c domain.ReviewCapacity) (domain.Member, error) {
+	return queryMember(context.Background(), r.s, queries.UpdateMemberReviewCapacity, memberId.String(), nullReviewCapacity(c))
+}
+
+func (r *membersRepo) UpdateMemberPrimaryTeam(memberId domain.MemberId, teamName domain.TeamName) (domain.Member, error) {
 	ctx := context.Background()
 
-	var id int
-	var uuid string
-	var name string
-	var isActive bool
+	tx, err := r.s.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Member{}, errors.Wrap(err, ErrFailedStartTX)
+	}
+	defer tx.Rollback()
 
-	err := r.s.QueryRow(queries.UpdateMemberStatus, memberId.String(), status.IsActive()).Scan(&id, &uuid, &name, &isActive)
+	var memberID, teamID int
+	err = tx.QueryRowContext(ctx, queries.GetMemberTeamIds, memberId.String(), teamName.String()).Scan(&memberID, &teamID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.Member{}, domain.ErrNotFound
@@ -34,22 +85,188 @@ func (r *membersRepo) UpdateMemberStatus(memberId domain.MemberId, status domain
 		return domain.Member{}, errors.Wrap(err, ErrFailedQuery)
 	}
 
-	teamName, err := r.getTeamNameByMemberId(ctx, memberId)
-	if err != nil && !errors.Is(err, domain.ErrNotFound) {
-		return domain.Member{}, err
+	if _, err := tx.ExecContext(ctx, queries.ClearPrimaryTeam, memberID); err != nil {
+		return domain.Member{}, errors.Wrap(err, ErrFailedExec)
+	}
+	if _, err := tx.ExecContext(ctx, queries.SetPrimaryTeam, memberID, teamID); err != nil {
+		return domain.Member{}, errors.Wrap(err, ErrFailedExec)
 	}
 
-	member := domain.MemberBuilder(domain.MemberId(uuid)).
-		Name(name).
-		Status(domain.MemberStatusIsActiveByBool(isActive)).
-		Build()
-	member.Team = teamName
+	if err := tx.Commit(); err != nil {
+		return domain.Member{}, errors.Wrap(err, ErrFailedCommitTX)
+	}
+
+	return r.GetMember(memberId)
+}
+
+func (r *membersRepo) GetMember(memberId domain.MemberId) (domain.Member, error) {
+	return queryMember(context.Background(), r.s, queries.GetMemberWithCapacity, memberId.String())
+}
+
+func (r *membersRepo) UpdateMemberProfile(memberId domain.MemberId, patch domain.MemberPatch) (domain.Member, error) {
+	name, email, title := nullString(patch.Name), nullString(patch.Email), nullString(patch.Title)
+
+	return queryMember(context.Background(), r.s, queries.UpdateMemberProfile,
+		memberId.String(), name.Valid, name.String, email.Valid, email.String, title.Valid, title.String,
+	)
+}
+
+// ListMembers reads one page of the directory, teams of the whole page are loaded with a single query.
+func (r *membersRepo) ListMembers(filter domain.MemberFilter, page domain.MemberPageRequest) (domain.Members, error) {
+	ctx := context.Background()
+
+	var afterName, afterId sql.NullString
+	if page.After != nil {
+		afterName = sql.NullString{String: page.After.Name, Valid: true}
+		afterId = sql.NullString{String: page.After.Id.String(), Valid: true}
+	}
+	rows, err := r.s.QueryContext(ctx, queries.ListMembers,
+		filter.Name, filter.Team.String(), nullBool(filter.Active), afterName, afterId, page.Fetch(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	members := make([]memberRow, 0)
+	ids := make([]string, 0)
+	for rows.Next() {
+		var row memberRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		members = append(members, row)
+		ids = append(ids, row.uuid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	teams, err := getMembersTeams(ctx, r.s, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(domain.Members, 0, len(members))
+	for _, row := range members {
+		res = append(res, row.member(teams[domain.MemberId(row.uuid)]))
+	}
+
+	return res, nil
+}
+
+func (r *membersRepo) GetMemberIdByIdentity(ctx context.Context, identity domain.Identity) (domain.MemberId, error) {
+	var id string
+
+	err := r.s.QueryRowContext(ctx, queries.GetMemberIdByIdentity, identity.Provider.String(), identity.ExternalId).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrNotFound
+		}
+		return "", errors.Wrap(err, ErrFailedQuery)
+	}
+
+	return domain.MemberId(id), nil
+}
+
+// AddMemberIdentity is idempotent for the same member, an identity of another member is a duplicate.
+func (r *membersRepo) AddMemberIdentity(ctx context.Context, mi domain.MemberIdentity) error {
+	var id int
+
+	err := r.s.QueryRowContext(ctx, queries.AddMemberIdentity,
+		mi.MemberId.String(), mi.Identity.Provider.String(), mi.Identity.ExternalId,
+	).Scan(&id)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(err, ErrFailedQuery)
+	}
+
+	owner, err := r.GetMemberIdByIdentity(ctx, mi.Identity)
+	if err != nil {
+		// nothing was inserted and nobody owns the identity, so the member does not exist
+		return err
+	}
+	if owner != mi.MemberId {
+		return domain.ErrDuplicate
+	}
+	return nil
+}
+
+// GetMemberIdentities lists the linked identities, an unknown member is domain.ErrNotFound.
+func (r *membersRepo) GetMemberIdentities(ctx context.Context, memberId domain.MemberId) ([]domain.Identity, error) {
+	rows, err := r.s.QueryContext(ctx, queries.GetMemberIdentities, memberId.String())
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	found := false
+	identities := make([]domain.Identity, 0)
+	for rows.Next() {
+		var provider, externalId sql.NullString
+		if err := rows.Scan(&provider, &externalId); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		found = true
+		if provider.Valid {
+			identities = append(identities, domain.Identity{
+				Provider:   domain.IdentityProvider(provider.String),
+				ExternalId: externalId.String,
+			})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+	if !found {
+		return nil, domain.ErrNotFound
+	}
+
+	return identities, nil
+}
+
+// RemoveMemberIdentity is domain.ErrNotFound when the identity is not linked to the member.
+func (r *membersRepo) RemoveMemberIdentity(ctx context.Context, mi domain.MemberIdentity) error {
+	res, err := r.s.ExecContext(ctx, queries.RemoveMemberIdentity,
+		mi.MemberId.String(), mi.Identity.Provider.String(), mi.Identity.ExternalId,
+	)
+	if err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *membersRepo) GetMemberReviewCapacity(memberId domain.MemberId) (domain.ReviewCapacity, error) {
+	var capacity sql.NullInt64
+
+	err := r.s.QueryRow(queries.GetMemberReviewCapacity, memberId.String()).Scan(&capacity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ReviewCapacity{}, domain.ErrNotFound
+		}
+		return domain.ReviewCapacity{}, errors.Wrap(err, ErrFailedQuery)
+	}
 
-	return member, nil
+	return reviewCapacity(capacity), nil
 }
 
-func (r *membersRepo) GetPrReviewsByMember(memberId domain.MemberId) (domain.PullRequests, error) {
-	rows, err := r.s.Query(queries.GetPrReviewsByMember, memberId.String())
+func (r *membersRepo) GetPrReviewsByMember(
+	memberId domain.MemberId,
+	statuses []domain.PrStatus,
+	page domain.PageRequest,
+) (domain.PullRequests, error) {
+	args := append([]any{memberId.String(), pq.Array(prStatusNames(statuses))}, pageArgs(page)...)
+	rows, err := r.s.Query(queries.GetPrReviewsByMember, args...)
 	if err != nil {
 		return nil, errors.Wrap(err, ErrFailedQuery)
 	}
@@ -61,23 +278,18 @@ func (r *membersRepo) GetPrReviewsByMember(memberId domain.MemberId) (domain.Pul
 		var prName string
 		var authorID string
 		var status string
+		var createdAt time.Time
 
-		if err := rows.Scan(&prID, &prName, &authorID, &status); err != nil {
+		if err := rows.Scan(&prID, &prName, &authorID, &status, &createdAt); err != nil {
 			return nil, errors.Wrap(err, ErrFailedScan)
 		}
 
-		var prStatus domain.PrStatus
-		if status == "MERGED" {
-			prStatus = domain.PrStatusMerged
-		} else {
-			prStatus = domain.PrStatusOpen
-		}
-
 		pr := domain.PullRequestShort{
-			Id:       domain.PrId(prID),
-			Name:     domain.PrName(prName),
-			AuthorId: domain.MemberId(authorID),
-			Status:   prStatus,
+			Id:        domain.PrId(prID),
+			Name:      domain.PrName(prName),
+			AuthorId:  domain.MemberId(authorID),
+			Status:    prStatus(status),
+			CreatedAt: createdAt,
 		}
 		prs = append(prs, pr)
 	}
@@ -89,14 +301,132 @@ func (r *membersRepo) GetPrReviewsByMember(memberId domain.MemberId) (domain.Pul
 	return domain.PullRequests(prs), nil
 }
 
-func (r *membersRepo) getTeamNameByMemberId(ctx context.Context, memberId domain.MemberId) (domain.TeamName, error) {
-	var teamName string
-	err := r.s.QueryRowContext(ctx, queries.GetTeamNameByMemberId, memberId.String()).Scan(&teamName)
+func (r *membersRepo) CountOpenReviewsByMember(memberId domain.MemberId) (int, error) {
+	var count int
+	if err := r.s.QueryRow(queries.CountOpenReviewsByMember, memberId.String()).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, ErrFailedQuery)
+	}
+	return count, nil
+}
+
+func getMemberTeams(ctx context.Context, q querier, memberId domain.MemberId) (domain.TeamMemberships, error) {
+	rows, err := q.QueryContext(ctx, queries.GetTeamsByMemberId, memberId.String())
 	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	teams := make([]domain.TeamMembership, 0)
+	for rows.Next() {
+		var name string
+		var isPrimary bool
+
+		if err := rows.Scan(&name, &isPrimary); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		teams = append(teams, domain.TeamMembership{Team: domain.TeamName(name), Primary: isPrimary})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return domain.TeamMemberships(teams), nil
+}
+
+// queryMember reads a single row of queries.memberColumns and the member's teams.
+func queryMember(ctx context.Context, q querier, query string, args ...any) (domain.Member, error) {
+	var row memberRow
+
+	if err := q.QueryRowContext(ctx, query, args...).Scan(row.dest()...); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return domain.TeamName(""), domain.ErrNotFound
+			return domain.Member{}, domain.ErrNotFound
+		}
+		return domain.Member{}, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	teams, err := getMemberTeams(ctx, q, domain.MemberId(row.uuid))
+	if err != nil {
+		return domain.Member{}, err
+	}
+
+	return row.member(teams), nil
+}
+
+func getMembersTeams(ctx context.Context, q querier, ids []string) (map[domain.MemberId]domain.TeamMemberships, error) {
+	teams := make(map[domain.MemberId]domain.TeamMemberships, len(ids))
+	if len(ids) == 0 {
+		return teams, nil
+	}
+
+	rows, err := q.QueryContext(ctx, queries.GetTeamsByMemberIds, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		var name string
+		var isPrimary bool
+
+		if err := rows.Scan(&id, &name, &isPrimary); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
 		}
-		return domain.TeamName(""), errors.Wrap(err, ErrFailedQuery)
+		mId := domain.MemberId(id)
+		teams[mId] = append(teams[mId], domain.TeamMembership{Team: domain.TeamName(name), Primary: isPrimary})
 	}
-	return domain.TeamName(teamName), nil
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return teams, nil
+}
+
+type memberRow struct {
+	id       int
+	uuid     string
+	name     string
+	isActive bool
+	email    sql.NullString
+	title    sql.NullString
+	capacity sql.NullInt64
+}
+
+func (row *memberRow) dest() []any {
+	return []any{&row.id, &row.uuid, &row.name, &row.isActive, &row.email, &row.title, &row.capacity}
+}
+
+func (row *memberRow) member(teams domain.TeamMemberships) domain.Member {
+	if teams == nil {
+		teams = domain.TeamMemberships{}
+	}
+
+	return domain.MemberBuilder(domain.MemberId(row.uuid)).
+		Name(row.name).
+		Status(domain.MemberStatusIsActiveByBool(row.isActive)).
+		Capacity(reviewCapacity(row.capacity)).
+		Profile(domain.MemberProfile{Email: row.email.String, Title: row.title.String}).
+		Teams(teams).
+		Build()
+}
+
+func nullString(v *string) sql.NullString {
+	if v == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *v, Valid: true}
+}
+
+func reviewCapacity(c sql.NullInt64) domain.ReviewCapacity {
+	if !c.Valid {
+		return domain.UnlimitedReviewCapacity()
+	}
+	return domain.NewReviewCapacity(int(c.Int64))
+}
+
+func nullReviewCapacity(c domain.ReviewCapacity) sql.NullInt64 {
+	limit, ok := c.Limit()
+	return sql.NullInt64{Int64: int64(limit), Valid: ok}
 }
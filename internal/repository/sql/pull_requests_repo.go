@@ -42,7 +42,14 @@ func (r *pullRequestsRepo) CreatePullRequest(pr domain.PullRequest) (domain.Pull
 		return domain.PullRequest{}, errors.Wrap(err, ErrFailedQuery)
 	}
 
-	_, err = r.s.ExecContext(ctx, queries.CreatePullRequest, pr.Id.String(), pr.Name.String(), authorID)
+	reviewers := make([]string, 0, len(pr.AssignedReviews))
+	for _, m := range pr.AssignedReviews.Slice() {
+		reviewers = append(reviewers, m.Id.String())
+	}
+
+	_, err = r.s.ExecContext(ctx, queries.CreatePullRequest,
+		append([]any{pr.Id.String(), pr.Name.String(), authorID, pq.Array(reviewers), pr.Team.String(), pr.Status.String()},
+			externalKeyArgs(pr.External)...)...)
 	if err != nil {
 		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23505" {
 			return domain.PullRequest{}, domain.ErrDuplicate
@@ -63,88 +70,206 @@ func (r *pullRequestsRepo) CreatePullRequest(pr domain.PullRequest) (domain.Pull
 	return createdPr, nil
 }
 
+func (r *pullRequestsRepo) GetPullRequestCandidates(ctx context.Context, teamName domain.TeamName, authorId domain.MemberId) (domain.MembersHistories, error) {
+	rows, err := r.s.QueryContext(ctx, queries.GetPullRequestCandidates, teamName.String(), authorId.String())
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	candidates := make([]domain.MemberHistory, 0)
+	for rows.Next() {
+		var id int
+		var uuid string
+		var isActive bool
+		var load int
+		var capacity sql.NullInt64
+
+		if err := rows.Scan(&id, &uuid, &isActive, &load, &capacity); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+
+		candidate := domain.NewMemberHistory(
+			domain.MemberId(uuid),
+			domain.MemberStatusIsActiveByBool(isActive),
+			domain.MemberRoleDefault,
+			false,
+		).WithLoad(load).WithCapacity(reviewCapacity(capacity))
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return domain.MembersHistories(candidates), nil
+}
+
 func (r *pullRequestsRepo) GetPullRequestByUUID(ctx context.Context, prId domain.PrId) (domain.PullRequest, error) {
-	var id int
-	var uuid string
-	var title string
-	var authorUUID string
-	var status string
-	var createdAt time.Time
-	var mergedAt sql.NullTime
-	var version int
+	return getPullRequest(ctx, r.s, prId)
+}
 
-	err := r.s.QueryRow(queries.GetPullRequestByUUID, prId.String()).Scan(
-		&id, &uuid, &title, &authorUUID, &status, &createdAt, &mergedAt, &version,
-	)
+func (r *pullRequestsRepo) GetPullRequestIdByExternalKey(ctx context.Context, key domain.ExternalPrKey) (domain.PrId, error) {
+	var uuid string
+	err := r.s.QueryRowContext(ctx, queries.GetPullRequestUUIDByExternalKey,
+		key.Provider.String(), key.Repository, key.Number).Scan(&uuid)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return domain.PullRequest{}, domain.ErrNotFound
+			return "", domain.ErrNotFound
 		}
-		return domain.PullRequest{}, errors.Wrap(err, ErrFailedQuery)
+		return "", errors.Wrap(err, ErrFailedQuery)
 	}
 
-	var prStatus domain.PrStatus
-	if status == "MERGED" {
-		prStatus = domain.PrStatusMerged
-	} else {
-		prStatus = domain.PrStatusOpen
+	return domain.PrId(uuid), nil
+}
+
+func (r *pullRequestsRepo) ListPullRequests(
+	ctx context.Context,
+	filter domain.PrFilter,
+	page domain.PageRequest,
+) ([]domain.PullRequest, error) {
+	args := append([]any{
+		pq.Array(prStatusNames(filter.Statuses)),
+		filter.AuthorId.String(),
+		filter.ReviewerId.String(),
+		filter.Team.String(),
+		nullTime(filter.From),
+		nullTime(filter.To),
+	}, pageArgs(page)...)
+
+	rows, err := r.s.QueryContext(ctx, queries.ListPullRequests, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
 	}
+	defer rows.Close()
 
-	pr := domain.PullRequest{
-		Id:        domain.PrId(uuid),
-		Name:      domain.PrName(title),
-		AuthorId:  domain.MemberId(authorUUID),
-		Status:    prStatus,
-		CreatedAt: createdAt,
+	prs := make([]domain.PullRequest, 0)
+	for rows.Next() {
+		var uuid string
+		var title string
+		var authorUUID string
+		var status string
+		var teamName string
+		var createdAt time.Time
+		var mergedAt sql.NullTime
+		var version int
+		var external domain.ExternalPrKey
+
+		if err := rows.Scan(&uuid, &title, &authorUUID, &status, &teamName, &createdAt, &mergedAt, &version,
+			&external.Provider, &external.Repository, &external.Number); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+
+		pr := domain.PullRequest{
+			Id:        domain.PrId(uuid),
+			External:  external,
+			Name:      domain.PrName(title),
+			AuthorId:  domain.MemberId(authorUUID),
+			Team:      domain.TeamName(teamName),
+			Status:    prStatus(status),
+			CreatedAt: createdAt,
+			Version:   version,
+		}
+		if mergedAt.Valid {
+			pr.MergedAt = mergedAt.Time
+		}
+		prs = append(prs, pr)
 	}
 
-	if mergedAt.Valid {
-		pr.MergedAt = mergedAt.Time
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
 	}
 
-	reviewers, err := r.GetPullRequestReviewers(ctx, prId)
-	if err != nil && !errors.Is(err, domain.ErrNotFound) {
-		return domain.PullRequest{}, err
+	// a page holds at most MaxPageLimit+1 pull requests, so the reviewers are read one PR at a time
+	for i := range prs {
+		prs[i].AssignedReviews, prs[i].Reviews, err = getPullRequestReviewers(ctx, r.s, prs[i].Id)
+		if err != nil {
+			return nil, err
+		}
 	}
-	pr.AssignedReviews = reviewers
 
-	return pr, nil
+	return prs, nil
+}
+
+func (r *pullRequestsRepo) GetPullRequestReviewers(ctx context.Context, prId domain.PrId) (domain.Members, domain.Reviews, error) {
+	return getPullRequestReviewers(ctx, r.s, prId)
 }
 
-func (r *pullRequestsRepo) GetPullRequestReviewers(ctx context.Context, prId domain.PrId) (domain.Members, error) {
-	rows, err := r.s.Query(queries.GetPullRequestReviewers, prId.String())
+func (r *pullRequestsRepo) GetPullRequestHistory(ctx context.Context, prId domain.PrId) (domain.AssignmentEvents, error) {
+	var prID int
+	err := r.s.QueryRowContext(ctx, queries.GetPullRequestIdByUUID, prId.String()).Scan(&prID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	rows, err := r.s.QueryContext(ctx, queries.GetPullRequestHistory, prID)
 	if err != nil {
 		return nil, errors.Wrap(err, ErrFailedQuery)
 	}
 	defer rows.Close()
 
-	members := make([]domain.Member, 0)
+	events := make([]domain.AssignmentEvent, 0)
 	for rows.Next() {
-		var id int
-		var uuid string
-		var name string
-		var isActive bool
+		var eventType string
+		var oldUUID string
+		var newUUID string
+		var e domain.AssignmentEvent
 
-		if err := rows.Scan(&id, &uuid, &name, &isActive); err != nil {
+		if err := rows.Scan(&eventType, &oldUUID, &newUUID, &e.Actor, &e.Reason, &e.CreatedAt); err != nil {
 			return nil, errors.Wrap(err, ErrFailedScan)
 		}
-
-		status := domain.MemberStatusIsActiveByBool(isActive)
-		member := domain.MemberBuilder(domain.MemberId(uuid)).
-			Name(name).
-			Status(status).
-			Build()
-		members = append(members, member)
+		e.Type = domain.AssignmentEventType(eventType)
+		e.OldMemberId = domain.MemberId(oldUUID)
+		e.NewMemberId = domain.MemberId(newUUID)
+		events = append(events, e)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, errors.Wrap(err, ErrRowsIterations)
 	}
 
-	return domain.Members(members), nil
+	return domain.AssignmentEvents(events), nil
 }
 
-func (r *pullRequestsRepo) MergePullRequest(prId domain.PrId) (domain.PullRequest, error) {
+func (r *pullRequestsRepo) SubmitReview(review domain.Review, version int) (domain.PullRequest, error) {
+	ctx := context.Background()
+
+	var reviewedAt time.Time
+	err := r.s.QueryRow(queries.SubmitReview,
+		review.PrId.String(), review.MemberId.String(), review.State.String(), version).Scan(&reviewedAt)
+	if err == nil {
+		return r.GetPullRequestByUUID(ctx, review.PrId)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return domain.PullRequest{}, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	var status string
+	var current int
+	err = r.s.QueryRow(queries.CheckPRStatus, review.PrId.String()).Scan(&status, &current)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.PullRequest{}, domain.ErrNotFound
+		}
+		return domain.PullRequest{}, errors.Wrap(err, ErrFailedQuery)
+	}
+	if err := domain.CheckPrVersion(version, current); err != nil {
+		return domain.PullRequest{}, err
+	}
+	switch prStatus(status) {
+	case domain.PrStatusMerged:
+		return domain.PullRequest{}, domain.ErrConflict
+	case domain.PrStatusOpen:
+		return domain.PullRequest{}, domain.ErrForbidden
+	default:
+		return domain.PullRequest{}, domain.ErrInvalidPrState
+	}
+}
+
+func (r *pullRequestsRepo) MergePullRequest(prId domain.PrId, version int) (domain.PullRequest, error) {
 	ctx := context.Background()
 
 	pr, err := r.GetPullRequestByUUID(ctx, prId)
@@ -152,9 +277,15 @@ func (r *pullRequestsRepo) MergePullRequest(prId domain.PrId) (domain.PullReques
 		return domain.PullRequest{}, err
 	}
 
+	if err := domain.CheckPrVersion(version, pr.Version); err != nil {
+		return domain.PullRequest{}, err
+	}
 	if pr.Status == domain.PrStatusMerged {
 		return pr, nil
 	}
+	if err := domain.PrTransitionMerge.Validate(pr.Status); err != nil {
+		return domain.PullRequest{}, err
+	}
 
 	var id int
 	var uuid string
@@ -163,16 +294,27 @@ func (r *pullRequestsRepo) MergePullRequest(prId domain.PrId) (domain.PullReques
 	var statusID int
 	var createdAt time.Time
 	var mergedAt sql.NullTime
-	var version int
+	var newVersion int
 
-	err = r.s.QueryRow(queries.MergePullRequest, prId.String()).Scan(
-		&id, &uuid, &title, &authorID, &statusID, &createdAt, &mergedAt, &version,
+	err = r.s.QueryRow(queries.MergePullRequest, prId.String(), version).Scan(
+		&id, &uuid, &title, &authorID, &statusID, &createdAt, &mergedAt, &newVersion,
 	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return r.GetPullRequestByUUID(ctx, prId)
+		if !errors.Is(err, sql.ErrNoRows) {
+			return domain.PullRequest{}, errors.Wrap(err, ErrFailedQuery)
 		}
-		return domain.PullRequest{}, errors.Wrap(err, ErrFailedQuery)
+		// changed, closed or merged concurrently
+		pr, err := r.GetPullRequestByUUID(ctx, prId)
+		if err != nil {
+			return domain.PullRequest{}, err
+		}
+		if err := domain.CheckPrVersion(version, pr.Version); err != nil {
+			return domain.PullRequest{}, err
+		}
+		if pr.Status != domain.PrStatusMerged {
+			return domain.PullRequest{}, domain.ErrInvalidPrState
+		}
+		return pr, nil
 	}
 
 	return r.GetPullRequestByUUID(ctx, prId)
@@ -183,16 +325,40 @@ func (r *pullRequestsRepo) BeginReasignTx(ctx context.Context) (servpullrequests
 	if err != nil {
 		return nil, errors.Wrap(err, ErrFailedStartTX)
 	}
-	return &reassignTx{tx: tx, s: r.s}, nil
+	return &reassignTx{tx: tx}, nil
 }
 
 type reassignTx struct {
-	tx interface{}
-	s  sqlstore.Storage
+	tx *sql.Tx
+}
+
+// LockPullRequest locks the PR row until the end of the transaction and returns its status and version.
+func (rtx *reassignTx) LockPullRequest(ctx context.Context, prId domain.PrId) (domain.PrStatus, int, error) {
+	var status string
+	var version int
+	err := rtx.tx.QueryRowContext(ctx, queries.LockPRStatus, prId.String()).Scan(&status, &version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, domain.ErrNotFound
+		}
+		return 0, 0, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	return prStatus(status), version, nil
 }
 
-func (rtx *reassignTx) GetPullRequestMembersHistories(ctx context.Context, prId domain.PrId) (domain.MembersHistories, error) {
-	rows, err := rtx.s.Query(queries.GetPullRequestMembersHistories, prId.String(), "")
+func (rtx *reassignTx) IsMemberAssigned(ctx context.Context, prId domain.PrId, memberId domain.MemberId) (bool, error) {
+	var assigned bool
+	err := rtx.tx.QueryRowContext(ctx, queries.CheckMemberAssignedToPR, prId.String(), memberId.String()).Scan(&assigned)
+	if err != nil {
+		return false, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	return assigned, nil
+}
+
+func (rtx *reassignTx) GetPullRequestMembersHistories(ctx context.Context, prId domain.PrId, oldMemberId domain.MemberId) (domain.MembersHistories, error) {
+	rows, err := rtx.tx.QueryContext(ctx, queries.GetPullRequestMembersHistories, prId.String(), oldMemberId.String())
 	if err != nil {
 		return nil, errors.Wrap(err, ErrFailedQuery)
 	}
@@ -205,10 +371,11 @@ func (rtx *reassignTx) GetPullRequestMembersHistories(ctx context.Context, prId
 		var name string
 		var isActive bool
 		var role string
-		var assignedAt sql.NullTime
 		var wasAssignedBefore bool
+		var load int
+		var capacity sql.NullInt64
 
-		if err := rows.Scan(&id, &uuid, &name, &isActive, &role, &assignedAt, &wasAssignedBefore); err != nil {
+		if err := rows.Scan(&id, &uuid, &name, &isActive, &role, &wasAssignedBefore, &load, &capacity); err != nil {
 			return nil, errors.Wrap(err, ErrFailedScan)
 		}
 
@@ -227,7 +394,7 @@ func (rtx *reassignTx) GetPullRequestMembersHistories(ctx context.Context, prId
 			status,
 			memberRole,
 			wasAssignedBefore,
-		)
+		).WithLoad(load).WithCapacity(reviewCapacity(capacity))
 		histories = append(histories, history)
 	}
 
@@ -242,88 +409,224 @@ func (rtx *reassignTx) GetPullRequestMembersHistories(ctx context.Context, prId
 	return domain.MembersHistories(histories), nil
 }
 
-func (rtx *reassignTx) AssignMember(ctx context.Context, prId domain.PrId, newMemberId domain.MemberId) (domain.PullRequest, error) {
+func (rtx *reassignTx) AssignMember(
+	ctx context.Context,
+	prId domain.PrId,
+	oldMemberId, newMemberId domain.MemberId,
+	audit domain.AssignmentAudit,
+) (domain.PullRequest, error) {
+	var replaced bool
+
+	err := rtx.tx.QueryRowContext(ctx, queries.AssignMemberToPR,
+		prId.String(), oldMemberId.String(), newMemberId.String(), audit.Actor, audit.Reason).Scan(&replaced)
+	if err != nil {
+		return domain.PullRequest{}, errors.Wrap(err, ErrFailedQuery)
+	}
+	if !replaced {
+		return domain.PullRequest{}, domain.ErrForbidden
+	}
+
+	return getPullRequest(ctx, rtx.tx, prId)
+}
+
+func (r *pullRequestsRepo) BeginStatusTx(ctx context.Context) (servpullrequests.StatusTx, error) {
+	tx, err := r.s.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedStartTX)
+	}
+	return &statusTx{reassignTx: &reassignTx{tx: tx}}, nil
+}
+
+// statusTx moves a PR along its lifecycle, it shares locking and commit with reassignTx.
+type statusTx struct {
+	*reassignTx
+}
+
+func (stx *statusTx) GetPullRequest(ctx context.Context, prId domain.PrId) (domain.PullRequest, error) {
+	return getPullRequest(ctx, stx.tx, prId)
+}
+
+func (stx *statusTx) UpdateStatus(ctx context.Context, prId domain.PrId, status domain.PrStatus) error {
+	res, err := stx.tx.ExecContext(ctx, queries.UpdatePullRequestStatus, prId.String(), status.String())
+	if err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (stx *statusTx) AssignReviewers(ctx context.Context, prId domain.PrId, reviewers domain.Members, audit domain.AssignmentAudit) error {
+	ids := make([]string, 0, len(reviewers))
+	for _, m := range reviewers.Slice() {
+		ids = append(ids, m.Id.String())
+	}
+
+	_, err := stx.tx.ExecContext(ctx, queries.AssignPullRequestReviewers, prId.String(), pq.Array(ids), audit.Actor, audit.Reason)
+	if err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	return nil
+}
+
+func (stx *statusTx) ReleaseReviewers(ctx context.Context, prId domain.PrId, audit domain.AssignmentAudit) error {
+	_, err := stx.tx.ExecContext(ctx, queries.ReleasePullRequestReviewers, prId.String(), audit.Actor, audit.Reason)
+	if err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	return nil
+}
+
+func (rtx *reassignTx) Commit() error {
+	if err := rtx.tx.Commit(); err != nil {
+		return errors.Wrap(err, ErrFailedCommitTX)
+	}
+	return nil
+}
+
+func (rtx *reassignTx) Rollback() error {
+	if err := rtx.tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		return errors.Wrap(err, ErrFailedRollbackTX)
+	}
+	return nil
+}
+
+// querier is implemented by both the connection pool and a transaction.
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func scanReviewers(rows *sql.Rows, prId domain.PrId) (domain.Members, domain.Reviews, error) {
+	members := make([]domain.Member, 0)
+	reviews := make([]domain.Review, 0)
+	for rows.Next() {
+		var id int
+		var uuid string
+		var name string
+		var isActive bool
+		var state sql.NullString
+		var reviewedAt sql.NullTime
+
+		if err := rows.Scan(&id, &uuid, &name, &isActive, &state, &reviewedAt); err != nil {
+			return nil, nil, errors.Wrap(err, ErrFailedScan)
+		}
+
+		status := domain.MemberStatusIsActiveByBool(isActive)
+		member := domain.MemberBuilder(domain.MemberId(uuid)).
+			Name(name).
+			Status(status).
+			Build()
+		members = append(members, member)
+
+		review := domain.Review{
+			PrId:     prId,
+			MemberId: domain.MemberId(uuid),
+			State:    domain.ReviewStateFromString(state.String),
+		}
+		if reviewedAt.Valid {
+			review.ReviewedAt = reviewedAt.Time
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return domain.Members(members), domain.Reviews(reviews), nil
+}
+
+func getPullRequest(ctx context.Context, q querier, prId domain.PrId) (domain.PullRequest, error) {
 	var id int
 	var uuid string
 	var title string
 	var authorUUID string
 	var status string
+	var teamName string
 	var createdAt time.Time
 	var mergedAt sql.NullTime
 	var version int
+	var external domain.ExternalPrKey
 
-	err := rtx.s.QueryRow(queries.AssignMemberToPR, prId.String(), "", newMemberId.String()).Scan(
-		&id, &uuid, &title, &authorUUID, &status, &createdAt, &mergedAt, &version,
+	err := q.QueryRowContext(ctx, queries.GetPullRequestByUUID, prId.String()).Scan(
+		&id, &uuid, &title, &authorUUID, &status, &teamName, &createdAt, &mergedAt, &version,
+		&external.Provider, &external.Repository, &external.Number,
 	)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.PullRequest{}, domain.ErrNotFound
+		}
 		return domain.PullRequest{}, errors.Wrap(err, ErrFailedQuery)
 	}
 
-	var prStatus domain.PrStatus
-	if status == "MERGED" {
-		prStatus = domain.PrStatusMerged
-	} else {
-		prStatus = domain.PrStatusOpen
-	}
-
 	pr := domain.PullRequest{
 		Id:        domain.PrId(uuid),
+		External:  external,
 		Name:      domain.PrName(title),
 		AuthorId:  domain.MemberId(authorUUID),
-		Status:    prStatus,
+		Team:      domain.TeamName(teamName),
+		Status:    prStatus(status),
 		CreatedAt: createdAt,
+		Version:   version,
 	}
 
 	if mergedAt.Valid {
 		pr.MergedAt = mergedAt.Time
 	}
 
-	reviewers, err := rtx.getPullRequestReviewers(ctx, prId)
+	reviewers, reviews, err := getPullRequestReviewers(ctx, q, prId)
 	if err != nil && !errors.Is(err, domain.ErrNotFound) {
 		return domain.PullRequest{}, err
 	}
 	pr.AssignedReviews = reviewers
+	pr.Reviews = reviews
 
 	return pr, nil
 }
 
-func (rtx *reassignTx) getPullRequestReviewers(_ context.Context, prId domain.PrId) (domain.Members, error) {
-	rows, err := rtx.s.Query(queries.GetPullRequestReviewers, prId.String())
+func getPullRequestReviewers(ctx context.Context, q querier, prId domain.PrId) (domain.Members, domain.Reviews, error) {
+	rows, err := q.QueryContext(ctx, queries.GetPullRequestReviewers, prId.String())
 	if err != nil {
-		return nil, errors.Wrap(err, ErrFailedQuery)
+		return nil, nil, errors.Wrap(err, ErrFailedQuery)
 	}
 	defer rows.Close()
 
-	members := make([]domain.Member, 0)
-	for rows.Next() {
-		var id int
-		var uuid string
-		var name string
-		var isActive bool
-
-		if err := rows.Scan(&id, &uuid, &name, &isActive); err != nil {
-			return nil, errors.Wrap(err, ErrFailedScan)
-		}
-
-		status := domain.MemberStatusIsActiveByBool(isActive)
-		member := domain.MemberBuilder(domain.MemberId(uuid)).
-			Name(name).
-			Status(status).
-			Build()
-		members = append(members, member)
-	}
+	return scanReviewers(rows, prId)
+}
 
-	if err := rows.Err(); err != nil {
-		return nil, errors.Wrap(err, ErrRowsIterations)
+// externalKeyArgs stores an empty key as NULLs, keys are unique among the set ones only.
+func externalKeyArgs(k domain.ExternalPrKey) []any {
+	if k.Empty() {
+		return []any{nil, nil, nil}
 	}
+	return []any{k.Provider.String(), k.Repository, k.Number}
+}
 
-	return domain.Members(members), nil
+func prStatus(status string) domain.PrStatus {
+	s, _ := domain.PrStatusFromString(status)
+	return s
 }
 
-func (rtx *reassignTx) Commit() error {
-	return nil
+func prStatusNames(statuses []domain.PrStatus) []string {
+	names := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		names = append(names, s.String())
+	}
+	return names
 }
 
-func (rtx *reassignTx) Rollback() error {
-	return nil
+// pageArgs are the keyset cursor and the row limit, both cursor args are NULL on the first page.
+func pageArgs(page domain.PageRequest) []any {
+	if page.After == nil {
+		return []any{sql.NullTime{}, sql.NullString{}, page.Fetch()}
+	}
+	return []any{page.After.CreatedAt, page.After.Id.String(), page.Fetch()}
 }
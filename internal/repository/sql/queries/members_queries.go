@@ -1,19 +1,36 @@
 package queries
 
 const (
+	// UpdateMemberStatus also returns whether the status changed, the previous one is read under the row lock.
 	UpdateMemberStatus = `
-		UPDATE members
+		UPDATE members m
 		SET is_active = $2
-		WHERE uuid = $1
-		RETURNING id, uuid, name, is_active;
+		FROM (SELECT id, is_active FROM members WHERE uuid = $1 FOR UPDATE) prev
+		WHERE m.id = prev.id
+		RETURNING ` + memberColumns + `, prev.is_active <> $2;
 	`
 
+	UpdateMemberReviewCapacity = `
+		UPDATE members m
+		SET review_capacity = $2
+		WHERE m.uuid = $1
+		RETURNING ` + memberColumns + `;
+	`
+
+	GetMemberReviewCapacity = `
+		SELECT ` + effectiveReviewCapacity + `
+		FROM members m
+		WHERE m.uuid = $1;
+	`
+
+	// GetPrReviewsByMember pages the reviews with a keyset cursor ($3, $4), an empty $2 matches any status.
 	GetPrReviewsByMember = `
 		SELECT 
 			pr.uuid AS pull_request_id,
 			pr.title AS pull_request_name,
 			author.uuid AS author_id,
-			s.status AS status
+			s.status AS status,
+			pr.created_at
 		FROM pr_members pm
 		INNER JOIN pull_requests pr ON pm.pr_id = pr.id
 		INNER JOIN members reviewer ON pm.member_id = reviewer.id
@@ -21,8 +38,23 @@ const (
 		INNER JOIN statuses s ON pr.status_id = s.id
 		INNER JOIN roles r ON pm.role_id = r.id
 		WHERE reviewer.uuid = $1
-		  AND r.role = 'reviewer'
-		ORDER BY pr.created_at DESC;
+		  AND r.role IN ` + reviewerRoles + `
+		  AND (cardinality($2::text[]) = 0 OR s.status = ANY($2::text[]))
+		  AND ($3::timestamptz IS NULL OR (pr.created_at, pr.uuid) < ($3::timestamptz, $4::uuid))
+		ORDER BY pr.created_at DESC, pr.uuid DESC
+		LIMIT $5;
+	`
+
+	CountOpenReviewsByMember = `
+		SELECT COUNT(*)
+		FROM pr_members pm
+		INNER JOIN pull_requests pr ON pm.pr_id = pr.id
+		INNER JOIN members reviewer ON pm.member_id = reviewer.id
+		INNER JOIN statuses s ON pr.status_id = s.id
+		INNER JOIN roles r ON pm.role_id = r.id
+		WHERE reviewer.uuid = $1
+		  AND r.role IN ` + reviewerRoles + `
+		  AND s.status = 'OPEN';
 	`
 
 	GetMemberByUUID = `
@@ -31,6 +63,46 @@ const (
 		WHERE uuid = $1;
 	`
 
+	GetMembersByUUIDs = `
+		SELECT uuid, name, is_active
+		FROM members
+		WHERE uuid = ANY($1::uuid[]);
+	`
+
+	GetMemberWithCapacity = `
+		SELECT ` + memberColumns + `
+		FROM members m
+		WHERE m.uuid = $1;
+	`
+
+	// ListMembers pages the directory with a keyset cursor ($4, $5), empty filters ($1, $2, $3) match any member.
+	ListMembers = `
+		SELECT ` + memberColumns + `
+		FROM members m
+		WHERE ($1::text = '' OR strpos(lower(m.name), lower($1::text)) > 0)
+		  AND ($2::text = '' OR EXISTS (
+				SELECT 1
+				FROM members_teams mt
+				INNER JOIN teams t ON mt.team_id = t.id
+				WHERE mt.member_id = m.id
+				  AND t.name = $2::text
+			))
+		  AND ($3::boolean IS NULL OR m.is_active = $3::boolean)
+		  AND ($5::uuid IS NULL OR (m.name, m.uuid) > ($4::text, $5::uuid))
+		ORDER BY m.name, m.uuid
+		LIMIT $6;
+	`
+
+	// UpdateMemberProfile keeps a field when its flag ($2, $4, $6) is false, an empty email or title is stored as NULL.
+	UpdateMemberProfile = `
+		UPDATE members m
+		SET name = CASE WHEN $2::boolean THEN $3::text ELSE m.name END,
+			email = CASE WHEN $4::boolean THEN NULLIF($5::text, '') ELSE m.email END,
+			title = CASE WHEN $6::boolean THEN NULLIF($7::text, '') ELSE m.title END
+		WHERE m.uuid = $1
+		RETURNING ` + memberColumns + `;
+	`
+
 	GetActiveMembersByTeamId = `
 		SELECT m.id, m.uuid, m.name, m.is_active
 		FROM members m
@@ -40,4 +112,55 @@ const (
 		  AND m.uuid != $2
 		ORDER BY m.name;
 	`
+
+	GetMemberIdByIdentity = `
+		SELECT m.uuid
+		FROM member_identities mi
+		INNER JOIN members m ON mi.member_id = m.id
+		WHERE mi.provider = $1 AND lower(mi.external_id) = lower($2);
+	`
+
+	// AddMemberIdentity returns no row when the member is unknown or the identity is already linked.
+	AddMemberIdentity = `
+		INSERT INTO member_identities (member_id, provider, external_id)
+		SELECT m.id, $2, $3
+		FROM members m
+		WHERE m.uuid = $1
+		ON CONFLICT (provider, lower(external_id)) DO NOTHING
+		RETURNING id;
+	`
+
+	// GetMemberIdentities returns no row for an unknown member and a NULL row for a member without identities.
+	GetMemberIdentities = `
+		SELECT mi.provider, mi.external_id
+		FROM members m
+		LEFT JOIN member_identities mi ON mi.member_id = m.id
+		WHERE m.uuid = $1
+		ORDER BY mi.provider, lower(mi.external_id);
+	`
+
+	RemoveMemberIdentity = `
+		DELETE FROM member_identities mi
+		USING members m
+		WHERE mi.member_id = m.id
+		  AND m.uuid = $1
+		  AND mi.provider = $2
+		  AND lower(mi.external_id) = lower($3);
+	`
 )
+
+// memberColumns is the full member row scanned by the repository.
+const memberColumns = `m.id, m.uuid, m.name, m.is_active, m.email, m.title, ` + effectiveReviewCapacity
+
+// effectiveReviewCapacity is the member's own capacity or the default of the member's primary team, NULL means unlimited.
+const effectiveReviewCapacity = `
+	COALESCE(
+		m.review_capacity,
+		(
+			SELECT t.default_review_capacity
+			FROM teams t
+			INNER JOIN members_teams mt ON t.id = mt.team_id
+			WHERE mt.member_id = m.id
+			  AND mt.is_primary
+		)
+	) AS review_capacity`
@@ -3,47 +3,51 @@ package queries
 const (
 	CreatePullRequest = `
 		WITH pr_ins AS (
-			INSERT INTO pull_requests (uuid, title, author_id, status_id, created_at, version)
-			VALUES ($1, $2, $3, (SELECT id FROM statuses WHERE status = 'OPEN'), NOW(), 1)
+			INSERT INTO pull_requests (
+				uuid, title, author_id, team_id, status_id, created_at, version,
+				external_provider, external_repository, external_number
+			)
+			VALUES (
+				$1, $2, $3, (SELECT id FROM teams WHERE name = $5), (SELECT id FROM statuses WHERE status = $6), NOW(), 1,
+				$7, $8, $9
+			)
 			ON CONFLICT (uuid) DO NOTHING
-			RETURNING id, author_id
-		),
-		pr_existing AS (
-			SELECT id, author_id
-			FROM pull_requests
-			WHERE uuid = $1
+			RETURNING id
 		),
-		pr_id AS (
-			SELECT id, author_id FROM pr_ins
-			UNION ALL
-			SELECT id, author_id FROM pr_existing
-			WHERE NOT EXISTS (SELECT 1 FROM pr_ins)
+		assigned AS (
+			INSERT INTO pr_members (pr_id, member_id, role_id, assigned_at)
+			SELECT 
+				pr_ins.id,
+				m.id,
+				(SELECT id FROM roles WHERE role = 'reviewer'),
+				NOW()
+			FROM pr_ins
+			INNER JOIN members m ON m.uuid = ANY($4::uuid[])
+			ON CONFLICT (pr_id, member_id) DO NOTHING
+			RETURNING pr_id, member_id
 		),
-		reviewers AS (
-			SELECT m.id, m.uuid
-			FROM members m
-			INNER JOIN members_teams mt ON m.id = mt.member_id
-			INNER JOIN pr_id ON mt.team_id = (
-				SELECT mt2.team_id 
-				FROM members_teams mt2
-				INNER JOIN members m2 ON mt2.member_id = m2.id
-				WHERE m2.id = (SELECT author_id FROM pr_id LIMIT 1)
-				LIMIT 1
-			)
-			WHERE m.is_active = true
-			  AND m.id != (SELECT author_id FROM pr_id LIMIT 1)
-			ORDER BY RANDOM()
-			LIMIT 2
+		logged AS (
+			INSERT INTO pr_assignment_events (pr_id, event_type, new_member_id, actor, reason)
+			SELECT pr_id, 'assigned', member_id, 'system', 'pr_created'
+			FROM assigned
 		)
-		INSERT INTO pr_members (pr_id, member_id, role_id, assigned_at)
+		SELECT pr_id, member_id FROM assigned;
+	`
+
+	GetPullRequestCandidates = `
 		SELECT 
-			(SELECT id FROM pr_id LIMIT 1),
-			r.id,
-			(SELECT id FROM roles WHERE role = 'reviewer'),
-			NOW()
-		FROM reviewers r
-		ON CONFLICT (pr_id, member_id) DO NOTHING
-		RETURNING pr_id, member_id;
+			m.id,
+			m.uuid,
+			m.is_active,
+			` + openReviewLoad + ` AS load,
+			` + effectiveReviewCapacity + `
+		FROM members m
+		INNER JOIN members_teams mt ON m.id = mt.member_id
+		INNER JOIN teams t ON mt.team_id = t.id
+		WHERE t.name = $1
+		  AND m.is_active = true
+		  AND m.uuid != $2
+		ORDER BY m.name;
 	`
 
 	GetPullRequestByUUID = `
@@ -53,23 +57,66 @@ const (
 			pr.title,
 			author.uuid AS author_id,
 			s.status AS status,
+			COALESCE(t.name, '') AS team_name,
 			pr.created_at,
 			pr.merged_at,
-			pr.version
+			pr.version,
+			COALESCE(pr.external_provider, ''),
+			COALESCE(pr.external_repository, ''),
+			COALESCE(pr.external_number, 0)
 		FROM pull_requests pr
 		INNER JOIN members author ON pr.author_id = author.id
 		INNER JOIN statuses s ON pr.status_id = s.id
+		LEFT JOIN teams t ON pr.team_id = t.id
 		WHERE pr.uuid = $1;
 	`
 
+	// ListPullRequests pages pull requests with a keyset cursor ($7, $8), empty filters match any.
+	ListPullRequests = `
+		SELECT 
+			pr.uuid,
+			pr.title,
+			author.uuid AS author_id,
+			s.status AS status,
+			COALESCE(t.name, '') AS team_name,
+			pr.created_at,
+			pr.merged_at,
+			pr.version,
+			COALESCE(pr.external_provider, ''),
+			COALESCE(pr.external_repository, ''),
+			COALESCE(pr.external_number, 0)
+		FROM pull_requests pr
+		INNER JOIN members author ON pr.author_id = author.id
+		INNER JOIN statuses s ON pr.status_id = s.id
+		LEFT JOIN teams t ON pr.team_id = t.id
+		WHERE (cardinality($1::text[]) = 0 OR s.status = ANY($1::text[]))
+		  AND ($2::text = '' OR author.uuid::text = $2::text)
+		  AND ($3::text = '' OR EXISTS (
+			SELECT 1
+			FROM pr_members pm
+			INNER JOIN members m ON pm.member_id = m.id
+			INNER JOIN roles r ON pm.role_id = r.id
+			WHERE pm.pr_id = pr.id
+			  AND m.uuid::text = $3::text
+			  AND r.role IN ` + reviewerRoles + `
+		  ))
+		  AND ($4::text = '' OR t.name = $4::text)
+		  AND ($5::timestamptz IS NULL OR pr.created_at >= $5::timestamptz)
+		  AND ($6::timestamptz IS NULL OR pr.created_at < $6::timestamptz)
+		  AND ($7::timestamptz IS NULL OR (pr.created_at, pr.uuid) < ($7::timestamptz, $8::uuid))
+		ORDER BY pr.created_at DESC, pr.uuid DESC
+		LIMIT $9;
+	`
+
 	GetPullRequestReviewers = `
-		SELECT m.id, m.uuid, m.name, m.is_active
+		SELECT m.id, m.uuid, m.name, m.is_active, rs.state, pm.reviewed_at
 		FROM pr_members pm
 		INNER JOIN pull_requests pr ON pm.pr_id = pr.id
 		INNER JOIN members m ON pm.member_id = m.id
 		INNER JOIN roles r ON pm.role_id = r.id
+		LEFT JOIN review_states rs ON pm.review_state_id = rs.id
 		WHERE pr.uuid = $1
-		  AND r.role = 'reviewer'
+		  AND r.role IN ` + reviewerRoles + `
 		ORDER BY pm.assigned_at;
 	`
 
@@ -79,43 +126,52 @@ const (
 		    merged_at = COALESCE(merged_at, NOW()),
 		    version = version + 1
 		WHERE uuid = $1
-		  AND status_id != (SELECT id FROM statuses WHERE status = 'MERGED')
+		  AND status_id = (SELECT id FROM statuses WHERE status = 'OPEN')
+		  AND ($2::int = 0 OR version = $2::int)
 		RETURNING id, uuid, title, author_id, status_id, created_at, merged_at, version;
 	`
 
+	// GetPullRequestMembersHistories draws candidates from every team of the replaced reviewer ($2).
 	GetPullRequestMembersHistories = `
 		SELECT 
 			m.id,
 			m.uuid,
 			m.name,
 			m.is_active,
-			r.role,
-			pm.assigned_at,
 			CASE 
-				WHEN pm.member_id = $2 THEN true 
-				ELSE false 
-			END AS was_assigned_before
+				WHEN m.id = pr.author_id THEN 'author' 
+				WHEN EXISTS (
+					SELECT 1
+					FROM pr_assignment_events e
+					WHERE e.pr_id = pr.id
+					  AND e.old_member_id = m.id
+					  AND e.event_type = 'reassigned'
+				) THEN 'reassigned'
+				ELSE 'default' 
+			END AS role,
+			EXISTS (
+				SELECT 1
+				FROM pr_assignment_events e
+				WHERE e.pr_id = pr.id
+				  AND e.new_member_id = m.id
+			) AS was_assigned_before,
+			` + openReviewLoad + ` AS load,
+			` + effectiveReviewCapacity + `
 		FROM members m
-		INNER JOIN members_teams mt ON m.id = mt.member_id
-		INNER JOIN pull_requests pr ON mt.team_id = (
-			SELECT mt2.team_id 
-			FROM members_teams mt2
-			INNER JOIN members m2 ON mt2.member_id = m2.id
-			WHERE m2.id = (
-				SELECT pm2.member_id 
-				FROM pr_members pm2
-				INNER JOIN pull_requests pr2 ON pm2.pr_id = pr2.id
-				WHERE pr2.uuid = $1
-				LIMIT 1
-			)
-			LIMIT 1
-		)
-		LEFT JOIN pr_members pm ON pm.member_id = m.id AND pm.pr_id = (
-			SELECT id FROM pull_requests WHERE uuid = $1
-		)
-		LEFT JOIN roles r ON pm.role_id = r.id
+		INNER JOIN pull_requests pr ON pr.uuid = $1
 		WHERE EXISTS (
-			SELECT 1 FROM pull_requests WHERE uuid = $1
+			SELECT 1
+			FROM members_teams mt
+			INNER JOIN members_teams old_mt ON mt.team_id = old_mt.team_id
+			INNER JOIN members old ON old_mt.member_id = old.id
+			WHERE mt.member_id = m.id
+			  AND old.uuid = $2
+		)
+		  AND NOT EXISTS (
+			SELECT 1 
+			FROM pr_members pm
+			WHERE pm.pr_id = pr.id
+			  AND pm.member_id = m.id
 		)
 		ORDER BY m.name;
 	`
@@ -125,39 +181,150 @@ const (
 			DELETE FROM pr_members
 			WHERE pr_id = (SELECT id FROM pull_requests WHERE uuid = $1)
 			  AND member_id = (SELECT id FROM members WHERE uuid = $2)
-			  AND role_id = (SELECT id FROM roles WHERE role = 'reviewer')
+			  AND role_id IN (SELECT id FROM roles WHERE role IN ` + reviewerRoles + `)
 			RETURNING pr_id
 		),
 		new_assignment AS (
 			INSERT INTO pr_members (pr_id, member_id, role_id, assigned_at)
 			SELECT 
-				(SELECT id FROM pull_requests WHERE uuid = $1),
+				old_reviewer.pr_id,
 				(SELECT id FROM members WHERE uuid = $3),
 				(SELECT id FROM roles WHERE role = 'reviewer'),
 				NOW()
-			WHERE EXISTS (SELECT 1 FROM old_reviewer)
-			RETURNING pr_id
+			FROM old_reviewer
+			RETURNING pr_id, member_id
+		),
+		logged AS (
+			INSERT INTO pr_assignment_events (pr_id, event_type, old_member_id, new_member_id, actor, reason)
+			SELECT
+				new_assignment.pr_id,
+				'reassigned',
+				(SELECT id FROM members WHERE uuid = $2),
+				new_assignment.member_id,
+				$4::text,
+				$5::text
+			FROM new_assignment
+		),
+		bumped AS (
+			UPDATE pull_requests
+			SET version = version + 1
+			WHERE id IN (SELECT pr_id FROM new_assignment)
+			RETURNING id
 		)
-		SELECT 
-			pr.id,
-			pr.uuid,
-			pr.title,
-			author.uuid AS author_id,
-			s.status AS status,
-			pr.created_at,
-			pr.merged_at,
-			pr.version
+		SELECT EXISTS (SELECT 1 FROM bumped);
+	`
+
+	UpdatePullRequestStatus = `
+		UPDATE pull_requests
+		SET status_id = (SELECT id FROM statuses WHERE status = $2),
+		    version = version + 1
+		WHERE uuid = $1;
+	`
+
+	AssignPullRequestReviewers = `
+		WITH assigned AS (
+			INSERT INTO pr_members (pr_id, member_id, role_id, assigned_at)
+			SELECT
+				pr.id,
+				m.id,
+				(SELECT id FROM roles WHERE role = 'reviewer'),
+				NOW()
+			FROM pull_requests pr
+			INNER JOIN members m ON m.uuid = ANY($2::uuid[])
+			WHERE pr.uuid = $1
+			ON CONFLICT (pr_id, member_id) DO NOTHING
+			RETURNING pr_id, member_id
+		)
+		INSERT INTO pr_assignment_events (pr_id, event_type, new_member_id, actor, reason)
+		SELECT pr_id, 'assigned', member_id, $3::text, $4::text
+		FROM assigned;
+	`
+
+	ReleasePullRequestReviewers = `
+		WITH released AS (
+			DELETE FROM pr_members pm
+			USING pull_requests pr
+			WHERE pm.pr_id = pr.id
+			  AND pr.uuid = $1
+			  AND pm.role_id IN (SELECT id FROM roles WHERE role IN ` + reviewerRoles + `)
+			RETURNING pm.pr_id, pm.member_id
+		)
+		INSERT INTO pr_assignment_events (pr_id, event_type, old_member_id, actor, reason)
+		SELECT pr_id, 'unassigned', member_id, $2::text, $3::text
+		FROM released;
+	`
+
+	GetPullRequestUUIDByExternalKey = `
+		SELECT uuid
+		FROM pull_requests
+		WHERE external_provider = $1
+		  AND lower(external_repository) = lower($2)
+		  AND external_number = $3;
+	`
+
+	GetPullRequestIdByUUID = `
+		SELECT id
+		FROM pull_requests
+		WHERE uuid = $1;
+	`
+
+	GetPullRequestHistory = `
+		SELECT
+			e.event_type,
+			COALESCE(old_m.uuid::text, ''),
+			COALESCE(new_m.uuid::text, ''),
+			e.actor,
+			e.reason,
+			e.created_at
+		FROM pr_assignment_events e
+		LEFT JOIN members old_m ON e.old_member_id = old_m.id
+		LEFT JOIN members new_m ON e.new_member_id = new_m.id
+		WHERE e.pr_id = $1
+		ORDER BY e.created_at, e.id;
+	`
+
+	// SubmitReview is a compare-and-swap on the PR version ($4), 0 skips the check.
+	SubmitReview = `
+		WITH reviewed AS (
+			UPDATE pr_members pm
+			SET review_state_id = (SELECT id FROM review_states WHERE state = $3::text),
+			    role_id = (
+					SELECT id FROM roles
+					WHERE role = CASE WHEN $3::text = 'APPROVED' THEN 'approver' ELSE 'reviewer' END
+				),
+			    reviewed_at = NOW()
+			FROM pull_requests pr, members m, statuses s
+			WHERE pm.pr_id = pr.id
+			  AND pm.member_id = m.id
+			  AND pr.status_id = s.id
+			  AND pr.uuid = $1
+			  AND m.uuid = $2
+			  AND s.status = 'OPEN'
+			  AND ($4::int = 0 OR pr.version = $4::int)
+			  AND pm.role_id IN (SELECT id FROM roles WHERE role IN ` + reviewerRoles + `)
+			RETURNING pm.pr_id, pm.reviewed_at
+		),
+		bumped AS (
+			UPDATE pull_requests
+			SET version = version + 1
+			WHERE id IN (SELECT pr_id FROM reviewed)
+		)
+		SELECT reviewed_at FROM reviewed;
+	`
+
+	CheckPRStatus = `
+		SELECT s.status, pr.version
 		FROM pull_requests pr
-		INNER JOIN members author ON pr.author_id = author.id
 		INNER JOIN statuses s ON pr.status_id = s.id
 		WHERE pr.uuid = $1;
 	`
 
-	CheckPRStatus = `
-		SELECT s.status
+	LockPRStatus = `
+		SELECT s.status, pr.version
 		FROM pull_requests pr
 		INNER JOIN statuses s ON pr.status_id = s.id
-		WHERE pr.uuid = $1;
+		WHERE pr.uuid = $1
+		FOR UPDATE OF pr;
 	`
 
 	CheckMemberAssignedToPR = `
@@ -169,7 +336,22 @@ const (
 			INNER JOIN roles r ON pm.role_id = r.id
 			WHERE pr.uuid = $1
 			  AND m.uuid = $2
-			  AND r.role = 'reviewer'
+			  AND r.role IN ` + reviewerRoles + `
 		);
 	`
 )
+
+// reviewerRoles are the pr_members roles of an assigned reviewer, approver is a reviewer who approved the PR.
+const reviewerRoles = `('reviewer', 'approver')`
+
+// openReviewLoad counts the reviews of the member m in OPEN PRs from the current pr_members rows only:
+// a reassignment moves the row to the new reviewer, so the review no longer counts for the replaced one.
+const openReviewLoad = `(
+	SELECT COUNT(*)
+	FROM pr_members pml
+	INNER JOIN pull_requests prl ON pml.pr_id = prl.id
+	INNER JOIN statuses sl ON prl.status_id = sl.id
+	WHERE pml.member_id = m.id
+	  AND pml.role_id IN (SELECT id FROM roles WHERE role IN ` + reviewerRoles + `)
+	  AND sl.status = 'OPEN'
+)`
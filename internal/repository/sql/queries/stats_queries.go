@@ -0,0 +1,82 @@
+package queries
+
+// Stats queries share the filter parameters: $1 team name (empty matches any team),
+// $2 and $3 an optional [from, to) range, NULL leaves the bound open.
+// $4 and $5 are the keyset cursor of the previous page (NULL on the first one), $6 the number of rows to read.
+const (
+	GetMemberAssignmentStats = `
+		WITH assigned AS (
+			SELECT
+				pm.member_id,
+				COUNT(*) AS total,
+				COUNT(*) FILTER (WHERE s.status = 'OPEN') AS open,
+				COUNT(*) FILTER (WHERE s.status = 'MERGED') AS merged
+			FROM pr_members pm
+			INNER JOIN pull_requests pr ON pm.pr_id = pr.id
+			INNER JOIN statuses s ON pr.status_id = s.id
+			LEFT JOIN teams t ON pr.team_id = t.id
+			WHERE pm.role_id IN (SELECT id FROM roles WHERE role IN ` + reviewerRoles + `)
+			  AND ($1::text = '' OR t.name = $1::text)
+			  AND ($2::timestamptz IS NULL OR pm.assigned_at >= $2::timestamptz)
+			  AND ($3::timestamptz IS NULL OR pm.assigned_at < $3::timestamptz)
+			GROUP BY pm.member_id
+		),
+		reassigned_away AS (
+			SELECT e.old_member_id AS member_id, COUNT(*) AS total
+			FROM pr_assignment_events e
+			INNER JOIN pull_requests pr ON e.pr_id = pr.id
+			LEFT JOIN teams t ON pr.team_id = t.id
+			WHERE e.event_type = 'reassigned'
+			  AND ($1::text = '' OR t.name = $1::text)
+			  AND ($2::timestamptz IS NULL OR e.created_at >= $2::timestamptz)
+			  AND ($3::timestamptz IS NULL OR e.created_at < $3::timestamptz)
+			GROUP BY e.old_member_id
+		)
+		SELECT
+			m.uuid,
+			m.name,
+			COALESCE(a.total, 0),
+			COALESCE(a.open, 0),
+			COALESCE(a.merged, 0),
+			COALESCE(ra.total, 0)
+		FROM members m
+		LEFT JOIN assigned a ON a.member_id = m.id
+		LEFT JOIN reassigned_away ra ON ra.member_id = m.id
+		WHERE (a.member_id IS NOT NULL OR ra.member_id IS NOT NULL)
+		  AND ($5::uuid IS NULL OR (m.name, m.uuid) > ($4::text, $5::uuid))
+		ORDER BY m.name, m.uuid
+		LIMIT $6;
+	`
+
+	GetPullRequestAssignmentStats = `
+		SELECT
+			pr.uuid,
+			pr.title,
+			author.uuid,
+			s.status,
+			COALESCE(t.name, '') AS team_name,
+			pr.created_at,
+			(
+				SELECT COUNT(*)
+				FROM pr_members pm
+				WHERE pm.pr_id = pr.id
+				  AND pm.role_id IN (SELECT id FROM roles WHERE role IN ` + reviewerRoles + `)
+			) AS reviewers,
+			(
+				SELECT COUNT(*)
+				FROM pr_assignment_events e
+				WHERE e.pr_id = pr.id
+				  AND e.event_type = 'reassigned'
+			) AS reassignments
+		FROM pull_requests pr
+		INNER JOIN members author ON pr.author_id = author.id
+		INNER JOIN statuses s ON pr.status_id = s.id
+		LEFT JOIN teams t ON pr.team_id = t.id
+		WHERE ($1::text = '' OR t.name = $1::text)
+		  AND ($2::timestamptz IS NULL OR pr.created_at >= $2::timestamptz)
+		  AND ($3::timestamptz IS NULL OR pr.created_at < $3::timestamptz)
+		  AND ($4::timestamptz IS NULL OR (pr.created_at, pr.uuid) < ($4::timestamptz, $5::uuid))
+		ORDER BY pr.created_at DESC, pr.uuid DESC
+		LIMIT $6;
+	`
+)
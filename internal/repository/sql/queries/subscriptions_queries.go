@@ -0,0 +1,106 @@
+package queries
+
+const (
+	CreateSubscription = `
+		INSERT INTO webhook_subscriptions (uuid, url, secret, events)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + subscriptionColumns + `;
+	`
+
+	ListSubscriptions = `
+		SELECT ` + subscriptionColumns + `
+		FROM webhook_subscriptions
+		ORDER BY created_at, id;
+	`
+
+	GetSubscription = `
+		SELECT ` + subscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE uuid = $1;
+	`
+
+	DeleteSubscription = `
+		DELETE FROM webhook_subscriptions
+		WHERE uuid = $1;
+	`
+
+	// CreateDelivery returns no row when the subscription is gone, a NULL $7 makes it due at once.
+	CreateDelivery = `
+		WITH d AS (
+			INSERT INTO webhook_deliveries (uuid, subscription_id, event_id, event_type, payload, status, next_attempt_at)
+			SELECT $1, s.id, $3, $4, $5, $6, COALESCE($7, NOW())
+			FROM webhook_subscriptions s
+			WHERE s.uuid = $2
+			RETURNING *
+		)
+		SELECT ` + deliveryColumns + `
+		FROM d
+		INNER JOIN webhook_subscriptions s ON d.subscription_id = s.id;
+	`
+
+	// UpdateDelivery records the outcome of the last attempt, a zero $4 stores no response code
+	// and a NULL $6 keeps the time of the next attempt.
+	UpdateDelivery = `
+		UPDATE webhook_deliveries
+		SET status = $2,
+		    attempts = $3,
+		    response_code = NULLIF($4, 0),
+		    last_error = $5,
+		    next_attempt_at = COALESCE($6, next_attempt_at),
+		    updated_at = NOW()
+		WHERE uuid = $1;
+	`
+
+	// ClaimDeliveries takes up to $1 due pending deliveries, oldest due first, and postpones them
+	// by $2 seconds, so that other workers skip them while they are attempted.
+	ClaimDeliveries = `
+		WITH due AS (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), d AS (
+			UPDATE webhook_deliveries w
+			SET next_attempt_at = NOW() + make_interval(secs => $2)
+			FROM due
+			WHERE w.id = due.id
+			RETURNING w.*
+		)
+		SELECT ` + deliveryColumns + `, ` + subscriptionColumnsOf + `
+		FROM d
+		INNER JOIN webhook_subscriptions s ON d.subscription_id = s.id;
+	`
+
+	GetDelivery = `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries d
+		INNER JOIN webhook_subscriptions s ON d.subscription_id = s.id
+		WHERE d.uuid = $1;
+	`
+
+	GetSubscriptionDbId = `
+		SELECT id
+		FROM webhook_subscriptions
+		WHERE uuid = $1;
+	`
+
+	// ListDeliveries returns the latest $2 deliveries of the subscription row $1, newest first.
+	ListDeliveries = `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries d
+		INNER JOIN webhook_subscriptions s ON d.subscription_id = s.id
+		WHERE d.subscription_id = $1
+		ORDER BY d.created_at DESC, d.id DESC
+		LIMIT $2;
+	`
+)
+
+const subscriptionColumns = `uuid, url, secret, events, created_at`
+
+const subscriptionColumnsOf = `s.uuid, s.url, s.secret, s.events, s.created_at`
+
+const deliveryColumns = `
+	d.uuid, s.uuid, d.event_id, d.event_type, d.payload, d.status,
+	d.attempts, COALESCE(d.response_code, 0), d.last_error, d.next_attempt_at, d.created_at, d.updated_at`
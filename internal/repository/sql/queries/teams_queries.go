@@ -25,8 +25,13 @@ const (
 	`
 
 	LinkMembersToTeam = `
-		INSERT INTO members_teams (team_id, member_id)
-		SELECT $1, m.id
+		INSERT INTO members_teams (team_id, member_id, is_primary)
+		SELECT $1, m.id, NOT EXISTS (
+			SELECT 1
+			FROM members_teams p
+			WHERE p.member_id = m.id
+			  AND p.is_primary
+		)
 		FROM members m
 		WHERE m.uuid = ANY($2::uuid[])
 		ON CONFLICT (team_id, member_id) DO NOTHING;
@@ -41,12 +46,309 @@ const (
 		ORDER BY m.name;
 	`
 
+	UpdateTeamReviewCapacity = `
+		UPDATE teams
+		SET default_review_capacity = $2
+		WHERE name = $1
+		RETURNING name, ` + teamSettingsColumns + `;
+	`
+
+	UpdateTeamRequiredReviewers = `
+		UPDATE teams
+		SET required_reviewers = $2
+		WHERE name = $1
+		RETURNING name, ` + teamSettingsColumns + `;
+	`
+
+	UpdateTeamMergePolicy = `
+		UPDATE teams
+		SET merge_min_approvals = $2,
+		    merge_block_on_changes_requested = $3,
+		    merge_require_active_reviewers = $4
+		WHERE name = $1
+		RETURNING name, ` + teamSettingsColumns + `;
+	`
+
+	GetTeamSettingsByName = `
+		SELECT ` + teamSettingsColumns + `
+		FROM teams
+		WHERE name = $1;
+	`
+
 	GetTeamNameByMemberId = `
 		SELECT t.name
 		FROM teams t
 		INNER JOIN members_teams mt ON t.id = mt.team_id
 		INNER JOIN members m ON mt.member_id = m.id
 		WHERE m.uuid = $1
-		LIMIT 1;
+		  AND mt.is_primary;
+	`
+
+	// GetMemberTeam resolves the given team of the member, or the primary one when $2 is empty.
+	GetMemberTeam = `
+		SELECT t.name
+		FROM teams t
+		INNER JOIN members_teams mt ON t.id = mt.team_id
+		INNER JOIN members m ON mt.member_id = m.id
+		WHERE m.uuid = $1
+		  AND (t.name = $2::text OR ($2::text = '' AND mt.is_primary));
+	`
+
+	GetTeamsByMemberId = `
+		SELECT t.name, mt.is_primary
+		FROM teams t
+		INNER JOIN members_teams mt ON t.id = mt.team_id
+		INNER JOIN members m ON mt.member_id = m.id
+		WHERE m.uuid = $1
+		ORDER BY mt.is_primary DESC, t.name;
+	`
+
+	GetTeamsByMemberIds = `
+		SELECT m.uuid, t.name, mt.is_primary
+		FROM teams t
+		INNER JOIN members_teams mt ON t.id = mt.team_id
+		INNER JOIN members m ON mt.member_id = m.id
+		WHERE m.uuid = ANY($1::uuid[])
+		ORDER BY m.uuid, mt.is_primary DESC, t.name;
+	`
+
+	GetMemberTeamIds = `
+		SELECT mt.member_id, mt.team_id
+		FROM members_teams mt
+		INNER JOIN members m ON mt.member_id = m.id
+		INNER JOIN teams t ON mt.team_id = t.id
+		WHERE m.uuid = $1
+		  AND t.name = $2;
+	`
+
+	ClearPrimaryTeam = `
+		UPDATE members_teams
+		SET is_primary = false
+		WHERE member_id = $1
+		  AND is_primary;
+	`
+
+	GetTeamIdByName = `
+		SELECT id
+		FROM teams
+		WHERE name = $1;
+	`
+
+	// GetTeamReviewLoads counts the current reviewer rows assigned since $2, a review reassigned away counts for the new reviewer only.
+	GetTeamReviewLoads = `
+		SELECT m.uuid, m.name, COUNT(pr.id)
+		FROM members_teams mt
+		INNER JOIN members m ON mt.member_id = m.id
+		LEFT JOIN pr_members pm ON pm.member_id = m.id
+			AND pm.assigned_at >= $2
+			AND pm.role_id IN (SELECT id FROM roles WHERE role IN ` + reviewerRoles + `)
+		LEFT JOIN pull_requests pr ON pm.pr_id = pr.id
+			AND pr.team_id = mt.team_id
+		WHERE mt.team_id = $1
+		  AND m.is_active = true
+		GROUP BY m.id, m.uuid, m.name
+		ORDER BY m.name, m.uuid;
+	`
+
+	LockTeam = `
+		SELECT id
+		FROM teams
+		WHERE name = $1
+		FOR UPDATE;
+	`
+
+	// DeactivateTeamMembers returns only the members whose status changed.
+	DeactivateTeamMembers = `
+		UPDATE members m
+		SET is_active = false
+		FROM members_teams mt
+		WHERE mt.member_id = m.id
+		  AND mt.team_id = $1
+		  AND m.is_active = true
+		RETURNING m.uuid;
+	`
+
+	// GetOpenAssignmentsByMembers locks every OPEN PR reviewed by the members, the PR team falls back to the author's primary team.
+	// A non-empty $2 keeps only the PRs of that team.
+	GetOpenAssignmentsByMembers = `
+		SELECT
+			pr.uuid,
+			author.uuid,
+			COALESCE(t.name, '') AS team_name,
+			reviewer.uuid,
+			ARRAY(
+				SELECT p.uuid::text
+				FROM pr_members ppm
+				INNER JOIN members p ON ppm.member_id = p.id
+				WHERE ppm.pr_id = pr.id
+			) AS participants
+		FROM pr_members pm
+		INNER JOIN pull_requests pr ON pm.pr_id = pr.id
+		INNER JOIN statuses s ON pr.status_id = s.id
+		INNER JOIN roles r ON pm.role_id = r.id
+		INNER JOIN members reviewer ON pm.member_id = reviewer.id
+		INNER JOIN members author ON pr.author_id = author.id
+		LEFT JOIN teams t ON t.id = COALESCE(
+			pr.team_id,
+			(
+				SELECT amt.team_id
+				FROM members_teams amt
+				WHERE amt.member_id = author.id
+				  AND amt.is_primary
+			)
+		)
+		WHERE reviewer.uuid = ANY($1::uuid[])
+		  AND s.status = 'OPEN'
+		  AND r.role IN ` + reviewerRoles + `
+		  AND ($2::text = '' OR t.name = $2::text)
+		ORDER BY pr.id, pm.assigned_at
+		FOR UPDATE OF pr;
+	`
+
+	GetActiveMembersByTeams = `
+		SELECT
+			t.name,
+			m.uuid,
+			` + openReviewLoad + ` AS load,
+			` + effectiveReviewCapacity + `
+		FROM members m
+		INNER JOIN members_teams mt ON m.id = mt.member_id
+		INNER JOIN teams t ON mt.team_id = t.id
+		WHERE t.name = ANY($1::text[])
+		  AND m.is_active = true
+		ORDER BY t.name, m.name;
+	`
+
+	ReplaceReviewers = `
+		WITH changes AS (
+			SELECT pr.id AS pr_id, old_m.id AS old_id, new_m.id AS new_id
+			FROM UNNEST($1::uuid[], $2::uuid[], $3::uuid[]) AS c(pr_uuid, old_uuid, new_uuid)
+			INNER JOIN pull_requests pr ON pr.uuid = c.pr_uuid
+			INNER JOIN members old_m ON old_m.uuid = c.old_uuid
+			INNER JOIN members new_m ON new_m.uuid = c.new_uuid
+		),
+		removed AS (
+			DELETE FROM pr_members pm
+			USING changes c
+			WHERE pm.pr_id = c.pr_id
+			  AND pm.member_id = c.old_id
+			  AND pm.role_id IN (SELECT id FROM roles WHERE role IN ` + reviewerRoles + `)
+			RETURNING pm.pr_id, pm.member_id
+		),
+		added AS (
+			INSERT INTO pr_members (pr_id, member_id, role_id, assigned_at)
+			SELECT c.pr_id, c.new_id, (SELECT id FROM roles WHERE role = 'reviewer'), NOW()
+			FROM changes c
+			INNER JOIN removed r ON r.pr_id = c.pr_id AND r.member_id = c.old_id
+			RETURNING pr_id
+		),
+		logged AS (
+			INSERT INTO pr_assignment_events (pr_id, event_type, old_member_id, new_member_id, actor, reason)
+			SELECT c.pr_id, 'reassigned', c.old_id, c.new_id, $4::text, $5::text
+			FROM changes c
+			INNER JOIN removed r ON r.pr_id = c.pr_id AND r.member_id = c.old_id
+		)
+		UPDATE pull_requests
+		SET version = version + 1
+		WHERE id IN (SELECT pr_id FROM added);
+	`
+
+	UpsertMembers = `
+		INSERT INTO members (uuid, name, is_active)
+		SELECT * FROM UNNEST($1::uuid[], $2::varchar[], $3::boolean[])
+		ON CONFLICT (uuid) DO UPDATE
+		SET name = EXCLUDED.name,
+		    is_active = EXCLUDED.is_active;
+	`
+
+	RenameTeam = `
+		UPDATE teams
+		SET name = $2
+		WHERE name = $1
+		RETURNING name, ` + teamSettingsColumns + `;
+	`
+
+	// LockTeams locks the existing teams of the list in id order, so concurrent reconciles do not deadlock.
+	LockTeams = `
+		SELECT name
+		FROM teams
+		WHERE name = ANY($1::text[])
+		ORDER BY id
+		FOR UPDATE;
+	`
+
+	GetMembersByTeamNames = `
+		SELECT t.name, m.uuid, m.name, m.is_active
+		FROM teams t
+		INNER JOIN members_teams mt ON t.id = mt.team_id
+		INNER JOIN members m ON mt.member_id = m.id
+		WHERE t.name = ANY($1::text[])
+		ORDER BY t.name, m.name;
+	`
+
+	CreateTeams = `
+		INSERT INTO teams (name)
+		SELECT UNNEST($1::text[])
+		ON CONFLICT (name) DO NOTHING;
+	`
+
+	RemoveTeamMembers = `
+		DELETE FROM members_teams mt
+		USING members m, teams t
+		WHERE mt.member_id = m.id
+		  AND mt.team_id = t.id
+		  AND t.name = $1
+		  AND m.uuid = ANY($2::uuid[])
+		RETURNING m.uuid;
+	`
+
+	RemoveAllTeamMembers = `
+		DELETE FROM members_teams mt
+		USING members m, teams t
+		WHERE mt.member_id = m.id
+		  AND mt.team_id = t.id
+		  AND t.name = $1
+		RETURNING m.uuid;
+	`
+
+	// DeleteTeam leaves the team PRs without a team, they fall back to the author's primary team.
+	DeleteTeam = `
+		DELETE FROM teams
+		WHERE name = $1;
+	`
+
+	// PromotePrimaryTeams makes the oldest remaining team primary for members left without one.
+	PromotePrimaryTeams = `
+		UPDATE members_teams mt
+		SET is_primary = true
+		FROM members m
+		WHERE mt.member_id = m.id
+		  AND m.uuid = ANY($1::uuid[])
+		  AND mt.team_id = (
+			SELECT MIN(p.team_id)
+			FROM members_teams p
+			WHERE p.member_id = mt.member_id
+		  )
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM members_teams p
+			WHERE p.member_id = mt.member_id
+			  AND p.is_primary
+		  );
+	`
+
+	SetPrimaryTeam = `
+		UPDATE members_teams
+		SET is_primary = true
+		WHERE member_id = $1
+		  AND team_id = $2;
 	`
 )
+
+// teamSettingsColumns must be scanned in this order, nullable merge policy columns inherit the global policy.
+const teamSettingsColumns = `
+	required_reviewers,
+	default_review_capacity,
+	merge_min_approvals,
+	merge_block_on_changes_requested,
+	merge_require_active_reviewers`
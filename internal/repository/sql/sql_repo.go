@@ -4,25 +4,35 @@ import (
 	sqlstore "github.com/eragon-mdi/go-playground/storage/sql"
 	servmembers "github.com/eragon-mdi/pr-reviewer-service/internal/service/members"
 	servpullrequests "github.com/eragon-mdi/pr-reviewer-service/internal/service/pull-requests"
+	servstats "github.com/eragon-mdi/pr-reviewer-service/internal/service/stats"
+	servsubscriptions "github.com/eragon-mdi/pr-reviewer-service/internal/service/subscriptions"
 	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
+	servwebhooks "github.com/eragon-mdi/pr-reviewer-service/internal/service/webhooks"
 )
 
 type SqlRepo interface {
 	servteams.Repository
 	servmembers.Repository
 	servpullrequests.Repository
+	servstats.Repository
+	servwebhooks.Repository
+	servsubscriptions.Repository
 }
 
 type sqlRepo struct {
 	*teamsRepo
 	*membersRepo
 	*pullRequestsRepo
+	*statsRepo
+	*subscriptionsRepo
 }
 
 func New(s sqlstore.Storage) SqlRepo {
 	return &sqlRepo{
-		teamsRepo:        NewTeamsRepo(s),
-		membersRepo:      NewMembersRepo(s),
-		pullRequestsRepo: NewPullRequestsRepo(s),
+		teamsRepo:         NewTeamsRepo(s),
+		membersRepo:       NewMembersRepo(s),
+		pullRequestsRepo:  NewPullRequestsRepo(s),
+		statsRepo:         NewStatsRepo(s),
+		subscriptionsRepo: NewSubscriptionsRepo(s),
 	}
 }
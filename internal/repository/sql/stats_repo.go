@@ -0,0 +1,105 @@
+package sqlrepo
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sqlstore "github.com/eragon-mdi/go-playground/storage/sql"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/repository/sql/queries"
+	"github.com/go-faster/errors"
+)
+
+type statsRepo struct {
+	s sqlstore.Storage
+}
+
+func NewStatsRepo(s sqlstore.Storage) *statsRepo {
+	return &statsRepo{s: s}
+}
+
+func (r *statsRepo) GetMemberAssignmentStats(
+	ctx context.Context,
+	f domain.StatsFilter,
+	page domain.MemberPageRequest,
+) ([]domain.MemberAssignmentStats, error) {
+	var afterName, afterId sql.NullString
+	if page.After != nil {
+		afterName = sql.NullString{String: page.After.Name, Valid: true}
+		afterId = sql.NullString{String: page.After.Id.String(), Valid: true}
+	}
+	args := append(statsFilterArgs(f), afterName, afterId, page.Fetch())
+
+	rows, err := r.s.QueryContext(ctx, queries.GetMemberAssignmentStats, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	stats := make([]domain.MemberAssignmentStats, 0)
+	for rows.Next() {
+		var uuid string
+		var s domain.MemberAssignmentStats
+
+		if err := rows.Scan(&uuid, &s.Name, &s.Total, &s.Open, &s.Merged, &s.ReassignedAway); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		s.MemberId = domain.MemberId(uuid)
+		stats = append(stats, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return stats, nil
+}
+
+func (r *statsRepo) GetPullRequestAssignmentStats(
+	ctx context.Context,
+	f domain.StatsFilter,
+	page domain.PageRequest,
+) ([]domain.PrAssignmentStats, error) {
+	args := append(statsFilterArgs(f), pageArgs(page)...)
+
+	rows, err := r.s.QueryContext(ctx, queries.GetPullRequestAssignmentStats, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	stats := make([]domain.PrAssignmentStats, 0)
+	for rows.Next() {
+		var prUUID string
+		var title string
+		var authorUUID string
+		var status string
+		var teamName string
+		var s domain.PrAssignmentStats
+
+		if err := rows.Scan(&prUUID, &title, &authorUUID, &status, &teamName, &s.CreatedAt, &s.Reviewers, &s.Reassignments); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		s.PrId = domain.PrId(prUUID)
+		s.Name = domain.PrName(title)
+		s.AuthorId = domain.MemberId(authorUUID)
+		s.Status = prStatus(status)
+		s.Team = domain.TeamName(teamName)
+		stats = append(stats, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return stats, nil
+}
+
+func statsFilterArgs(f domain.StatsFilter) []any {
+	return []any{f.Team.String(), nullTime(f.From), nullTime(f.To)}
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
@@ -0,0 +1,229 @@
+package sqlrepo
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sqlstore "github.com/eragon-mdi/go-playground/storage/sql"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/repository/sql/queries"
+	"github.com/go-faster/errors"
+	"github.com/lib/pq"
+)
+
+type subscriptionsRepo struct {
+	s sqlstore.Storage
+}
+
+func NewSubscriptionsRepo(s sqlstore.Storage) *subscriptionsRepo {
+	return &subscriptionsRepo{s: s}
+}
+
+func (r *subscriptionsRepo) CreateSubscription(ctx context.Context, sub domain.Subscription) (domain.Subscription, error) {
+	events := make([]string, 0, len(sub.Events))
+	for _, t := range sub.Events {
+		events = append(events, t.String())
+	}
+
+	created, err := scanSubscription(r.s.QueryRowContext(ctx, queries.CreateSubscription,
+		sub.Id.String(), sub.Url, sub.Secret, pq.Array(events),
+	))
+	if err != nil {
+		return domain.Subscription{}, errors.Wrap(err, ErrFailedQuery)
+	}
+	return created, nil
+}
+
+func (r *subscriptionsRepo) ListSubscriptions(ctx context.Context) ([]domain.Subscription, error) {
+	rows, err := r.s.QueryContext(ctx, queries.ListSubscriptions)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	subs := make([]domain.Subscription, 0)
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		subs = append(subs, sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return subs, nil
+}
+
+func (r *subscriptionsRepo) GetSubscription(ctx context.Context, id domain.SubscriptionId) (domain.Subscription, error) {
+	sub, err := scanSubscription(r.s.QueryRowContext(ctx, queries.GetSubscription, id.String()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Subscription{}, domain.ErrNotFound
+		}
+		return domain.Subscription{}, errors.Wrap(err, ErrFailedQuery)
+	}
+	return sub, nil
+}
+
+// DeleteSubscription removes the subscription with its deliveries, an unknown one is domain.ErrNotFound.
+func (r *subscriptionsRepo) DeleteSubscription(ctx context.Context, id domain.SubscriptionId) error {
+	res, err := r.s.ExecContext(ctx, queries.DeleteSubscription, id.String())
+	if err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, ErrFailedAffectedRows)
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// CreateDelivery is domain.ErrNotFound when the subscription was removed meanwhile.
+func (r *subscriptionsRepo) CreateDelivery(ctx context.Context, d domain.Delivery) (domain.Delivery, error) {
+	created, err := scanDelivery(r.s.QueryRowContext(ctx, queries.CreateDelivery,
+		d.Id.String(), d.SubscriptionId.String(), string(d.EventId), d.EventType.String(), string(d.Payload), d.Status.String(),
+		nullTime(d.NextAttemptAt),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Delivery{}, domain.ErrNotFound
+		}
+		return domain.Delivery{}, errors.Wrap(err, ErrFailedQuery)
+	}
+	return created, nil
+}
+
+func (r *subscriptionsRepo) UpdateDelivery(ctx context.Context, d domain.Delivery) error {
+	_, err := r.s.ExecContext(ctx, queries.UpdateDelivery,
+		d.Id.String(), d.Status.String(), d.Attempts, d.ResponseCode, d.LastError, nullTime(d.NextAttemptAt),
+	)
+	if err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	return nil
+}
+
+func (r *subscriptionsRepo) GetDelivery(ctx context.Context, id domain.DeliveryId) (domain.Delivery, error) {
+	d, err := scanDelivery(r.s.QueryRowContext(ctx, queries.GetDelivery, id.String()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Delivery{}, domain.ErrNotFound
+		}
+		return domain.Delivery{}, errors.Wrap(err, ErrFailedQuery)
+	}
+	return d, nil
+}
+
+// ListDeliveries returns the latest deliveries of the subscription, an unknown one is domain.ErrNotFound.
+func (r *subscriptionsRepo) ListDeliveries(ctx context.Context, id domain.SubscriptionId, limit int) ([]domain.Delivery, error) {
+	var subID int
+	if err := r.s.QueryRowContext(ctx, queries.GetSubscriptionDbId, id.String()).Scan(&subID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	rows, err := r.s.QueryContext(ctx, queries.ListDeliveries, subID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	deliveries := make([]domain.Delivery, 0)
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDeliveries takes due pending deliveries for an attempt and holds them for the lease.
+func (r *subscriptionsRepo) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.DueDelivery, error) {
+	rows, err := r.s.QueryContext(ctx, queries.ClaimDeliveries, limit, lease.Seconds())
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	due := make([]domain.DueDelivery, 0, limit)
+	for rows.Next() {
+		var sub domain.Subscription
+		var subID string
+		var events []string
+
+		d, err := scanDelivery(rows, &subID, &sub.Url, &sub.Secret, pq.Array(&events), &sub.CreatedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+
+		sub.Id = domain.SubscriptionId(subID)
+		sub.Events = make([]domain.EventType, 0, len(events))
+		for _, t := range events {
+			sub.Events = append(sub.Events, domain.EventType(t))
+		}
+		due = append(due, domain.DueDelivery{Delivery: d, Subscription: sub})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return due, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSubscription(row rowScanner) (domain.Subscription, error) {
+	var sub domain.Subscription
+	var id string
+	var events []string
+
+	if err := row.Scan(&id, &sub.Url, &sub.Secret, pq.Array(&events), &sub.CreatedAt); err != nil {
+		return domain.Subscription{}, err
+	}
+
+	sub.Id = domain.SubscriptionId(id)
+	sub.Events = make([]domain.EventType, 0, len(events))
+	for _, t := range events {
+		sub.Events = append(sub.Events, domain.EventType(t))
+	}
+	return sub, nil
+}
+
+// scanDelivery scans the delivery columns followed by the extra ones.
+func scanDelivery(row rowScanner, extra ...any) (domain.Delivery, error) {
+	var d domain.Delivery
+	var id, subID, eventID, eventType, payload, status string
+
+	dest := []any{&id, &subID, &eventID, &eventType, &payload, &status,
+		&d.Attempts, &d.ResponseCode, &d.LastError, &d.NextAttemptAt, &d.CreatedAt, &d.UpdatedAt,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return domain.Delivery{}, err
+	}
+
+	d.Id = domain.DeliveryId(id)
+	d.SubscriptionId = domain.SubscriptionId(subID)
+	d.EventId = domain.EventId(eventID)
+	d.EventType = domain.EventType(eventType)
+	d.Payload = []byte(payload)
+	d.Status = domain.DeliveryStatus(status)
+	return d, nil
+}
@@ -3,10 +3,12 @@ package sqlrepo
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	sqlstore "github.com/eragon-mdi/go-playground/storage/sql"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/repository/sql/queries"
+	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
 	"github.com/go-faster/errors"
 	"github.com/lib/pq"
 )
@@ -101,7 +103,7 @@ func (r *teamsRepo) CreateTeamWithMembers(teamName domain.TeamName, members doma
 }
 
 func (r *teamsRepo) GetTeamWithMembers(ctx context.Context, teamName domain.TeamName) (domain.Team, error) {
-	rows, err := r.s.Query(queries.GetMembersByTeamName, teamName.String())
+	rows, err := r.s.QueryContext(ctx, queries.GetMembersByTeamName, teamName.String())
 	if err != nil {
 		return domain.Team{}, errors.Wrap(err, ErrFailedQuery)
 	}
@@ -181,3 +183,619 @@ func (r *teamsRepo) GetTeamNameByMemberId(ctx context.Context, memberId domain.M
 	}
 	return domain.TeamName(teamName), nil
 }
+
+func (r *teamsRepo) UpdateTeamReviewCapacity(teamName domain.TeamName, c domain.ReviewCapacity) (domain.Team, error) {
+	return r.updateTeamSettings(queries.UpdateTeamReviewCapacity, teamName, nullReviewCapacity(c))
+}
+
+func (r *teamsRepo) UpdateTeamRequiredReviewers(teamName domain.TeamName, required int) (domain.Team, error) {
+	return r.updateTeamSettings(queries.UpdateTeamRequiredReviewers, teamName, required)
+}
+
+func (r *teamsRepo) UpdateTeamMergePolicy(teamName domain.TeamName, p domain.MergePolicyOverride) (domain.Team, error) {
+	return r.updateTeamSettings(queries.UpdateTeamMergePolicy, teamName,
+		nullInt(p.MinApprovals), nullBool(p.BlockOnChangesRequested), nullBool(p.RequireActiveReviewers))
+}
+
+func (r *teamsRepo) GetMemberTeam(memberId domain.MemberId, teamName domain.TeamName) (domain.TeamName, error) {
+	var name string
+	err := r.s.QueryRow(queries.GetMemberTeam, memberId.String(), teamName.String()).Scan(&name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.TeamName(""), domain.ErrNotFound
+		}
+		return domain.TeamName(""), errors.Wrap(err, ErrFailedQuery)
+	}
+	return domain.TeamName(name), nil
+}
+
+func (r *teamsRepo) GetTeamSettings(teamName domain.TeamName) (domain.TeamSettings, error) {
+	var row teamSettingsRow
+
+	err := r.s.QueryRow(queries.GetTeamSettingsByName, teamName.String()).Scan(row.dest()...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.DefaultTeamSettings(), nil
+		}
+		return domain.TeamSettings{}, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	return row.settings(), nil
+}
+
+func (r *teamsRepo) GetTeamReviewLoads(ctx context.Context, teamName domain.TeamName, since time.Time) ([]domain.MemberLoad, error) {
+	var teamID int
+	err := r.s.QueryRowContext(ctx, queries.GetTeamIdByName, teamName.String()).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	rows, err := r.s.QueryContext(ctx, queries.GetTeamReviewLoads, teamID, since)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	loads := make([]domain.MemberLoad, 0)
+	for rows.Next() {
+		var uuid string
+		var l domain.MemberLoad
+
+		if err := rows.Scan(&uuid, &l.Name, &l.Load); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		l.MemberId = domain.MemberId(uuid)
+		loads = append(loads, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return loads, nil
+}
+
+func (r *teamsRepo) AddTeamMembers(teamName domain.TeamName, members domain.Members) (domain.Team, error) {
+	ctx := context.Background()
+
+	tx, err := r.s.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Team{}, errors.Wrap(err, ErrFailedStartTX)
+	}
+	defer tx.Rollback()
+
+	var teamID int
+	err = tx.QueryRowContext(ctx, queries.LockTeam, teamName.String()).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Team{}, domain.ErrNotFound
+		}
+		return domain.Team{}, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	if err = upsertMembers(ctx, tx, members); err != nil {
+		return domain.Team{}, err
+	}
+
+	if err = linkMembersToTeam(ctx, tx, teamID, members.Ids()); err != nil {
+		return domain.Team{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Team{}, errors.Wrap(err, ErrFailedCommitTX)
+	}
+
+	return r.GetTeamWithMembers(ctx, teamName)
+}
+
+func (r *teamsRepo) RenameTeam(teamName, newName domain.TeamName) (domain.Team, error) {
+	team, err := r.updateTeamSettings(queries.RenameTeam, teamName, newName.String())
+	if err != nil {
+		var pgErr *pq.Error
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.Team{}, domain.ErrDuplicate
+		}
+		return domain.Team{}, err
+	}
+	return team, nil
+}
+
+func (r *teamsRepo) updateTeamSettings(query string, teamName domain.TeamName, values ...any) (domain.Team, error) {
+	var name string
+	var row teamSettingsRow
+
+	err := r.s.QueryRow(query, append([]any{teamName.String()}, values...)...).Scan(append([]any{&name}, row.dest()...)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Team{}, domain.ErrNotFound
+		}
+		return domain.Team{}, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	team := domain.NewTeam(domain.TeamName(name))
+	team.Settings = row.settings()
+
+	return team, nil
+}
+
+type teamSettingsRow struct {
+	required      int
+	capacity      sql.NullInt64
+	minApprovals  sql.NullInt64
+	blockChanges  sql.NullBool
+	requireActive sql.NullBool
+}
+
+func (row *teamSettingsRow) dest() []any {
+	return []any{&row.required, &row.capacity, &row.minApprovals, &row.blockChanges, &row.requireActive}
+}
+
+func (row *teamSettingsRow) settings() domain.TeamSettings {
+	var policy domain.MergePolicyOverride
+	if row.minApprovals.Valid {
+		v := int(row.minApprovals.Int64)
+		policy.MinApprovals = &v
+	}
+	if row.blockChanges.Valid {
+		v := row.blockChanges.Bool
+		policy.BlockOnChangesRequested = &v
+	}
+	if row.requireActive.Valid {
+		v := row.requireActive.Bool
+		policy.RequireActiveReviewers = &v
+	}
+
+	return domain.TeamSettings{
+		RequiredReviewers:     row.required,
+		DefaultReviewCapacity: reviewCapacity(row.capacity),
+		MergePolicy:           policy,
+	}
+}
+
+func nullInt(v *int) sql.NullInt64 {
+	if v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*v), Valid: true}
+}
+
+func nullBool(v *bool) sql.NullBool {
+	if v == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *v, Valid: true}
+}
+
+func (r *teamsRepo) BeginDeactivationTx(ctx context.Context) (servteams.DeactivationTx, error) {
+	tx, err := r.s.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedStartTX)
+	}
+	return &deactivationTx{tx: tx}, nil
+}
+
+type deactivationTx struct {
+	tx *sql.Tx
+}
+
+func (dtx *deactivationTx) DeactivateTeamMembers(ctx context.Context, teamName domain.TeamName) ([]domain.MemberId, error) {
+	var teamID int
+	err := dtx.tx.QueryRowContext(ctx, queries.LockTeam, teamName.String()).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+
+	rows, err := dtx.tx.QueryContext(ctx, queries.DeactivateTeamMembers, teamID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	ids := make([]domain.MemberId, 0)
+	for rows.Next() {
+		var uuid string
+		if err := rows.Scan(&uuid); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		ids = append(ids, domain.MemberId(uuid))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return ids, nil
+}
+
+func (dtx *deactivationTx) GetOpenAssignments(ctx context.Context, memberIds []domain.MemberId) (domain.ReviewAssignments, error) {
+	return dtx.openAssignments(ctx, domain.TeamName(""), memberIds)
+}
+
+func (dtx *deactivationTx) openAssignments(ctx context.Context, teamName domain.TeamName, memberIds []domain.MemberId) (domain.ReviewAssignments, error) {
+	rows, err := dtx.tx.QueryContext(ctx, queries.GetOpenAssignmentsByMembers, pq.Array(memberIdStrings(memberIds)), teamName.String())
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	assignments := make([]domain.ReviewAssignment, 0)
+	for rows.Next() {
+		var prUUID string
+		var authorUUID string
+		var teamName string
+		var reviewerUUID string
+		var participants []string
+
+		if err := rows.Scan(&prUUID, &authorUUID, &teamName, &reviewerUUID, pq.Array(&participants)); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+
+		a := domain.ReviewAssignment{
+			PrId:         domain.PrId(prUUID),
+			AuthorId:     domain.MemberId(authorUUID),
+			Team:         domain.TeamName(teamName),
+			MemberId:     domain.MemberId(reviewerUUID),
+			Participants: make([]domain.MemberId, 0, len(participants)),
+		}
+		for _, p := range participants {
+			a.Participants = append(a.Participants, domain.MemberId(p))
+		}
+		assignments = append(assignments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return domain.ReviewAssignments(assignments), nil
+}
+
+func (dtx *deactivationTx) GetReplacementCandidates(ctx context.Context, teams []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error) {
+	names := make([]string, 0, len(teams))
+	for _, t := range teams {
+		names = append(names, t.String())
+	}
+
+	rows, err := dtx.tx.QueryContext(ctx, queries.GetActiveMembersByTeams, pq.Array(names))
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	pools := make(map[domain.TeamName]domain.MembersHistories, len(teams))
+	for rows.Next() {
+		var teamName string
+		var uuid string
+		var load int
+		var capacity sql.NullInt64
+
+		if err := rows.Scan(&teamName, &uuid, &load, &capacity); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+
+		candidate := domain.NewMemberHistory(
+			domain.MemberId(uuid),
+			domain.MemberStatusActive,
+			domain.MemberRoleDefault,
+			false,
+		).WithLoad(load).WithCapacity(reviewCapacity(capacity))
+		pools[domain.TeamName(teamName)] = append(pools[domain.TeamName(teamName)], candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return pools, nil
+}
+
+func (dtx *deactivationTx) ReplaceReviewers(ctx context.Context, reassignments []domain.Reassignment, audit domain.AssignmentAudit) error {
+	prs := make([]string, 0, len(reassignments))
+	olds := make([]string, 0, len(reassignments))
+	news := make([]string, 0, len(reassignments))
+	for _, r := range reassignments {
+		prs = append(prs, r.PrId.String())
+		olds = append(olds, r.OldMemberId.String())
+		news = append(news, r.NewMemberId.String())
+	}
+
+	_, err := dtx.tx.ExecContext(ctx, queries.ReplaceReviewers, pq.Array(prs), pq.Array(olds), pq.Array(news), audit.Actor, audit.Reason)
+	if err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	return nil
+}
+
+func (r *teamsRepo) BeginMembershipTx(ctx context.Context) (servteams.MembershipTx, error) {
+	tx, err := r.s.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedStartTX)
+	}
+	return &membershipTx{deactivationTx: &deactivationTx{tx: tx}}, nil
+}
+
+// membershipTx reuses the reviewer replacement of the team deactivation.
+type membershipTx struct {
+	*deactivationTx
+}
+
+func (mtx *membershipTx) LockTeam(ctx context.Context, teamName domain.TeamName) error {
+	var teamID int
+	err := mtx.tx.QueryRowContext(ctx, queries.LockTeam, teamName.String()).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return errors.Wrap(err, ErrFailedQuery)
+	}
+	return nil
+}
+
+func (mtx *membershipTx) GetTeamMemberIds(ctx context.Context, teamName domain.TeamName) ([]domain.MemberId, error) {
+	rows, err := mtx.tx.QueryContext(ctx, queries.GetMembersByTeamName, teamName.String())
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	ids := make([]domain.MemberId, 0)
+	for rows.Next() {
+		var id int
+		var uuid string
+		var name string
+		var isActive bool
+
+		if err := rows.Scan(&id, &uuid, &name, &isActive); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		ids = append(ids, domain.MemberId(uuid))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return ids, nil
+}
+
+func (mtx *membershipTx) GetOpenTeamAssignments(ctx context.Context, teamName domain.TeamName, memberIds []domain.MemberId) (domain.ReviewAssignments, error) {
+	return mtx.openAssignments(ctx, teamName, memberIds)
+}
+
+func (mtx *membershipTx) RemoveTeamMembers(ctx context.Context, teamName domain.TeamName, memberIds []domain.MemberId) ([]domain.MemberId, error) {
+	return mtx.removeMemberships(ctx, queries.RemoveTeamMembers, teamName.String(), pq.Array(memberIdStrings(memberIds)))
+}
+
+func (mtx *membershipTx) DeleteTeam(ctx context.Context, teamName domain.TeamName) error {
+	if _, err := mtx.removeMemberships(ctx, queries.RemoveAllTeamMembers, teamName.String()); err != nil {
+		return err
+	}
+
+	if _, err := mtx.tx.ExecContext(ctx, queries.DeleteTeam, teamName.String()); err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	return nil
+}
+
+// removeMemberships deletes team memberships, members that lost their primary team get the oldest remaining one.
+func (mtx *membershipTx) removeMemberships(ctx context.Context, query string, args ...any) ([]domain.MemberId, error) {
+	rows, err := mtx.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	ids := make([]domain.MemberId, 0)
+	for rows.Next() {
+		var uuid string
+		if err := rows.Scan(&uuid); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		ids = append(ids, domain.MemberId(uuid))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	if len(ids) > 0 {
+		if _, err := mtx.tx.ExecContext(ctx, queries.PromotePrimaryTeams, pq.Array(memberIdStrings(ids))); err != nil {
+			return nil, errors.Wrap(err, ErrFailedExec)
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *teamsRepo) BeginReconcileTx(ctx context.Context) (servteams.ReconcileTx, error) {
+	tx, err := r.s.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedStartTX)
+	}
+	return &reconcileTx{membershipTx: &membershipTx{deactivationTx: &deactivationTx{tx: tx}}}, nil
+}
+
+// reconcileTx reuses the member upsert and linking of AddTeamMembers and the removal of membershipTx.
+type reconcileTx struct {
+	*membershipTx
+}
+
+// LockTeams returns the existing teams of the list with their members, missing teams are skipped.
+func (rtx *reconcileTx) LockTeams(ctx context.Context, teamNames []domain.TeamName) ([]domain.Team, error) {
+	names := teamNameStrings(teamNames)
+
+	existing := make(map[domain.TeamName]struct{})
+	rows, err := rtx.tx.QueryContext(ctx, queries.LockTeams, pq.Array(names))
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		existing[domain.TeamName(name)] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	members, err := rtx.teamMembers(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]domain.Team, 0, len(existing))
+	for _, name := range teamNames {
+		if _, ok := existing[name]; ok {
+			teams = append(teams, domain.NewTeam(name, members[name]...))
+		}
+	}
+	return teams, nil
+}
+
+func (rtx *reconcileTx) teamMembers(ctx context.Context, names []string) (map[domain.TeamName]domain.Members, error) {
+	rows, err := rtx.tx.QueryContext(ctx, queries.GetMembersByTeamNames, pq.Array(names))
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	members := make(map[domain.TeamName]domain.Members)
+	for rows.Next() {
+		var team string
+		var uuid string
+		var name string
+		var isActive bool
+
+		if err := rows.Scan(&team, &uuid, &name, &isActive); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		tName := domain.TeamName(team)
+		members[tName] = append(members[tName], domain.MemberBuilder(domain.MemberId(uuid)).
+			Name(name).
+			Status(domain.MemberStatusIsActiveByBool(isActive)).
+			Build())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return members, nil
+}
+
+func (rtx *reconcileTx) GetMembers(ctx context.Context, memberIds []domain.MemberId) (domain.Members, error) {
+	rows, err := rtx.tx.QueryContext(ctx, queries.GetMembersByUUIDs, pq.Array(memberIdStrings(memberIds)))
+	if err != nil {
+		return nil, errors.Wrap(err, ErrFailedQuery)
+	}
+	defer rows.Close()
+
+	members := make(domain.Members, 0, len(memberIds))
+	for rows.Next() {
+		var uuid string
+		var name string
+		var isActive bool
+
+		if err := rows.Scan(&uuid, &name, &isActive); err != nil {
+			return nil, errors.Wrap(err, ErrFailedScan)
+		}
+		members = append(members, domain.MemberBuilder(domain.MemberId(uuid)).
+			Name(name).
+			Status(domain.MemberStatusIsActiveByBool(isActive)).
+			Build())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, ErrRowsIterations)
+	}
+
+	return members, nil
+}
+
+func (rtx *reconcileTx) CreateTeams(ctx context.Context, teamNames []domain.TeamName) error {
+	if _, err := rtx.tx.ExecContext(ctx, queries.CreateTeams, pq.Array(teamNameStrings(teamNames))); err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	return nil
+}
+
+func (rtx *reconcileTx) UpsertMembers(ctx context.Context, members domain.Members) error {
+	return upsertMembers(ctx, rtx.tx, members)
+}
+
+func (rtx *reconcileTx) LinkTeamMembers(ctx context.Context, teamName domain.TeamName, memberIds []domain.MemberId) error {
+	var teamID int
+	err := rtx.tx.QueryRowContext(ctx, queries.LockTeam, teamName.String()).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return errors.Wrap(err, ErrFailedQuery)
+	}
+
+	return linkMembersToTeam(ctx, rtx.tx, teamID, memberIds)
+}
+
+// upsertMembers creates the members or updates their name and status.
+func upsertMembers(ctx context.Context, tx *sql.Tx, members domain.Members) error {
+	uuids := make([]string, len(members))
+	names := make([]string, len(members))
+	isActives := make([]bool, len(members))
+	for i, m := range members {
+		uuids[i] = m.Id.String()
+		names[i] = m.Name
+		isActives[i] = m.Status.IsActive()
+	}
+
+	if _, err := tx.ExecContext(ctx, queries.UpsertMembers, pq.Array(uuids), pq.Array(names), pq.Array(isActives)); err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	return nil
+}
+
+// linkMembersToTeam adds the memberships, the team becomes primary for members without one.
+func linkMembersToTeam(ctx context.Context, tx *sql.Tx, teamID int, memberIds []domain.MemberId) error {
+	if _, err := tx.ExecContext(ctx, queries.LinkMembersToTeam, teamID, pq.Array(memberIdStrings(memberIds))); err != nil {
+		return errors.Wrap(err, ErrFailedExec)
+	}
+	return nil
+}
+
+func teamNameStrings(names []domain.TeamName) []string {
+	res := make([]string, 0, len(names))
+	for _, n := range names {
+		res = append(res, n.String())
+	}
+	return res
+}
+
+func (dtx *deactivationTx) Commit() error {
+	if err := dtx.tx.Commit(); err != nil {
+		return errors.Wrap(err, ErrFailedCommitTX)
+	}
+	return nil
+}
+
+func (dtx *deactivationTx) Rollback() error {
+	if err := dtx.tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		return errors.Wrap(err, ErrFailedRollbackTX)
+	}
+	return nil
+}
+
+func memberIdStrings(ids []domain.MemberId) []string {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, id.String())
+	}
+	return res
+}
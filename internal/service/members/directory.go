@@ -0,0 +1,47 @@
+package servmembers
+
+import (
+	"fmt"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/go-faster/errors"
+)
+
+func (ms *MembersService) Member(id domain.MemberId) (domain.Member, error) {
+	member, err := ms.repo.GetMember(id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Member{}, domain.ErrNotFound
+		}
+		return domain.Member{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return member, nil
+}
+
+// ListMembers returns one page of the member directory ordered by name.
+func (ms *MembersService) ListMembers(filter domain.MemberFilter, page domain.MemberPageRequest) (domain.MembersPage, error) {
+	members, err := ms.repo.ListMembers(filter, page)
+	if err != nil {
+		return domain.MembersPage{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return domain.NewMembersPage(members, page.Limit), nil
+}
+
+// UpdateMember applies the patch to the display name and profile, an empty patch is rejected.
+func (ms *MembersService) UpdateMember(id domain.MemberId, patch domain.MemberPatch) (domain.Member, error) {
+	if patch.Empty() || !patch.Valid() {
+		return domain.Member{}, domain.ErrValidation
+	}
+
+	member, err := ms.repo.UpdateMemberProfile(id, patch)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Member{}, domain.ErrNotFound
+		}
+		return domain.Member{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return member, nil
+}
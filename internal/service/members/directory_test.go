@@ -0,0 +1,191 @@
+package servmembers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servmembers "github.com/eragon-mdi/pr-reviewer-service/internal/service/members"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/members/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMembersService_Member(t *testing.T) {
+	id := domain.MemberId(uuid.New().String())
+
+	tests := []struct {
+		name      string
+		repoSetup func(*mocks.MembersRepository)
+		wantName  string
+		wantErr   error
+	}{
+		{
+			name: "found",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().GetMember(id).Return(domain.MemberBuilder(id).Name("Alice").Build(), nil)
+			},
+			wantName: "Alice",
+		},
+		{
+			name: "not found",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().GetMember(id).Return(domain.Member{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "internal error",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().GetMember(id).Return(domain.Member{}, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMembersRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, nil)
+			got, err := service.Member(id)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, got.Name)
+		})
+	}
+}
+
+func TestMembersService_ListMembers(t *testing.T) {
+	active := true
+	filter := domain.MemberFilter{Name: "al", Team: "backend", Active: &active}
+	page := domain.MemberPageRequest{Limit: 2}
+
+	a := domain.MemberBuilder(domain.MemberId(uuid.New().String())).Name("Alan").Build()
+	b := domain.MemberBuilder(domain.MemberId(uuid.New().String())).Name("Alice").Build()
+	c := domain.MemberBuilder(domain.MemberId(uuid.New().String())).Name("Alina").Build()
+
+	tests := []struct {
+		name      string
+		repoSetup func(*mocks.MembersRepository)
+		wantItems domain.Members
+		wantNext  string
+		wantErr   error
+	}{
+		{
+			name: "extra row gives next cursor",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().ListMembers(filter, page).Return(domain.Members{a, b, c}, nil)
+			},
+			wantItems: domain.Members{a, b},
+			wantNext:  domain.MemberCursor{Name: b.Name, Id: b.Id}.String(),
+		},
+		{
+			name: "last page",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().ListMembers(filter, page).Return(domain.Members{a}, nil)
+			},
+			wantItems: domain.Members{a},
+		},
+		{
+			name: "internal error",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().ListMembers(filter, page).Return(nil, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMembersRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, nil)
+			got, err := service.ListMembers(filter, page)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantItems, got.Items)
+			assert.Equal(t, tt.wantNext, got.NextCursor)
+		})
+	}
+}
+
+func TestMembersService_UpdateMember(t *testing.T) {
+	id := domain.MemberId(uuid.New().String())
+	name, blank, email := "Alice Smith", "  ", "alice@example.com"
+
+	tests := []struct {
+		name      string
+		patch     domain.MemberPatch
+		repoSetup func(*mocks.MembersRepository, domain.MemberPatch)
+		wantErr   error
+	}{
+		{
+			name:  "updated",
+			patch: domain.MemberPatch{Name: &name, Email: &email},
+			repoSetup: func(mockRepo *mocks.MembersRepository, patch domain.MemberPatch) {
+				mockRepo.EXPECT().UpdateMemberProfile(id, patch).Return(domain.MemberBuilder(id).
+					Name(name).
+					Profile(domain.MemberProfile{Email: email}).
+					Build(), nil)
+			},
+		},
+		{
+			name:    "empty patch",
+			patch:   domain.MemberPatch{},
+			wantErr: domain.ErrValidation,
+		},
+		{
+			name:    "blank name",
+			patch:   domain.MemberPatch{Name: &blank},
+			wantErr: domain.ErrValidation,
+		},
+		{
+			name:  "not found",
+			patch: domain.MemberPatch{Email: &email},
+			repoSetup: func(mockRepo *mocks.MembersRepository, patch domain.MemberPatch) {
+				mockRepo.EXPECT().UpdateMemberProfile(id, patch).Return(domain.Member{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:  "internal error",
+			patch: domain.MemberPatch{Email: &email},
+			repoSetup: func(mockRepo *mocks.MembersRepository, patch domain.MemberPatch) {
+				mockRepo.EXPECT().UpdateMemberProfile(id, patch).Return(domain.Member{}, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMembersRepository(t)
+			if tt.repoSetup != nil {
+				tt.repoSetup(mockRepo, tt.patch)
+			}
+
+			service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, nil)
+			got, err := service.UpdateMember(id, tt.patch)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, name, got.Name)
+			assert.Equal(t, email, got.Profile.Email)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package servmembers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servmembers "github.com/eragon-mdi/pr-reviewer-service/internal/service/members"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/members/mocks"
+	servselector "github.com/eragon-mdi/pr-reviewer-service/internal/service/selector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMembersService_SetMemberIsActive_Events(t *testing.T) {
+	ctx := context.Background()
+	memId := domain.MemberId("old")
+	enabled := true
+	active, inactive := domain.MemberStatusIsActiveByBool(true), domain.MemberStatusIsActiveByBool(false)
+	deactivated := mock.MatchedBy(func(e domain.Event) bool {
+		return e.Type == domain.EventMemberDeactivated && e.MemberId == memId
+	})
+
+	t.Run("deactivation is announced", func(t *testing.T) {
+		mockRepo := mocks.NewMembersRepository(t)
+		mockPub := mocks.NewEventPublisher(t)
+		mockRepo.EXPECT().UpdateMemberStatus(memId, inactive).Return(domain.Member{Id: memId, Status: inactive}, true, nil)
+		mockPub.EXPECT().Publish(mock.Anything, deactivated).Once()
+
+		service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, mockPub)
+		_, report, err := service.SetMemberIsActive(ctx, domain.Member{Id: memId, Status: inactive}, nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, report.Deactivated)
+	})
+
+	t.Run("moved reviews are announced after commit", func(t *testing.T) {
+		mockRepo := mocks.NewMembersRepository(t)
+		mockPub := mocks.NewEventPublisher(t)
+		tx := mocks.NewMemberStatusTx(t)
+		mockRepo.EXPECT().BeginMemberStatusTx(ctx).Return(tx, nil)
+		tx.EXPECT().UpdateMemberStatus(ctx, memId, inactive).Return(domain.Member{Id: memId, Status: inactive}, true, nil)
+		tx.EXPECT().GetOpenAssignments(ctx, []domain.MemberId{memId}).Return(domain.ReviewAssignments{
+			{PrId: "pr-1", AuthorId: "author", Team: "backend", MemberId: memId, Participants: []domain.MemberId{memId}},
+		}, nil)
+		tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{"backend"}).Return(map[domain.TeamName]domain.MembersHistories{
+			"backend": {domain.NewMemberHistory("mate", domain.MemberStatusActive, domain.MemberRoleDefault, false)},
+		}, nil)
+		tx.EXPECT().ReplaceReviewers(ctx, mock.Anything, mock.Anything).Return(nil)
+		commit := tx.EXPECT().Commit().Return(nil).Call
+		mockPub.EXPECT().Publish(mock.Anything, deactivated, mock.MatchedBy(func(e domain.Event) bool {
+			return e.Type == domain.EventReviewerReassigned && e.PrId == "pr-1" && e.Reviewers[0] == "mate"
+		})).Once().NotBefore(commit)
+
+		service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, servselector.New(servselector.StrategyLeastLoaded), mockPub)
+		_, _, err := service.SetMemberIsActive(ctx, domain.Member{Id: memId, Status: inactive}, &enabled)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("already inactive member is not announced", func(t *testing.T) {
+		mockRepo := mocks.NewMembersRepository(t)
+		mockRepo.EXPECT().UpdateMemberStatus(memId, inactive).Return(domain.Member{Id: memId, Status: inactive}, false, nil)
+
+		service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, mocks.NewEventPublisher(t))
+		_, _, err := service.SetMemberIsActive(ctx, domain.Member{Id: memId, Status: inactive}, nil)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("reviews moved from an already inactive member are announced", func(t *testing.T) {
+		mockRepo := mocks.NewMembersRepository(t)
+		mockPub := mocks.NewEventPublisher(t)
+		tx := mocks.NewMemberStatusTx(t)
+		mockRepo.EXPECT().BeginMemberStatusTx(ctx).Return(tx, nil)
+		tx.EXPECT().UpdateMemberStatus(ctx, memId, inactive).Return(domain.Member{Id: memId, Status: inactive}, false, nil)
+		tx.EXPECT().GetOpenAssignments(ctx, []domain.MemberId{memId}).Return(domain.ReviewAssignments{
+			{PrId: "pr-1", AuthorId: "author", Team: "backend", MemberId: memId, Participants: []domain.MemberId{memId}},
+		}, nil)
+		tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{"backend"}).Return(map[domain.TeamName]domain.MembersHistories{
+			"backend": {domain.NewMemberHistory("mate", domain.MemberStatusActive, domain.MemberRoleDefault, false)},
+		}, nil)
+		tx.EXPECT().ReplaceReviewers(ctx, mock.Anything, mock.Anything).Return(nil)
+		tx.EXPECT().Commit().Return(nil)
+		mockPub.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(e domain.Event) bool {
+			return e.Type == domain.EventReviewerReassigned && e.PrId == "pr-1"
+		})).Once()
+
+		service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, servselector.New(servselector.StrategyLeastLoaded), mockPub)
+		_, _, err := service.SetMemberIsActive(ctx, domain.Member{Id: memId, Status: inactive}, &enabled)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("activation is not announced", func(t *testing.T) {
+		mockRepo := mocks.NewMembersRepository(t)
+		mockRepo.EXPECT().UpdateMemberStatus(memId, active).Return(domain.Member{Id: memId, Status: active}, true, nil)
+
+		service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, mocks.NewEventPublisher(t))
+		_, _, err := service.SetMemberIsActive(ctx, domain.Member{Id: memId, Status: active}, nil)
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package servmembers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/go-faster/errors"
+)
+
+// LinkIdentity attaches an external account to the member, linking it again to the same member is a no-op.
+func (ms *MembersService) LinkIdentity(ctx context.Context, id domain.MemberId, identity domain.Identity) (domain.MemberIdentity, error) {
+	if !id.IsValid() || !identity.Valid() {
+		return domain.MemberIdentity{}, domain.ErrValidation
+	}
+
+	mi := domain.MemberIdentity{MemberId: id, Identity: identity}
+	if err := ms.repo.AddMemberIdentity(ctx, mi); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.MemberIdentity{}, domain.ErrNotFound
+		}
+		if errors.Is(err, domain.ErrDuplicate) {
+			return domain.MemberIdentity{}, domain.ErrDuplicate
+		}
+		return domain.MemberIdentity{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return mi, nil
+}
+
+// UnlinkIdentity detaches an external account, an account not linked to the member is domain.ErrNotFound.
+func (ms *MembersService) UnlinkIdentity(ctx context.Context, id domain.MemberId, identity domain.Identity) error {
+	if !id.IsValid() || !identity.Valid() {
+		return domain.ErrValidation
+	}
+
+	if err := ms.repo.RemoveMemberIdentity(ctx, domain.MemberIdentity{MemberId: id, Identity: identity}); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+func (ms *MembersService) MemberIdentities(ctx context.Context, id domain.MemberId) ([]domain.Identity, error) {
+	identities, err := ms.repo.GetMemberIdentities(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return identities, nil
+}
+
+// ResolveMember turns a member reference into the member id. A UUID is returned as is,
+// whether the member exists is up to the operation using it; an identity nobody linked is domain.ErrNotFound.
+func (ms *MembersService) ResolveMember(ctx context.Context, ref domain.MemberRef) (domain.MemberId, error) {
+	if id, ok := ref.MemberId(); ok {
+		return id, nil
+	}
+
+	identity, ok := ref.Identity()
+	if !ok {
+		return "", domain.ErrValidation
+	}
+
+	id, err := ms.repo.GetMemberIdByIdentity(ctx, identity)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return "", domain.ErrNotFound
+		}
+		return "", fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,254 @@
+package servmembers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servmembers "github.com/eragon-mdi/pr-reviewer-service/internal/service/members"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/members/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMembersService_LinkIdentity(t *testing.T) {
+	ctx := context.Background()
+	id := domain.MemberId(uuid.New().String())
+	identity := domain.NewIdentity(domain.ProviderGithub, "octocat")
+	linked := domain.MemberIdentity{MemberId: id, Identity: identity}
+
+	tests := []struct {
+		name      string
+		memberId  domain.MemberId
+		identity  domain.Identity
+		repoSetup func(*mocks.MembersRepository)
+		wantErr   error
+	}{
+		{
+			name:     "linked",
+			memberId: id,
+			identity: identity,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().AddMemberIdentity(ctx, linked).Return(nil)
+			},
+		},
+		{
+			name:      "invalid member id",
+			memberId:  "u1",
+			identity:  identity,
+			repoSetup: func(*mocks.MembersRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:      "blank external id",
+			memberId:  id,
+			identity:  domain.NewIdentity(domain.ProviderGithub, " "),
+			repoSetup: func(*mocks.MembersRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:     "member not found",
+			memberId: id,
+			identity: identity,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().AddMemberIdentity(ctx, linked).Return(domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:     "linked to another member",
+			memberId: id,
+			identity: identity,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().AddMemberIdentity(ctx, linked).Return(domain.ErrDuplicate)
+			},
+			wantErr: domain.ErrDuplicate,
+		},
+		{
+			name:     "internal error",
+			memberId: id,
+			identity: identity,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().AddMemberIdentity(ctx, linked).Return(errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMembersRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, nil)
+			got, err := service.LinkIdentity(ctx, tt.memberId, tt.identity)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, linked, got)
+		})
+	}
+}
+
+func TestMembersService_UnlinkIdentity(t *testing.T) {
+	ctx := context.Background()
+	id := domain.MemberId(uuid.New().String())
+	identity := domain.NewIdentity(domain.ProviderGithub, "octocat")
+	linked := domain.MemberIdentity{MemberId: id, Identity: identity}
+
+	tests := []struct {
+		name      string
+		memberId  domain.MemberId
+		repoSetup func(*mocks.MembersRepository)
+		wantErr   error
+	}{
+		{
+			name:     "unlinked",
+			memberId: id,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().RemoveMemberIdentity(ctx, linked).Return(nil)
+			},
+		},
+		{
+			name:      "invalid member id",
+			memberId:  "u1",
+			repoSetup: func(*mocks.MembersRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:     "not linked",
+			memberId: id,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().RemoveMemberIdentity(ctx, linked).Return(domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:     "internal error",
+			memberId: id,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().RemoveMemberIdentity(ctx, linked).Return(errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMembersRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, nil)
+			err := service.UnlinkIdentity(ctx, tt.memberId, identity)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMembersService_MemberIdentities(t *testing.T) {
+	ctx := context.Background()
+	id := domain.MemberId(uuid.New().String())
+	identities := []domain.Identity{
+		domain.NewIdentity(domain.ProviderGithub, "octocat"),
+		domain.NewIdentity(domain.ProviderGitlab, "octo"),
+	}
+
+	t.Run("listed", func(t *testing.T) {
+		mockRepo := mocks.NewMembersRepository(t)
+		mockRepo.EXPECT().GetMemberIdentities(ctx, id).Return(identities, nil)
+
+		service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, nil)
+		got, err := service.MemberIdentities(ctx, id)
+
+		assert.NoError(t, err)
+		assert.Equal(t, identities, got)
+	})
+
+	t.Run("member not found", func(t *testing.T) {
+		mockRepo := mocks.NewMembersRepository(t)
+		mockRepo.EXPECT().GetMemberIdentities(ctx, id).Return(nil, domain.ErrNotFound)
+
+		service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, nil)
+		_, err := service.MemberIdentities(ctx, id)
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestMembersService_ResolveMember(t *testing.T) {
+	ctx := context.Background()
+	id := domain.MemberId(uuid.New().String())
+	identity := domain.NewIdentity(domain.ProviderGithub, "octocat")
+
+	tests := []struct {
+		name      string
+		ref       domain.MemberRef
+		repoSetup func(*mocks.MembersRepository)
+		want      domain.MemberId
+		wantErr   error
+	}{
+		{
+			name:      "uuid is returned as is",
+			ref:       domain.MemberRef(id),
+			repoSetup: func(*mocks.MembersRepository) {},
+			want:      id,
+		},
+		{
+			name: "identity",
+			ref:  "github:octocat",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().GetMemberIdByIdentity(ctx, identity).Return(id, nil)
+			},
+			want: id,
+		},
+		{
+			name: "unknown identity",
+			ref:  "github:octocat",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().GetMemberIdByIdentity(ctx, identity).Return("", domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:      "neither uuid nor identity",
+			ref:       "u1",
+			repoSetup: func(*mocks.MembersRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name: "internal error",
+			ref:  "github:octocat",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().GetMemberIdByIdentity(ctx, identity).Return("", errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMembersRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, nil)
+			got, err := service.ResolveMember(ctx, tt.ref)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
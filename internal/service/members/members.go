@@ -1,6 +1,7 @@
 package servmembers
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
@@ -8,13 +9,138 @@ import (
 )
 
 type MembersRepository interface {
-	UpdateMemberStatus(domain.MemberId, domain.MemberStatus) (domain.Member, error)
-	GetPrReviewsByMember(domain.MemberId) (domain.PullRequests, error)
+	UpdateMemberStatus(domain.MemberId, domain.MemberStatus) (_ domain.Member, changed bool, _ error)
+	GetPrReviewsByMember(domain.MemberId, []domain.PrStatus, domain.PageRequest) (domain.PullRequests, error)
+	CountOpenReviewsByMember(domain.MemberId) (int, error)
+	UpdateMemberReviewCapacity(domain.MemberId, domain.ReviewCapacity) (domain.Member, error)
+	GetMemberReviewCapacity(domain.MemberId) (domain.ReviewCapacity, error)
+	UpdateMemberPrimaryTeam(domain.MemberId, domain.TeamName) (domain.Member, error)
+	GetMember(domain.MemberId) (domain.Member, error)
+	ListMembers(domain.MemberFilter, domain.MemberPageRequest) (domain.Members, error)
+	UpdateMemberProfile(domain.MemberId, domain.MemberPatch) (domain.Member, error)
+	BeginMemberStatusTx(context.Context) (MemberStatusTx, error)
+	AddMemberIdentity(context.Context, domain.MemberIdentity) error
+	RemoveMemberIdentity(context.Context, domain.MemberIdentity) error
+	GetMemberIdentities(context.Context, domain.MemberId) ([]domain.Identity, error)
+	GetMemberIdByIdentity(context.Context, domain.Identity) (domain.MemberId, error)
 }
 
-func (ms *MembersService) SetMemberIsActive(member domain.Member) (domain.Member, error) {
+type MemberStatusTx interface {
+	UpdateMemberStatus(context.Context, domain.MemberId, domain.MemberStatus) (_ domain.Member, changed bool, _ error)
+	GetOpenAssignments(context.Context, []domain.MemberId) (domain.ReviewAssignments, error)
+	GetReplacementCandidates(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)
+	ReplaceReviewers(context.Context, []domain.Reassignment, domain.AssignmentAudit) error
+	Commit() error
+	Rollback() error
+}
 
-	updMember, err := ms.repo.UpdateMemberStatus(member.Id, member.Status)
+// SetMemberIsActive updates the member status. When the member is deactivated and reassign
+// (or the global default when reassign is nil) is set, the open reviews are moved to teammates.
+// The deactivation of an active member and the moved reviews are announced once committed.
+func (ms *MembersService) SetMemberIsActive(
+	ctx context.Context,
+	member domain.Member,
+	reassign *bool,
+) (domain.Member, domain.DeactivationReport, error) {
+	updMember, report, changed, err := ms.setMemberIsActive(ctx, member, reassign)
+	if err != nil {
+		return domain.Member{}, domain.DeactivationReport{}, err
+	}
+
+	announced := domain.DeactivationReport{Reassigned: report.Reassigned}
+	if changed && !member.Status.IsActive() {
+		announced.Deactivated = []domain.MemberId{member.Id}
+	}
+	ms.publish(ctx, announced.Events()...)
+
+	return updMember, report, nil
+}
+
+func (ms *MembersService) setMemberIsActive(
+	ctx context.Context,
+	member domain.Member,
+	reassign *bool,
+) (domain.Member, domain.DeactivationReport, bool, error) {
+	if reassign == nil {
+		reassign = &ms.reassignOnDeactivate
+	}
+	if member.Status.IsActive() || !*reassign {
+		updMember, changed, err := ms.repo.UpdateMemberStatus(member.Id, member.Status)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return domain.Member{}, domain.DeactivationReport{}, false, domain.ErrNotFound
+			}
+			return domain.Member{}, domain.DeactivationReport{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+		return updMember, domain.DeactivationReport{}, changed, nil
+	}
+
+	return ms.deactivateMember(ctx, member)
+}
+
+func (ms *MembersService) deactivateMember(
+	ctx context.Context,
+	member domain.Member,
+) (_ domain.Member, _ domain.DeactivationReport, changed bool, err error) {
+	tx, err := ms.repo.BeginMemberStatusTx(ctx)
+	if err != nil {
+		return domain.Member{}, domain.DeactivationReport{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	defer func() {
+		if err == nil {
+			if errCommit := tx.Commit(); errCommit != nil {
+				err = fmt.Errorf("%w: %w", domain.ErrInternal, errCommit)
+			}
+			return
+		}
+		if errRollback := tx.Rollback(); errRollback != nil {
+			err = fmt.Errorf("%w: %w", err, errRollback)
+		}
+	}()
+
+	updMember, changed, err := tx.UpdateMemberStatus(ctx, member.Id, member.Status)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Member{}, domain.DeactivationReport{}, false, domain.ErrNotFound
+		}
+		return domain.Member{}, domain.DeactivationReport{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	report := domain.DeactivationReport{
+		Deactivated: []domain.MemberId{member.Id},
+		Reassigned:  []domain.Reassignment{},
+		Unfilled:    []domain.Reassignment{},
+	}
+
+	assignments, err := tx.GetOpenAssignments(ctx, report.Deactivated)
+	if err != nil {
+		return domain.Member{}, domain.DeactivationReport{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	if assignments.Empty() {
+		return updMember, report, changed, nil
+	}
+
+	pools, err := tx.GetReplacementCandidates(ctx, assignments.Teams())
+	if err != nil {
+		return domain.Member{}, domain.DeactivationReport{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	report.Reassigned, report.Unfilled = assignments.Plan(pools, "", func(candidates domain.MembersHistories) domain.MembersHistories {
+		return ms.selector.Select(candidates, 1)
+	})
+
+	if len(report.Reassigned) > 0 {
+		if err = tx.ReplaceReviewers(ctx, report.Reassigned, domain.SystemAudit(domain.AssignmentReasonMemberDeactivated)); err != nil {
+			return domain.Member{}, domain.DeactivationReport{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+	}
+
+	return updMember, report, changed, nil
+}
+
+func (ms *MembersService) SetMemberReviewCapacity(member domain.Member) (domain.Member, error) {
+
+	updMember, err := ms.repo.UpdateMemberReviewCapacity(member.Id, member.Capacity)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return domain.Member{}, domain.ErrNotFound
@@ -25,9 +151,9 @@ func (ms *MembersService) SetMemberIsActive(member domain.Member) (domain.Member
 	return updMember, nil
 }
 
-func (ms *MembersService) MemberReviews(id domain.MemberId) (domain.Member, error) {
+func (ms *MembersService) SetMemberPrimaryTeam(member domain.Member) (domain.Member, error) {
 
-	revs, err := ms.repo.GetPrReviewsByMember(id)
+	updMember, err := ms.repo.UpdateMemberPrimaryTeam(member.Id, member.Team)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return domain.Member{}, domain.ErrNotFound
@@ -35,9 +161,41 @@ func (ms *MembersService) MemberReviews(id domain.MemberId) (domain.Member, erro
 		return domain.Member{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
 	}
 
+	return updMember, nil
+}
+
+// MemberReviews returns one page of the member reviews in the statuses (any when empty) and the next page cursor.
+func (ms *MembersService) MemberReviews(
+	id domain.MemberId,
+	statuses []domain.PrStatus,
+	page domain.PageRequest,
+) (domain.Member, string, error) {
+
+	capacity, err := ms.repo.GetMemberReviewCapacity(id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Member{}, "", domain.ErrNotFound
+		}
+		return domain.Member{}, "", fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	open, err := ms.repo.CountOpenReviewsByMember(id)
+	if err != nil {
+		return domain.Member{}, "", fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	revs, err := ms.repo.GetPrReviewsByMember(id, statuses, page)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Member{}, "", domain.ErrNotFound
+		}
+		return domain.Member{}, "", fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
 	if revs.Empty() {
-		return domain.MemberBuilder(id).Build(), domain.ErrNoContent
+		return domain.MemberBuilder(id).Capacity(capacity).OpenReviews(open).Build(), "", domain.ErrNoContent
 	}
 
-	return domain.MemberBuilder(id).Reviews(revs).Build(), nil
+	revs, next := revs.Page(page.Limit)
+	return domain.MemberBuilder(id).Reviews(revs).Capacity(capacity).OpenReviews(open).Build(), next, nil
 }
@@ -1,12 +1,16 @@
-package servmembers
+package servmembers_test
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servmembers "github.com/eragon-mdi/pr-reviewer-service/internal/service/members"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/service/members/mocks"
+	servselector "github.com/eragon-mdi/pr-reviewer-service/internal/service/selector"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,7 +37,7 @@ func TestMembersService_SetMemberIsActive(t *testing.T) {
 					Id:     member.Id,
 					Name:   "Test User",
 					Status: domain.MemberStatusActive,
-				}, nil)
+				}, true, nil)
 			},
 			want: domain.Member{
 				Name:   "Test User",
@@ -51,7 +55,7 @@ func TestMembersService_SetMemberIsActive(t *testing.T) {
 				mockRepo.EXPECT().UpdateMemberStatus(
 					member.Id,
 					member.Status,
-				).Return(domain.Member{}, domain.ErrNotFound)
+				).Return(domain.Member{}, false, domain.ErrNotFound)
 			},
 			want:    domain.Member{},
 			wantErr: domain.ErrNotFound,
@@ -66,7 +70,7 @@ func TestMembersService_SetMemberIsActive(t *testing.T) {
 				mockRepo.EXPECT().UpdateMemberStatus(
 					member.Id,
 					member.Status,
-				).Return(domain.Member{}, errors.New("database error"))
+				).Return(domain.Member{}, false, errors.New("database error"))
 			},
 			want:    domain.Member{},
 			wantErr: domain.ErrInternal,
@@ -84,8 +88,8 @@ func TestMembersService_SetMemberIsActive(t *testing.T) {
 				AllowedRolesToReasign:   []string{"default"},
 			}
 
-			service := NewMembersService(cfg, mockRepo)
-			got, err := service.SetMemberIsActive(tt.member)
+			service := servmembers.NewMembersService(cfg, mockRepo, nil, nil)
+			got, report, err := service.SetMemberIsActive(context.Background(), tt.member, nil)
 
 			if tt.wantErr != nil {
 				assert.Error(t, err)
@@ -94,25 +98,181 @@ func TestMembersService_SetMemberIsActive(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want.Name, got.Name)
 				assert.Equal(t, tt.want.Status, got.Status)
+				assert.Empty(t, report.Deactivated)
 			}
 		})
 	}
 }
 
+func TestMembersService_SetMemberIsActive_Reassign(t *testing.T) {
+	ctx := context.Background()
+	memId := domain.MemberId("old")
+	enabled, disabled := true, false
+	active, inactive := domain.MemberStatusIsActiveByBool(true), domain.MemberStatusIsActiveByBool(false)
+
+	tests := []struct {
+		name         string
+		global       bool
+		reassign     *bool
+		status       domain.MemberStatus
+		repoSetup    func(*mocks.MembersRepository)
+		wantReassign []domain.Reassignment
+		wantUnfilled []domain.Reassignment
+		wantErr      error
+	}{
+		{
+			name:     "reviews moved to teammates",
+			reassign: &enabled,
+			status:   inactive,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				tx := mocks.NewMemberStatusTx(t)
+				mockRepo.EXPECT().BeginMemberStatusTx(ctx).Return(tx, nil)
+				tx.EXPECT().UpdateMemberStatus(ctx, memId, inactive).
+					Return(domain.Member{Id: memId, Status: domain.MemberStatusInactive}, true, nil)
+				tx.EXPECT().GetOpenAssignments(ctx, []domain.MemberId{memId}).Return(domain.ReviewAssignments{
+					{PrId: "pr-1", AuthorId: "author", Team: "backend", MemberId: memId, Participants: []domain.MemberId{memId}},
+					{PrId: "pr-2", AuthorId: "mate", Team: "backend", MemberId: memId, Participants: []domain.MemberId{memId}},
+				}, nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{"backend"}).
+					Return(map[domain.TeamName]domain.MembersHistories{
+						"backend": {
+							domain.NewMemberHistory("author", domain.MemberStatusActive, domain.MemberRoleDefault, false),
+							domain.NewMemberHistory("mate", domain.MemberStatusActive, domain.MemberRoleDefault, false),
+						},
+					}, nil)
+				tx.EXPECT().ReplaceReviewers(ctx, []domain.Reassignment{
+					{PrId: "pr-1", OldMemberId: memId, NewMemberId: "mate"},
+					{PrId: "pr-2", OldMemberId: memId, NewMemberId: "author"},
+				}, domain.SystemAudit(domain.AssignmentReasonMemberDeactivated)).Return(nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{
+				{PrId: "pr-1", OldMemberId: memId, NewMemberId: "mate"},
+				{PrId: "pr-2", OldMemberId: memId, NewMemberId: "author"},
+			},
+			wantUnfilled: []domain.Reassignment{},
+		},
+		{
+			name:   "global option used when request omits it",
+			global: true,
+			status: inactive,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				tx := mocks.NewMemberStatusTx(t)
+				mockRepo.EXPECT().BeginMemberStatusTx(ctx).Return(tx, nil)
+				tx.EXPECT().UpdateMemberStatus(ctx, memId, inactive).
+					Return(domain.Member{Id: memId, Status: domain.MemberStatusInactive}, true, nil)
+				tx.EXPECT().GetOpenAssignments(ctx, []domain.MemberId{memId}).Return(domain.ReviewAssignments{
+					{PrId: "pr-1", AuthorId: "author", Team: "backend", MemberId: memId, Participants: []domain.MemberId{memId}},
+				}, nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{"backend"}).
+					Return(map[domain.TeamName]domain.MembersHistories{}, nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{},
+			wantUnfilled: []domain.Reassignment{{PrId: "pr-1", OldMemberId: memId}},
+		},
+		{
+			name:     "request overrides global option",
+			global:   true,
+			reassign: &disabled,
+			status:   inactive,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().UpdateMemberStatus(memId, inactive).
+					Return(domain.Member{Id: memId, Status: domain.MemberStatusInactive}, true, nil)
+			},
+		},
+		{
+			name:     "activation never reassigns",
+			reassign: &enabled,
+			status:   active,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().UpdateMemberStatus(memId, active).
+					Return(domain.Member{Id: memId, Status: domain.MemberStatusActive}, true, nil)
+			},
+		},
+		{
+			name:     "member not found rolls back",
+			reassign: &enabled,
+			status:   inactive,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				tx := mocks.NewMemberStatusTx(t)
+				mockRepo.EXPECT().BeginMemberStatusTx(ctx).Return(tx, nil)
+				tx.EXPECT().UpdateMemberStatus(ctx, memId, inactive).
+					Return(domain.Member{}, false, domain.ErrNotFound)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:     "replace failure rolls back",
+			reassign: &enabled,
+			status:   inactive,
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				tx := mocks.NewMemberStatusTx(t)
+				mockRepo.EXPECT().BeginMemberStatusTx(ctx).Return(tx, nil)
+				tx.EXPECT().UpdateMemberStatus(ctx, memId, inactive).
+					Return(domain.Member{Id: memId, Status: domain.MemberStatusInactive}, true, nil)
+				tx.EXPECT().GetOpenAssignments(ctx, []domain.MemberId{memId}).Return(domain.ReviewAssignments{
+					{PrId: "pr-1", AuthorId: "author", Team: "backend", MemberId: memId, Participants: []domain.MemberId{memId}},
+				}, nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{"backend"}).
+					Return(map[domain.TeamName]domain.MembersHistories{
+						"backend": {domain.NewMemberHistory("mate", domain.MemberStatusActive, domain.MemberRoleDefault, false)},
+					}, nil)
+				tx.EXPECT().ReplaceReviewers(ctx, []domain.Reassignment{
+					{PrId: "pr-1", OldMemberId: memId, NewMemberId: "mate"},
+				}, domain.SystemAudit(domain.AssignmentReasonMemberDeactivated)).Return(errors.New("database error"))
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMembersRepository(t)
+			tt.repoSetup(mockRepo)
+
+			cfg := &configs.BussinesLogic{ReassignOnDeactivate: tt.global}
+			service := servmembers.NewMembersService(cfg, mockRepo, servselector.New(servselector.StrategyLeastLoaded), nil)
+
+			member := domain.MemberBuilder(memId).Status(tt.status).Build()
+			got, report, err := service.SetMemberIsActive(ctx, member, tt.reassign)
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, got.Status)
+			assert.Equal(t, tt.wantReassign, report.Reassigned)
+			assert.Equal(t, tt.wantUnfilled, report.Unfilled)
+		})
+	}
+}
+
 func TestMembersService_MemberReviews(t *testing.T) {
+	page := domain.PageRequest{Limit: 1}
+	statuses := []domain.PrStatus{domain.PrStatusOpen}
+	createdAt := time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name      string
 		memberId  domain.MemberId
 		repoSetup func(*mocks.MembersRepository, domain.MemberId)
 		want      domain.Member
+		wantNext  bool
 		wantErr   error
 	}{
 		{
 			name:     "successful get with reviews",
 			memberId: domain.MemberId(uuid.New().String()),
 			repoSetup: func(mockRepo *mocks.MembersRepository, memberId domain.MemberId) {
+				mockRepo.EXPECT().GetMemberReviewCapacity(memberId).Return(domain.NewReviewCapacity(3), nil)
+				mockRepo.EXPECT().CountOpenReviewsByMember(memberId).Return(1, nil)
 				mockRepo.EXPECT().GetPrReviewsByMember(
-					memberId,
+					memberId, statuses, page,
 				).Return(domain.PullRequests{
 					{Id: domain.PrId("pr-1"), Name: domain.PrName("PR1"), AuthorId: domain.MemberId(uuid.New().String()), Status: domain.PrStatusOpen},
 				}, nil)
@@ -121,15 +281,38 @@ func TestMembersService_MemberReviews(t *testing.T) {
 				Reviews: domain.PullRequests{
 					{Id: domain.PrId("pr-1"), Name: domain.PrName("PR1"), AuthorId: domain.MemberId(uuid.New().String()), Status: domain.PrStatusOpen},
 				},
+				OpenReviews: 1,
 			},
 			wantErr: nil,
 		},
+		{
+			name:     "extra row gives next cursor",
+			memberId: domain.MemberId(uuid.New().String()),
+			repoSetup: func(mockRepo *mocks.MembersRepository, memberId domain.MemberId) {
+				mockRepo.EXPECT().GetMemberReviewCapacity(memberId).Return(domain.NewReviewCapacity(3), nil)
+				mockRepo.EXPECT().CountOpenReviewsByMember(memberId).Return(2, nil)
+				mockRepo.EXPECT().GetPrReviewsByMember(
+					memberId, statuses, page,
+				).Return(domain.PullRequests{
+					{Id: domain.PrId(uuid.New().String()), Status: domain.PrStatusOpen, CreatedAt: createdAt.Add(time.Minute)},
+					{Id: domain.PrId(uuid.New().String()), Status: domain.PrStatusOpen, CreatedAt: createdAt},
+				}, nil)
+			},
+			want: domain.Member{
+				Reviews:     domain.PullRequests{{Status: domain.PrStatusOpen}},
+				OpenReviews: 2,
+			},
+			wantNext: true,
+			wantErr:  nil,
+		},
 		{
 			name:     "empty reviews",
 			memberId: domain.MemberId(uuid.New().String()),
 			repoSetup: func(mockRepo *mocks.MembersRepository, memberId domain.MemberId) {
+				mockRepo.EXPECT().GetMemberReviewCapacity(memberId).Return(domain.NewReviewCapacity(3), nil)
+				mockRepo.EXPECT().CountOpenReviewsByMember(memberId).Return(0, nil)
 				mockRepo.EXPECT().GetPrReviewsByMember(
-					memberId,
+					memberId, statuses, page,
 				).Return(domain.PullRequests{}, nil)
 			},
 			want:    domain.Member{},
@@ -139,8 +322,10 @@ func TestMembersService_MemberReviews(t *testing.T) {
 			name:     "member not found",
 			memberId: domain.MemberId(uuid.New().String()),
 			repoSetup: func(mockRepo *mocks.MembersRepository, memberId domain.MemberId) {
+				mockRepo.EXPECT().GetMemberReviewCapacity(memberId).Return(domain.NewReviewCapacity(3), nil)
+				mockRepo.EXPECT().CountOpenReviewsByMember(memberId).Return(0, nil)
 				mockRepo.EXPECT().GetPrReviewsByMember(
-					memberId,
+					memberId, statuses, page,
 				).Return(domain.PullRequests{}, domain.ErrNotFound)
 			},
 			want:    domain.Member{},
@@ -150,13 +335,34 @@ func TestMembersService_MemberReviews(t *testing.T) {
 			name:     "internal error",
 			memberId: domain.MemberId(uuid.New().String()),
 			repoSetup: func(mockRepo *mocks.MembersRepository, memberId domain.MemberId) {
+				mockRepo.EXPECT().GetMemberReviewCapacity(memberId).Return(domain.NewReviewCapacity(3), nil)
+				mockRepo.EXPECT().CountOpenReviewsByMember(memberId).Return(0, nil)
 				mockRepo.EXPECT().GetPrReviewsByMember(
-					memberId,
+					memberId, statuses, page,
 				).Return(domain.PullRequests{}, errors.New("database error"))
 			},
 			want:    domain.Member{},
 			wantErr: domain.ErrInternal,
 		},
+		{
+			name:     "open reviews count error",
+			memberId: domain.MemberId(uuid.New().String()),
+			repoSetup: func(mockRepo *mocks.MembersRepository, memberId domain.MemberId) {
+				mockRepo.EXPECT().GetMemberReviewCapacity(memberId).Return(domain.NewReviewCapacity(3), nil)
+				mockRepo.EXPECT().CountOpenReviewsByMember(memberId).Return(0, errors.New("database error"))
+			},
+			want:    domain.Member{},
+			wantErr: domain.ErrInternal,
+		},
+		{
+			name:     "capacity lookup member not found",
+			memberId: domain.MemberId(uuid.New().String()),
+			repoSetup: func(mockRepo *mocks.MembersRepository, memberId domain.MemberId) {
+				mockRepo.EXPECT().GetMemberReviewCapacity(memberId).Return(domain.ReviewCapacity{}, domain.ErrNotFound)
+			},
+			want:    domain.Member{},
+			wantErr: domain.ErrNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -170,18 +376,73 @@ func TestMembersService_MemberReviews(t *testing.T) {
 				AllowedRolesToReasign:   []string{"default"},
 			}
 
-			service := NewMembersService(cfg, mockRepo)
-			got, err := service.MemberReviews(tt.memberId)
+			service := servmembers.NewMembersService(cfg, mockRepo, nil, nil)
+			got, next, err := service.MemberReviews(tt.memberId, statuses, page)
 
 			if tt.wantErr != nil {
 				assert.Error(t, err)
 				assert.True(t, errors.Is(err, tt.wantErr))
 			} else {
 				assert.NoError(t, err)
-				if !tt.want.Reviews.Empty() {
-					assert.Equal(t, len(tt.want.Reviews), len(got.Reviews))
-				}
+				assert.Equal(t, domain.NewReviewCapacity(3), got.Capacity)
+				assert.Equal(t, tt.want.OpenReviews, got.OpenReviews)
+				assert.Equal(t, len(tt.want.Reviews), len(got.Reviews))
+				assert.Equal(t, tt.wantNext, next != "")
 			}
 		})
 	}
 }
+
+func TestMembersService_SetMemberPrimaryTeam(t *testing.T) {
+	memberID := domain.MemberId(uuid.New().String())
+
+	tests := []struct {
+		name      string
+		repoSetup func(*mocks.MembersRepository)
+		wantErr   error
+	}{
+		{
+			name: "successful update",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().UpdateMemberPrimaryTeam(memberID, domain.TeamName("platform")).
+					Return(domain.Member{Id: memberID, Team: "platform"}, nil)
+			},
+		},
+		{
+			name: "member not in team",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().UpdateMemberPrimaryTeam(memberID, domain.TeamName("platform")).
+					Return(domain.Member{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "internal error",
+			repoSetup: func(mockRepo *mocks.MembersRepository) {
+				mockRepo.EXPECT().UpdateMemberPrimaryTeam(memberID, domain.TeamName("platform")).
+					Return(domain.Member{}, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMembersRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servmembers.NewMembersService(&configs.BussinesLogic{}, mockRepo, nil, nil)
+			member := domain.MemberBuilder(memberID).Build()
+			member.Team = "platform"
+			got, err := service.SetMemberPrimaryTeam(member)
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, domain.TeamName("platform"), got.Team)
+		})
+	}
+}
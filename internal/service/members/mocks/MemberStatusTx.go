@@ -0,0 +1,358 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MemberStatusTx is an autogenerated mock type for the MemberStatusTx type
+type MemberStatusTx struct {
+	mock.Mock
+}
+
+type MemberStatusTx_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MemberStatusTx) EXPECT() *MemberStatusTx_Expecter {
+	return &MemberStatusTx_Expecter{mock: &_m.Mock}
+}
+
+// Commit provides a mock function with no fields
+func (_m *MemberStatusTx) Commit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MemberStatusTx_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MemberStatusTx_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+func (_e *MemberStatusTx_Expecter) Commit() *MemberStatusTx_Commit_Call {
+	return &MemberStatusTx_Commit_Call{Call: _e.mock.On("Commit")}
+}
+
+func (_c *MemberStatusTx_Commit_Call) Run(run func()) *MemberStatusTx_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MemberStatusTx_Commit_Call) Return(_a0 error) *MemberStatusTx_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MemberStatusTx_Commit_Call) RunAndReturn(run func() error) *MemberStatusTx_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenAssignments provides a mock function with given fields: _a0, _a1
+func (_m *MemberStatusTx) GetOpenAssignments(_a0 context.Context, _a1 []domain.MemberId) (domain.ReviewAssignments, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenAssignments")
+	}
+
+	var r0 domain.ReviewAssignments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.MemberId) (domain.ReviewAssignments, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.MemberId) domain.ReviewAssignments); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.ReviewAssignments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MemberStatusTx_GetOpenAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenAssignments'
+type MemberStatusTx_GetOpenAssignments_Call struct {
+	*mock.Call
+}
+
+// GetOpenAssignments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.MemberId
+func (_e *MemberStatusTx_Expecter) GetOpenAssignments(_a0 interface{}, _a1 interface{}) *MemberStatusTx_GetOpenAssignments_Call {
+	return &MemberStatusTx_GetOpenAssignments_Call{Call: _e.mock.On("GetOpenAssignments", _a0, _a1)}
+}
+
+func (_c *MemberStatusTx_GetOpenAssignments_Call) Run(run func(_a0 context.Context, _a1 []domain.MemberId)) *MemberStatusTx_GetOpenAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *MemberStatusTx_GetOpenAssignments_Call) Return(_a0 domain.ReviewAssignments, _a1 error) *MemberStatusTx_GetOpenAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MemberStatusTx_GetOpenAssignments_Call) RunAndReturn(run func(context.Context, []domain.MemberId) (domain.ReviewAssignments, error)) *MemberStatusTx_GetOpenAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplacementCandidates provides a mock function with given fields: _a0, _a1
+func (_m *MemberStatusTx) GetReplacementCandidates(_a0 context.Context, _a1 []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplacementCandidates")
+	}
+
+	var r0 map[domain.TeamName]domain.MembersHistories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) map[domain.TeamName]domain.MembersHistories); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.TeamName]domain.MembersHistories)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.TeamName) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MemberStatusTx_GetReplacementCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplacementCandidates'
+type MemberStatusTx_GetReplacementCandidates_Call struct {
+	*mock.Call
+}
+
+// GetReplacementCandidates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.TeamName
+func (_e *MemberStatusTx_Expecter) GetReplacementCandidates(_a0 interface{}, _a1 interface{}) *MemberStatusTx_GetReplacementCandidates_Call {
+	return &MemberStatusTx_GetReplacementCandidates_Call{Call: _e.mock.On("GetReplacementCandidates", _a0, _a1)}
+}
+
+func (_c *MemberStatusTx_GetReplacementCandidates_Call) Run(run func(_a0 context.Context, _a1 []domain.TeamName)) *MemberStatusTx_GetReplacementCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *MemberStatusTx_GetReplacementCandidates_Call) Return(_a0 map[domain.TeamName]domain.MembersHistories, _a1 error) *MemberStatusTx_GetReplacementCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MemberStatusTx_GetReplacementCandidates_Call) RunAndReturn(run func(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)) *MemberStatusTx_GetReplacementCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceReviewers provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MemberStatusTx) ReplaceReviewers(_a0 context.Context, _a1 []domain.Reassignment, _a2 domain.AssignmentAudit) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Reassignment, domain.AssignmentAudit) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MemberStatusTx_ReplaceReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceReviewers'
+type MemberStatusTx_ReplaceReviewers_Call struct {
+	*mock.Call
+}
+
+// ReplaceReviewers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.Reassignment
+//   - _a2 domain.AssignmentAudit
+func (_e *MemberStatusTx_Expecter) ReplaceReviewers(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MemberStatusTx_ReplaceReviewers_Call {
+	return &MemberStatusTx_ReplaceReviewers_Call{Call: _e.mock.On("ReplaceReviewers", _a0, _a1, _a2)}
+}
+
+func (_c *MemberStatusTx_ReplaceReviewers_Call) Run(run func(_a0 context.Context, _a1 []domain.Reassignment, _a2 domain.AssignmentAudit)) *MemberStatusTx_ReplaceReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.Reassignment), args[2].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *MemberStatusTx_ReplaceReviewers_Call) Return(_a0 error) *MemberStatusTx_ReplaceReviewers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MemberStatusTx_ReplaceReviewers_Call) RunAndReturn(run func(context.Context, []domain.Reassignment, domain.AssignmentAudit) error) *MemberStatusTx_ReplaceReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with no fields
+func (_m *MemberStatusTx) Rollback() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MemberStatusTx_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type MemberStatusTx_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+func (_e *MemberStatusTx_Expecter) Rollback() *MemberStatusTx_Rollback_Call {
+	return &MemberStatusTx_Rollback_Call{Call: _e.mock.On("Rollback")}
+}
+
+func (_c *MemberStatusTx_Rollback_Call) Run(run func()) *MemberStatusTx_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MemberStatusTx_Rollback_Call) Return(_a0 error) *MemberStatusTx_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MemberStatusTx_Rollback_Call) RunAndReturn(run func() error) *MemberStatusTx_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMemberStatus provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MemberStatusTx) UpdateMemberStatus(_a0 context.Context, _a1 domain.MemberId, _a2 domain.MemberStatus) (domain.Member, bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMemberStatus")
+	}
+
+	var r0 domain.Member
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberId, domain.MemberStatus) (domain.Member, bool, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberId, domain.MemberStatus) domain.Member); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(domain.Member)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MemberId, domain.MemberStatus) bool); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.MemberId, domain.MemberStatus) error); ok {
+		r2 = rf(_a0, _a1, _a2)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MemberStatusTx_UpdateMemberStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMemberStatus'
+type MemberStatusTx_UpdateMemberStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateMemberStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.MemberId
+//   - _a2 domain.MemberStatus
+func (_e *MemberStatusTx_Expecter) UpdateMemberStatus(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MemberStatusTx_UpdateMemberStatus_Call {
+	return &MemberStatusTx_UpdateMemberStatus_Call{Call: _e.mock.On("UpdateMemberStatus", _a0, _a1, _a2)}
+}
+
+func (_c *MemberStatusTx_UpdateMemberStatus_Call) Run(run func(_a0 context.Context, _a1 domain.MemberId, _a2 domain.MemberStatus)) *MemberStatusTx_UpdateMemberStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberId), args[2].(domain.MemberStatus))
+	})
+	return _c
+}
+
+func (_c *MemberStatusTx_UpdateMemberStatus_Call) Return(_a0 domain.Member, changed bool, _a2 error) *MemberStatusTx_UpdateMemberStatus_Call {
+	_c.Call.Return(_a0, changed, _a2)
+	return _c
+}
+
+func (_c *MemberStatusTx_UpdateMemberStatus_Call) RunAndReturn(run func(context.Context, domain.MemberId, domain.MemberStatus) (domain.Member, bool, error)) *MemberStatusTx_UpdateMemberStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMemberStatusTx creates a new instance of MemberStatusTx. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMemberStatusTx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MemberStatusTx {
+	mock := &MemberStatusTx{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,8 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	servmembers "github.com/eragon-mdi/pr-reviewer-service/internal/service/members"
 )
 
 // MembersRepository is an autogenerated mock type for the MembersRepository type
@@ -20,27 +24,130 @@ func (_m *MembersRepository) EXPECT() *MembersRepository_Expecter {
 	return &MembersRepository_Expecter{mock: &_m.Mock}
 }
 
-// GetPrReviewsByMember provides a mock function with given fields: _a0
-func (_m *MembersRepository) GetPrReviewsByMember(_a0 domain.MemberId) (domain.PullRequests, error) {
+// AddMemberIdentity provides a mock function with given fields: _a0, _a1
+func (_m *MembersRepository) AddMemberIdentity(_a0 context.Context, _a1 domain.MemberIdentity) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMemberIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberIdentity) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MembersRepository_AddMemberIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMemberIdentity'
+type MembersRepository_AddMemberIdentity_Call struct {
+	*mock.Call
+}
+
+// AddMemberIdentity is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.MemberIdentity
+func (_e *MembersRepository_Expecter) AddMemberIdentity(_a0 interface{}, _a1 interface{}) *MembersRepository_AddMemberIdentity_Call {
+	return &MembersRepository_AddMemberIdentity_Call{Call: _e.mock.On("AddMemberIdentity", _a0, _a1)}
+}
+
+func (_c *MembersRepository_AddMemberIdentity_Call) Run(run func(_a0 context.Context, _a1 domain.MemberIdentity)) *MembersRepository_AddMemberIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberIdentity))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_AddMemberIdentity_Call) Return(_a0 error) *MembersRepository_AddMemberIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MembersRepository_AddMemberIdentity_Call) RunAndReturn(run func(context.Context, domain.MemberIdentity) error) *MembersRepository_AddMemberIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginMemberStatusTx provides a mock function with given fields: _a0
+func (_m *MembersRepository) BeginMemberStatusTx(_a0 context.Context) (servmembers.MemberStatusTx, error) {
 	ret := _m.Called(_a0)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrReviewsByMember")
+		panic("no return value specified for BeginMemberStatusTx")
 	}
 
-	var r0 domain.PullRequests
+	var r0 servmembers.MemberStatusTx
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.MemberId) (domain.PullRequests, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) (servmembers.MemberStatusTx, error)); ok {
 		return rf(_a0)
 	}
-	if rf, ok := ret.Get(0).(func(domain.MemberId) domain.PullRequests); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) servmembers.MemberStatusTx); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(domain.PullRequests)
+			r0 = ret.Get(0).(servmembers.MemberStatusTx)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersRepository_BeginMemberStatusTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginMemberStatusTx'
+type MembersRepository_BeginMemberStatusTx_Call struct {
+	*mock.Call
+}
+
+// BeginMemberStatusTx is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MembersRepository_Expecter) BeginMemberStatusTx(_a0 interface{}) *MembersRepository_BeginMemberStatusTx_Call {
+	return &MembersRepository_BeginMemberStatusTx_Call{Call: _e.mock.On("BeginMemberStatusTx", _a0)}
+}
+
+func (_c *MembersRepository_BeginMemberStatusTx_Call) Run(run func(_a0 context.Context)) *MembersRepository_BeginMemberStatusTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_BeginMemberStatusTx_Call) Return(_a0 servmembers.MemberStatusTx, _a1 error) *MembersRepository_BeginMemberStatusTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_BeginMemberStatusTx_Call) RunAndReturn(run func(context.Context) (servmembers.MemberStatusTx, error)) *MembersRepository_BeginMemberStatusTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOpenReviewsByMember provides a mock function with given fields: _a0
+func (_m *MembersRepository) CountOpenReviewsByMember(_a0 domain.MemberId) (int, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOpenReviewsByMember")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId) (int, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId) int); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
 	if rf, ok := ret.Get(1).(func(domain.MemberId) error); ok {
 		r1 = rf(_a0)
 	} else {
@@ -50,6 +157,292 @@ func (_m *MembersRepository) GetPrReviewsByMember(_a0 domain.MemberId) (domain.P
 	return r0, r1
 }
 
+// MembersRepository_CountOpenReviewsByMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOpenReviewsByMember'
+type MembersRepository_CountOpenReviewsByMember_Call struct {
+	*mock.Call
+}
+
+// CountOpenReviewsByMember is a helper method to define mock.On call
+//   - _a0 domain.MemberId
+func (_e *MembersRepository_Expecter) CountOpenReviewsByMember(_a0 interface{}) *MembersRepository_CountOpenReviewsByMember_Call {
+	return &MembersRepository_CountOpenReviewsByMember_Call{Call: _e.mock.On("CountOpenReviewsByMember", _a0)}
+}
+
+func (_c *MembersRepository_CountOpenReviewsByMember_Call) Run(run func(_a0 domain.MemberId)) *MembersRepository_CountOpenReviewsByMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_CountOpenReviewsByMember_Call) Return(_a0 int, _a1 error) *MembersRepository_CountOpenReviewsByMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_CountOpenReviewsByMember_Call) RunAndReturn(run func(domain.MemberId) (int, error)) *MembersRepository_CountOpenReviewsByMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMember provides a mock function with given fields: _a0
+func (_m *MembersRepository) GetMember(_a0 domain.MemberId) (domain.Member, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMember")
+	}
+
+	var r0 domain.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId) (domain.Member, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId) domain.Member); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(domain.Member)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberId) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersRepository_GetMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMember'
+type MembersRepository_GetMember_Call struct {
+	*mock.Call
+}
+
+// GetMember is a helper method to define mock.On call
+//   - _a0 domain.MemberId
+func (_e *MembersRepository_Expecter) GetMember(_a0 interface{}) *MembersRepository_GetMember_Call {
+	return &MembersRepository_GetMember_Call{Call: _e.mock.On("GetMember", _a0)}
+}
+
+func (_c *MembersRepository_GetMember_Call) Run(run func(_a0 domain.MemberId)) *MembersRepository_GetMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_GetMember_Call) Return(_a0 domain.Member, _a1 error) *MembersRepository_GetMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_GetMember_Call) RunAndReturn(run func(domain.MemberId) (domain.Member, error)) *MembersRepository_GetMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemberIdByIdentity provides a mock function with given fields: _a0, _a1
+func (_m *MembersRepository) GetMemberIdByIdentity(_a0 context.Context, _a1 domain.Identity) (domain.MemberId, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberIdByIdentity")
+	}
+
+	var r0 domain.MemberId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Identity) (domain.MemberId, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Identity) domain.MemberId); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.MemberId)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Identity) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersRepository_GetMemberIdByIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberIdByIdentity'
+type MembersRepository_GetMemberIdByIdentity_Call struct {
+	*mock.Call
+}
+
+// GetMemberIdByIdentity is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Identity
+func (_e *MembersRepository_Expecter) GetMemberIdByIdentity(_a0 interface{}, _a1 interface{}) *MembersRepository_GetMemberIdByIdentity_Call {
+	return &MembersRepository_GetMemberIdByIdentity_Call{Call: _e.mock.On("GetMemberIdByIdentity", _a0, _a1)}
+}
+
+func (_c *MembersRepository_GetMemberIdByIdentity_Call) Run(run func(_a0 context.Context, _a1 domain.Identity)) *MembersRepository_GetMemberIdByIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Identity))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_GetMemberIdByIdentity_Call) Return(_a0 domain.MemberId, _a1 error) *MembersRepository_GetMemberIdByIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_GetMemberIdByIdentity_Call) RunAndReturn(run func(context.Context, domain.Identity) (domain.MemberId, error)) *MembersRepository_GetMemberIdByIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemberIdentities provides a mock function with given fields: _a0, _a1
+func (_m *MembersRepository) GetMemberIdentities(_a0 context.Context, _a1 domain.MemberId) ([]domain.Identity, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberIdentities")
+	}
+
+	var r0 []domain.Identity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberId) ([]domain.Identity, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberId) []domain.Identity); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Identity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersRepository_GetMemberIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberIdentities'
+type MembersRepository_GetMemberIdentities_Call struct {
+	*mock.Call
+}
+
+// GetMemberIdentities is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.MemberId
+func (_e *MembersRepository_Expecter) GetMemberIdentities(_a0 interface{}, _a1 interface{}) *MembersRepository_GetMemberIdentities_Call {
+	return &MembersRepository_GetMemberIdentities_Call{Call: _e.mock.On("GetMemberIdentities", _a0, _a1)}
+}
+
+func (_c *MembersRepository_GetMemberIdentities_Call) Run(run func(_a0 context.Context, _a1 domain.MemberId)) *MembersRepository_GetMemberIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_GetMemberIdentities_Call) Return(_a0 []domain.Identity, _a1 error) *MembersRepository_GetMemberIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_GetMemberIdentities_Call) RunAndReturn(run func(context.Context, domain.MemberId) ([]domain.Identity, error)) *MembersRepository_GetMemberIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemberReviewCapacity provides a mock function with given fields: _a0
+func (_m *MembersRepository) GetMemberReviewCapacity(_a0 domain.MemberId) (domain.ReviewCapacity, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberReviewCapacity")
+	}
+
+	var r0 domain.ReviewCapacity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId) (domain.ReviewCapacity, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId) domain.ReviewCapacity); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(domain.ReviewCapacity)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberId) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersRepository_GetMemberReviewCapacity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberReviewCapacity'
+type MembersRepository_GetMemberReviewCapacity_Call struct {
+	*mock.Call
+}
+
+// GetMemberReviewCapacity is a helper method to define mock.On call
+//   - _a0 domain.MemberId
+func (_e *MembersRepository_Expecter) GetMemberReviewCapacity(_a0 interface{}) *MembersRepository_GetMemberReviewCapacity_Call {
+	return &MembersRepository_GetMemberReviewCapacity_Call{Call: _e.mock.On("GetMemberReviewCapacity", _a0)}
+}
+
+func (_c *MembersRepository_GetMemberReviewCapacity_Call) Run(run func(_a0 domain.MemberId)) *MembersRepository_GetMemberReviewCapacity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_GetMemberReviewCapacity_Call) Return(_a0 domain.ReviewCapacity, _a1 error) *MembersRepository_GetMemberReviewCapacity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_GetMemberReviewCapacity_Call) RunAndReturn(run func(domain.MemberId) (domain.ReviewCapacity, error)) *MembersRepository_GetMemberReviewCapacity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrReviewsByMember provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MembersRepository) GetPrReviewsByMember(_a0 domain.MemberId, _a1 []domain.PrStatus, _a2 domain.PageRequest) (domain.PullRequests, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrReviewsByMember")
+	}
+
+	var r0 domain.PullRequests
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId, []domain.PrStatus, domain.PageRequest) (domain.PullRequests, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId, []domain.PrStatus, domain.PageRequest) domain.PullRequests); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.PullRequests)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberId, []domain.PrStatus, domain.PageRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MembersRepository_GetPrReviewsByMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrReviewsByMember'
 type MembersRepository_GetPrReviewsByMember_Call struct {
 	*mock.Call
@@ -57,13 +450,15 @@ type MembersRepository_GetPrReviewsByMember_Call struct {
 
 // GetPrReviewsByMember is a helper method to define mock.On call
 //   - _a0 domain.MemberId
-func (_e *MembersRepository_Expecter) GetPrReviewsByMember(_a0 interface{}) *MembersRepository_GetPrReviewsByMember_Call {
-	return &MembersRepository_GetPrReviewsByMember_Call{Call: _e.mock.On("GetPrReviewsByMember", _a0)}
+//   - _a1 []domain.PrStatus
+//   - _a2 domain.PageRequest
+func (_e *MembersRepository_Expecter) GetPrReviewsByMember(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MembersRepository_GetPrReviewsByMember_Call {
+	return &MembersRepository_GetPrReviewsByMember_Call{Call: _e.mock.On("GetPrReviewsByMember", _a0, _a1, _a2)}
 }
 
-func (_c *MembersRepository_GetPrReviewsByMember_Call) Run(run func(_a0 domain.MemberId)) *MembersRepository_GetPrReviewsByMember_Call {
+func (_c *MembersRepository_GetPrReviewsByMember_Call) Run(run func(_a0 domain.MemberId, _a1 []domain.PrStatus, _a2 domain.PageRequest)) *MembersRepository_GetPrReviewsByMember_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.MemberId))
+		run(args[0].(domain.MemberId), args[1].([]domain.PrStatus), args[2].(domain.PageRequest))
 	})
 	return _c
 }
@@ -73,31 +468,251 @@ func (_c *MembersRepository_GetPrReviewsByMember_Call) Return(_a0 domain.PullReq
 	return _c
 }
 
-func (_c *MembersRepository_GetPrReviewsByMember_Call) RunAndReturn(run func(domain.MemberId) (domain.PullRequests, error)) *MembersRepository_GetPrReviewsByMember_Call {
+func (_c *MembersRepository_GetPrReviewsByMember_Call) RunAndReturn(run func(domain.MemberId, []domain.PrStatus, domain.PageRequest) (domain.PullRequests, error)) *MembersRepository_GetPrReviewsByMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateMemberStatus provides a mock function with given fields: _a0, _a1
-func (_m *MembersRepository) UpdateMemberStatus(_a0 domain.MemberId, _a1 domain.MemberStatus) (domain.Member, error) {
+// ListMembers provides a mock function with given fields: _a0, _a1
+func (_m *MembersRepository) ListMembers(_a0 domain.MemberFilter, _a1 domain.MemberPageRequest) (domain.Members, error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateMemberStatus")
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 domain.Members
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberFilter, domain.MemberPageRequest) (domain.Members, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberFilter, domain.MemberPageRequest) domain.Members); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.Members)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberFilter, domain.MemberPageRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersRepository_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type MembersRepository_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - _a0 domain.MemberFilter
+//   - _a1 domain.MemberPageRequest
+func (_e *MembersRepository_Expecter) ListMembers(_a0 interface{}, _a1 interface{}) *MembersRepository_ListMembers_Call {
+	return &MembersRepository_ListMembers_Call{Call: _e.mock.On("ListMembers", _a0, _a1)}
+}
+
+func (_c *MembersRepository_ListMembers_Call) Run(run func(_a0 domain.MemberFilter, _a1 domain.MemberPageRequest)) *MembersRepository_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberFilter), args[1].(domain.MemberPageRequest))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_ListMembers_Call) Return(_a0 domain.Members, _a1 error) *MembersRepository_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_ListMembers_Call) RunAndReturn(run func(domain.MemberFilter, domain.MemberPageRequest) (domain.Members, error)) *MembersRepository_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMemberIdentity provides a mock function with given fields: _a0, _a1
+func (_m *MembersRepository) RemoveMemberIdentity(_a0 context.Context, _a1 domain.MemberIdentity) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMemberIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberIdentity) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MembersRepository_RemoveMemberIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMemberIdentity'
+type MembersRepository_RemoveMemberIdentity_Call struct {
+	*mock.Call
+}
+
+// RemoveMemberIdentity is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.MemberIdentity
+func (_e *MembersRepository_Expecter) RemoveMemberIdentity(_a0 interface{}, _a1 interface{}) *MembersRepository_RemoveMemberIdentity_Call {
+	return &MembersRepository_RemoveMemberIdentity_Call{Call: _e.mock.On("RemoveMemberIdentity", _a0, _a1)}
+}
+
+func (_c *MembersRepository_RemoveMemberIdentity_Call) Run(run func(_a0 context.Context, _a1 domain.MemberIdentity)) *MembersRepository_RemoveMemberIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberIdentity))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_RemoveMemberIdentity_Call) Return(_a0 error) *MembersRepository_RemoveMemberIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MembersRepository_RemoveMemberIdentity_Call) RunAndReturn(run func(context.Context, domain.MemberIdentity) error) *MembersRepository_RemoveMemberIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMemberPrimaryTeam provides a mock function with given fields: _a0, _a1
+func (_m *MembersRepository) UpdateMemberPrimaryTeam(_a0 domain.MemberId, _a1 domain.TeamName) (domain.Member, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMemberPrimaryTeam")
 	}
 
 	var r0 domain.Member
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.MemberStatus) (domain.Member, error)); ok {
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.TeamName) (domain.Member, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.MemberStatus) domain.Member); ok {
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.TeamName) domain.Member); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Member)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberId, domain.TeamName) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersRepository_UpdateMemberPrimaryTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMemberPrimaryTeam'
+type MembersRepository_UpdateMemberPrimaryTeam_Call struct {
+	*mock.Call
+}
+
+// UpdateMemberPrimaryTeam is a helper method to define mock.On call
+//   - _a0 domain.MemberId
+//   - _a1 domain.TeamName
+func (_e *MembersRepository_Expecter) UpdateMemberPrimaryTeam(_a0 interface{}, _a1 interface{}) *MembersRepository_UpdateMemberPrimaryTeam_Call {
+	return &MembersRepository_UpdateMemberPrimaryTeam_Call{Call: _e.mock.On("UpdateMemberPrimaryTeam", _a0, _a1)}
+}
+
+func (_c *MembersRepository_UpdateMemberPrimaryTeam_Call) Run(run func(_a0 domain.MemberId, _a1 domain.TeamName)) *MembersRepository_UpdateMemberPrimaryTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberId), args[1].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_UpdateMemberPrimaryTeam_Call) Return(_a0 domain.Member, _a1 error) *MembersRepository_UpdateMemberPrimaryTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_UpdateMemberPrimaryTeam_Call) RunAndReturn(run func(domain.MemberId, domain.TeamName) (domain.Member, error)) *MembersRepository_UpdateMemberPrimaryTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMemberProfile provides a mock function with given fields: _a0, _a1
+func (_m *MembersRepository) UpdateMemberProfile(_a0 domain.MemberId, _a1 domain.MemberPatch) (domain.Member, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMemberProfile")
+	}
+
+	var r0 domain.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.MemberPatch) (domain.Member, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.MemberPatch) domain.Member); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Member)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberId, domain.MemberPatch) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersRepository_UpdateMemberProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMemberProfile'
+type MembersRepository_UpdateMemberProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateMemberProfile is a helper method to define mock.On call
+//   - _a0 domain.MemberId
+//   - _a1 domain.MemberPatch
+func (_e *MembersRepository_Expecter) UpdateMemberProfile(_a0 interface{}, _a1 interface{}) *MembersRepository_UpdateMemberProfile_Call {
+	return &MembersRepository_UpdateMemberProfile_Call{Call: _e.mock.On("UpdateMemberProfile", _a0, _a1)}
+}
+
+func (_c *MembersRepository_UpdateMemberProfile_Call) Run(run func(_a0 domain.MemberId, _a1 domain.MemberPatch)) *MembersRepository_UpdateMemberProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberId), args[1].(domain.MemberPatch))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_UpdateMemberProfile_Call) Return(_a0 domain.Member, _a1 error) *MembersRepository_UpdateMemberProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_UpdateMemberProfile_Call) RunAndReturn(run func(domain.MemberId, domain.MemberPatch) (domain.Member, error)) *MembersRepository_UpdateMemberProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMemberReviewCapacity provides a mock function with given fields: _a0, _a1
+func (_m *MembersRepository) UpdateMemberReviewCapacity(_a0 domain.MemberId, _a1 domain.ReviewCapacity) (domain.Member, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMemberReviewCapacity")
+	}
+
+	var r0 domain.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.ReviewCapacity) (domain.Member, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.ReviewCapacity) domain.Member); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Get(0).(domain.Member)
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.MemberId, domain.MemberStatus) error); ok {
+	if rf, ok := ret.Get(1).(func(domain.MemberId, domain.ReviewCapacity) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -106,6 +721,70 @@ func (_m *MembersRepository) UpdateMemberStatus(_a0 domain.MemberId, _a1 domain.
 	return r0, r1
 }
 
+// MembersRepository_UpdateMemberReviewCapacity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMemberReviewCapacity'
+type MembersRepository_UpdateMemberReviewCapacity_Call struct {
+	*mock.Call
+}
+
+// UpdateMemberReviewCapacity is a helper method to define mock.On call
+//   - _a0 domain.MemberId
+//   - _a1 domain.ReviewCapacity
+func (_e *MembersRepository_Expecter) UpdateMemberReviewCapacity(_a0 interface{}, _a1 interface{}) *MembersRepository_UpdateMemberReviewCapacity_Call {
+	return &MembersRepository_UpdateMemberReviewCapacity_Call{Call: _e.mock.On("UpdateMemberReviewCapacity", _a0, _a1)}
+}
+
+func (_c *MembersRepository_UpdateMemberReviewCapacity_Call) Run(run func(_a0 domain.MemberId, _a1 domain.ReviewCapacity)) *MembersRepository_UpdateMemberReviewCapacity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberId), args[1].(domain.ReviewCapacity))
+	})
+	return _c
+}
+
+func (_c *MembersRepository_UpdateMemberReviewCapacity_Call) Return(_a0 domain.Member, _a1 error) *MembersRepository_UpdateMemberReviewCapacity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersRepository_UpdateMemberReviewCapacity_Call) RunAndReturn(run func(domain.MemberId, domain.ReviewCapacity) (domain.Member, error)) *MembersRepository_UpdateMemberReviewCapacity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMemberStatus provides a mock function with given fields: _a0, _a1
+func (_m *MembersRepository) UpdateMemberStatus(_a0 domain.MemberId, _a1 domain.MemberStatus) (domain.Member, bool, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMemberStatus")
+	}
+
+	var r0 domain.Member
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.MemberStatus) (domain.Member, bool, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.MemberStatus) domain.Member); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Member)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberId, domain.MemberStatus) bool); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(domain.MemberId, domain.MemberStatus) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // MembersRepository_UpdateMemberStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMemberStatus'
 type MembersRepository_UpdateMemberStatus_Call struct {
 	*mock.Call
@@ -125,12 +804,12 @@ func (_c *MembersRepository_UpdateMemberStatus_Call) Run(run func(_a0 domain.Mem
 	return _c
 }
 
-func (_c *MembersRepository_UpdateMemberStatus_Call) Return(_a0 domain.Member, _a1 error) *MembersRepository_UpdateMemberStatus_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MembersRepository_UpdateMemberStatus_Call) Return(_a0 domain.Member, changed bool, _a2 error) *MembersRepository_UpdateMemberStatus_Call {
+	_c.Call.Return(_a0, changed, _a2)
 	return _c
 }
 
-func (_c *MembersRepository_UpdateMemberStatus_Call) RunAndReturn(run func(domain.MemberId, domain.MemberStatus) (domain.Member, error)) *MembersRepository_UpdateMemberStatus_Call {
+func (_c *MembersRepository_UpdateMemberStatus_Call) RunAndReturn(run func(domain.MemberId, domain.MemberStatus) (domain.Member, bool, error)) *MembersRepository_UpdateMemberStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,7 +2,7 @@ package servmembers
 
 import (
 	"context"
-	"math/rand"
+	"fmt"
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 )
@@ -13,19 +13,26 @@ func (ms *MembersService) ReasignMember(
 	mems domain.MembersHistories,
 ) (nilId domain.MemberId, _ error) {
 
-	slMems := mems.Slice()
-	l := len(slMems)
+	allowed := make([]domain.MemberHistory, 0, len(mems))
+	for _, member := range mems.Slice() {
+		if member.Id != memId && ms.allowedRoles.IsMemberAllowed(member) {
+			allowed = append(allowed, member)
+		}
+	}
 
-	start := rand.Intn(l)
+	if len(allowed) == 0 {
+		return nilId, fmt.Errorf("%w: %w", domain.ErrForbidden, domain.ErrNoContent)
+	}
 
-	for i := range l {
-		idx := (start + i) % l
-		member := slMems[idx]
+	free := domain.MembersHistories(allowed).WithFreeCapacity()
+	if free.Empty() {
+		return nilId, domain.ErrCapacityExceeded
+	}
 
-		if ms.allowedRoles.IsMemberAllowed(member) {
-			return member.Id, nil
-		}
+	picked := ms.selector.Select(free, 1)
+	if picked.Empty() {
+		return nilId, fmt.Errorf("%w: %w", domain.ErrForbidden, domain.ErrNoContent)
 	}
 
-	return nilId, domain.ErrForbidden
+	return picked.Slice()[0].Id, nil
 }
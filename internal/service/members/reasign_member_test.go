@@ -8,6 +8,7 @@ import (
 	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	servmembers "github.com/eragon-mdi/pr-reviewer-service/internal/service/members"
+	servselector "github.com/eragon-mdi/pr-reviewer-service/internal/service/selector"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -115,7 +116,7 @@ func TestMembersService_ReasignMember(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			service := servmembers.NewMembersService(tt.cfg, nil)
+			service := servmembers.NewMembersService(tt.cfg, nil, servselector.New(servselector.StrategyRandom), nil)
 
 			result, err := service.ReasignMember(context.Background(), tt.memId, tt.mems)
 
@@ -132,3 +133,70 @@ func TestMembersService_ReasignMember(t *testing.T) {
 		})
 	}
 }
+
+func TestMembersService_ReasignMember_Capacity(t *testing.T) {
+	cfg := &configs.BussinesLogic{
+		AllowedReuseToReasign:   true,
+		AlloweStatusesToReasign: []string{"active"},
+		AllowedRolesToReasign:   []string{"default"},
+	}
+	oldID := domain.MemberId(uuid.New().String())
+
+	tests := []struct {
+		name    string
+		mems    domain.MembersHistories
+		want    domain.MemberId
+		wantErr error
+	}{
+		{
+			name: "saturated candidate skipped",
+			mems: domain.MembersHistories{
+				domain.NewMemberHistory("full", domain.MemberStatusActive, domain.MemberRoleDefault, false).
+					WithLoad(2).WithCapacity(domain.NewReviewCapacity(2)),
+				domain.NewMemberHistory("free", domain.MemberStatusActive, domain.MemberRoleDefault, false).
+					WithLoad(1).WithCapacity(domain.NewReviewCapacity(2)),
+			},
+			want: "free",
+		},
+		{
+			name: "all candidates at capacity",
+			mems: domain.MembersHistories{
+				domain.NewMemberHistory("full-1", domain.MemberStatusActive, domain.MemberRoleDefault, false).
+					WithLoad(1).WithCapacity(domain.NewReviewCapacity(1)),
+				domain.NewMemberHistory("full-2", domain.MemberStatusActive, domain.MemberRoleDefault, false).
+					WithLoad(3).WithCapacity(domain.NewReviewCapacity(0)),
+			},
+			wantErr: domain.ErrCapacityExceeded,
+		},
+		{
+			name: "no allowed candidates is not a capacity error",
+			mems: domain.MembersHistories{
+				domain.NewMemberHistory("inactive", domain.MemberStatusInactive, domain.MemberRoleDefault, false).
+					WithLoad(5).WithCapacity(domain.NewReviewCapacity(1)),
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name: "replaced reviewer is never picked",
+			mems: domain.MembersHistories{
+				domain.NewMemberHistory(oldID, domain.MemberStatusActive, domain.MemberRoleDefault, false),
+			},
+			wantErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := servmembers.NewMembersService(cfg, nil, servselector.New(servselector.StrategyRandom), nil)
+
+			got, err := service.ReasignMember(context.Background(), oldID, tt.mems)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package servmembers
 
 import (
+	"context"
+
 	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 )
@@ -8,9 +10,14 @@ import (
 type MembersService struct {
 	repo         Repository
 	allowedRoles domain.AllowedRules
+	selector     ReviewerSelector
+
+	reassignOnDeactivate bool
+	events               EventPublisher
 }
 
-func NewMembersService(cfg *configs.BussinesLogic, r Repository) *MembersService {
+// NewMembersService pub announces the committed changes, nil announces nothing.
+func NewMembersService(cfg *configs.BussinesLogic, r Repository, sel ReviewerSelector, pub EventPublisher) *MembersService {
 	return &MembersService{
 		repo: r,
 		allowedRoles: domain.NewAllowedRules(
@@ -18,9 +25,27 @@ func NewMembersService(cfg *configs.BussinesLogic, r Repository) *MembersService
 			domain.MembersStatusesFromSliceOfStrings(cfg.AlloweStatusesToReasign),
 			domain.MembersRolesFromSliceOfStrings(cfg.AllowedRolesToReasign),
 		),
+		selector:             sel,
+		reassignOnDeactivate: cfg.ReassignOnDeactivate,
+		events:               pub,
 	}
 }
 
 type Repository interface {
 	MembersRepository
 }
+
+type ReviewerSelector interface {
+	Select(candidates domain.MembersHistories, n int) domain.MembersHistories
+}
+
+// EventPublisher announces committed changes to the webhook subscriptions.
+type EventPublisher interface {
+	Publish(context.Context, ...domain.Event)
+}
+
+func (ms *MembersService) publish(ctx context.Context, events ...domain.Event) {
+	if ms.events != nil && len(events) > 0 {
+		ms.events.Publish(ctx, events...)
+	}
+}
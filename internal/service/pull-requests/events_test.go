@@ -0,0 +1,206 @@
+package servpullrequests_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servpullrequests "github.com/eragon-mdi/pr-reviewer-service/internal/service/pull-requests"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/pull-requests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func eventOf(t domain.EventType, check func(domain.Event) bool) any {
+	return mock.MatchedBy(func(e domain.Event) bool {
+		return e.Type == t && (check == nil || check(e))
+	})
+}
+
+func TestPrService_Events(t *testing.T) {
+	ctx := context.Background()
+	prID := domain.PrId("pr-123")
+	authorID := domain.MemberId("author")
+	team := domain.TeamName("backend")
+	candidates := domain.MembersHistories{
+		domain.NewMemberHistory("rev-1", domain.MemberStatusActive, domain.MemberRoleDefault, false),
+		domain.NewMemberHistory("rev-2", domain.MemberStatusActive, domain.MemberRoleDefault, false),
+	}
+	reviewers := func(e domain.Event) bool {
+		return len(e.Reviewers) == 2 && e.Reviewers[0] == "rev-1" && e.Reviewers[1] == "rev-2"
+	}
+	openPR := domain.PullRequest{Id: prID, AuthorId: authorID, Team: team, Status: domain.PrStatusOpen, AssignedReviews: candidates.Members()}
+	mergedPR := openPR
+	mergedPR.Status = domain.PrStatusMerged
+
+	tests := []struct {
+		name   string
+		setup  func(*mocks.PullRequestsRepository, *mocks.ReviewerSelector, *mocks.EventPublisher)
+		change func(*servpullrequests.PrService) error
+	}{
+		{
+			name: "created pr announces its reviewers",
+			setup: func(repo *mocks.PullRequestsRepository, sel *mocks.ReviewerSelector, pub *mocks.EventPublisher) {
+				repo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				repo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				repo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(candidates, nil)
+				sel.EXPECT().Select(candidates, domain.DefaultRequiredReviewers).Return(candidates)
+				repo.EXPECT().CreatePullRequest(mock.Anything).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+					return pr, nil
+				})
+				pub.EXPECT().Publish(mock.Anything,
+					eventOf(domain.EventPrCreated, func(e domain.Event) bool { return e.PrId == prID && e.AuthorId == authorID }),
+					eventOf(domain.EventReviewerAssigned, reviewers),
+				).Once()
+			},
+			change: func(s *servpullrequests.PrService) error {
+				_, err := s.NewPullRequest(ctx, domain.PullRequestShort{Id: prID, Name: "Test PR", AuthorId: authorID})
+				return err
+			},
+		},
+		{
+			name: "created draft has no reviewers to announce",
+			setup: func(repo *mocks.PullRequestsRepository, _ *mocks.ReviewerSelector, pub *mocks.EventPublisher) {
+				repo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				repo.EXPECT().CreatePullRequest(mock.Anything).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+					return pr, nil
+				})
+				pub.EXPECT().Publish(mock.Anything, eventOf(domain.EventPrCreated, nil)).Once()
+			},
+			change: func(s *servpullrequests.PrService) error {
+				_, err := s.NewPullRequest(ctx, domain.PullRequestShort{Id: prID, Name: "Test PR", AuthorId: authorID, Draft: true})
+				return err
+			},
+		},
+		{
+			name: "duplicate pr announces nothing",
+			setup: func(repo *mocks.PullRequestsRepository, sel *mocks.ReviewerSelector, _ *mocks.EventPublisher) {
+				repo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				repo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				repo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(candidates, nil)
+				sel.EXPECT().Select(candidates, domain.DefaultRequiredReviewers).Return(candidates)
+				repo.EXPECT().CreatePullRequest(mock.Anything).Return(domain.PullRequest{}, domain.ErrDuplicate)
+			},
+			change: func(s *servpullrequests.PrService) error {
+				_, err := s.NewPullRequest(ctx, domain.PullRequestShort{Id: prID, Name: "Test PR", AuthorId: authorID})
+				if errors.Is(err, domain.ErrDuplicate) {
+					return nil
+				}
+				return err
+			},
+		},
+		{
+			name: "merge is announced",
+			setup: func(repo *mocks.PullRequestsRepository, _ *mocks.ReviewerSelector, pub *mocks.EventPublisher) {
+				repo.EXPECT().GetPullRequestByUUID(mock.Anything, prID).Return(openPR, nil)
+				repo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				repo.EXPECT().MergePullRequest(prID, openPR.Version).Return(mergedPR, nil)
+				pub.EXPECT().Publish(mock.Anything, eventOf(domain.EventPrMerged, func(e domain.Event) bool { return e.PrId == prID })).Once()
+			},
+			change: func(s *servpullrequests.PrService) error {
+				_, err := s.Merge(ctx, prID, domain.AnyVersion)
+				return err
+			},
+		},
+		{
+			name: "repeated merge announces nothing",
+			setup: func(repo *mocks.PullRequestsRepository, _ *mocks.ReviewerSelector, _ *mocks.EventPublisher) {
+				repo.EXPECT().GetPullRequestByUUID(mock.Anything, prID).Return(mergedPR, nil)
+			},
+			change: func(s *servpullrequests.PrService) error {
+				_, err := s.Merge(ctx, prID, domain.AnyVersion)
+				return err
+			},
+		},
+		{
+			name: "ready announces the assigned reviewers after commit",
+			setup: func(repo *mocks.PullRequestsRepository, sel *mocks.ReviewerSelector, pub *mocks.EventPublisher) {
+				tx := mocks.NewStatusTx(t)
+				repo.EXPECT().BeginStatusTx(ctx).Return(tx, nil)
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusDraft, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(domain.PullRequest{Id: prID, AuthorId: authorID, Team: team, Status: domain.PrStatusDraft}, nil).Once()
+				repo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				repo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(candidates, nil)
+				sel.EXPECT().Select(candidates, domain.DefaultRequiredReviewers).Return(candidates)
+				tx.EXPECT().AssignReviewers(ctx, prID, candidates.Members(), mock.Anything).Return(nil)
+				tx.EXPECT().UpdateStatus(ctx, prID, domain.PrStatus(domain.PrStatusOpen)).Return(nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(openPR, nil).Once()
+				commit := tx.EXPECT().Commit().Return(nil).Call
+				pub.EXPECT().Publish(mock.Anything, eventOf(domain.EventReviewerAssigned, reviewers)).Once().NotBefore(commit)
+			},
+			change: func(s *servpullrequests.PrService) error {
+				_, err := s.Ready(ctx, prID, domain.AnyVersion, domain.AssignmentAudit{})
+				return err
+			},
+		},
+		{
+			name: "ready on open pr announces nothing",
+			setup: func(repo *mocks.PullRequestsRepository, _ *mocks.ReviewerSelector, _ *mocks.EventPublisher) {
+				tx := mocks.NewStatusTx(t)
+				repo.EXPECT().BeginStatusTx(ctx).Return(tx, nil)
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusOpen, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(openPR, nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			change: func(s *servpullrequests.PrService) error {
+				_, err := s.Ready(ctx, prID, domain.AnyVersion, domain.AssignmentAudit{})
+				return err
+			},
+		},
+		{
+			name: "reassign is announced after commit",
+			setup: func(repo *mocks.PullRequestsRepository, _ *mocks.ReviewerSelector, pub *mocks.EventPublisher) {
+				tx := mocks.NewReassignTx(t)
+				repo.EXPECT().BeginReasignTx(ctx).Return(tx, nil)
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusOpen, 1, nil)
+				tx.EXPECT().IsMemberAssigned(ctx, prID, domain.MemberId("rev-1")).Return(true, nil)
+				tx.EXPECT().GetPullRequestMembersHistories(ctx, prID, domain.MemberId("rev-1")).Return(candidates[1:], nil)
+				tx.EXPECT().AssignMember(ctx, prID, domain.MemberId("rev-1"), domain.MemberId("rev-2"), mock.Anything).Return(openPR, nil)
+				commit := tx.EXPECT().Commit().Return(nil).Call
+				pub.EXPECT().Publish(mock.Anything, eventOf(domain.EventReviewerReassigned, func(e domain.Event) bool {
+					return e.PrId == prID && e.MemberId == "rev-1" && len(e.Reviewers) == 1 && e.Reviewers[0] == "rev-2"
+				})).Once().NotBefore(commit)
+			},
+			change: func(s *servpullrequests.PrService) error {
+				_, err := s.Reasign(ctx, domain.PrReasignMember{PrId: prID, MemberId: "rev-1"})
+				return err
+			},
+		},
+		{
+			name: "failed commit announces nothing",
+			setup: func(repo *mocks.PullRequestsRepository, _ *mocks.ReviewerSelector, _ *mocks.EventPublisher) {
+				tx := mocks.NewReassignTx(t)
+				repo.EXPECT().BeginReasignTx(ctx).Return(tx, nil)
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusOpen, 1, nil)
+				tx.EXPECT().IsMemberAssigned(ctx, prID, domain.MemberId("rev-1")).Return(true, nil)
+				tx.EXPECT().GetPullRequestMembersHistories(ctx, prID, domain.MemberId("rev-1")).Return(candidates[1:], nil)
+				tx.EXPECT().AssignMember(ctx, prID, domain.MemberId("rev-1"), domain.MemberId("rev-2"), mock.Anything).Return(openPR, nil)
+				tx.EXPECT().Commit().Return(errors.New("connection reset"))
+			},
+			change: func(s *servpullrequests.PrService) error {
+				_, err := s.Reasign(ctx, domain.PrReasignMember{PrId: prID, MemberId: "rev-1"})
+				if errors.Is(err, domain.ErrInternal) {
+					return nil
+				}
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewPullRequestsRepository(t)
+			sel := mocks.NewReviewerSelector(t)
+			memServ := mocks.NewMemberService(t)
+			pub := mocks.NewEventPublisher(t)
+			memServ.EXPECT().ReasignMember(mock.Anything, mock.Anything, mock.Anything).Return("rev-2", nil).Maybe()
+
+			tt.setup(repo, sel, pub)
+
+			service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, repo, memServ, sel, pub)
+			assert.NoError(t, tt.change(service))
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package servpullrequests
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/go-faster/errors"
+)
+
+type StatusTx interface {
+	LockPullRequest(context.Context, domain.PrId) (domain.PrStatus, int, error)
+	GetPullRequest(context.Context, domain.PrId) (domain.PullRequest, error)
+	UpdateStatus(context.Context, domain.PrId, domain.PrStatus) error
+	AssignReviewers(context.Context, domain.PrId, domain.Members, domain.AssignmentAudit) error
+	ReleaseReviewers(context.Context, domain.PrId, domain.AssignmentAudit) error
+	Commit() error
+	Rollback() error
+}
+
+// Ready publishes a draft and assigns its reviewers.
+func (ps *PrService) Ready(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	return ps.transition(ctx, id, version, domain.PrTransitionReady, audit.WithDefaults(domain.ActorAnonymous, domain.AssignmentReasonPrReady))
+}
+
+// Close abandons a draft or an open PR and releases its reviewers.
+func (ps *PrService) Close(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	return ps.transition(ctx, id, version, domain.PrTransitionClose, audit.WithDefaults(domain.ActorAnonymous, domain.AssignmentReasonPrClosed))
+}
+
+// Reopen opens a closed PR again with freshly selected reviewers.
+func (ps *PrService) Reopen(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	return ps.transition(ctx, id, version, domain.PrTransitionReopen, audit.WithDefaults(domain.ActorAnonymous, domain.AssignmentReasonPrReopened))
+}
+
+// transition is idempotent: a PR already in the target status is returned as is.
+// A PR changed since the expected version is not moved, domain.AnyVersion skips the check.
+// Reviewers assigned on opening are announced once the transaction is committed.
+func (ps *PrService) transition(
+	ctx context.Context,
+	id domain.PrId,
+	version int,
+	t domain.PrTransition,
+	audit domain.AssignmentAudit,
+) (domain.PullRequest, error) {
+	pr, moved, err := ps.applyTransition(ctx, id, version, t, audit)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	if moved && t.To == domain.PrStatusOpen {
+		ps.publish(ctx, domain.NewReviewersAssignedEvents(pr)...)
+	}
+
+	return pr, nil
+}
+
+// applyTransition moves the PR in a transaction, moved is false when it already was in the target status.
+func (ps *PrService) applyTransition(
+	ctx context.Context,
+	id domain.PrId,
+	version int,
+	t domain.PrTransition,
+	audit domain.AssignmentAudit,
+) (_ domain.PullRequest, moved bool, err error) {
+	tx, err := ps.repo.BeginStatusTx(ctx)
+	if err != nil {
+		return domain.PullRequest{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	defer func() {
+		if err == nil {
+			if errCommit := tx.Commit(); errCommit != nil {
+				err = fmt.Errorf("%w: %w", domain.ErrInternal, errCommit)
+			}
+			return
+		}
+		if errRollback := tx.Rollback(); errRollback != nil {
+			err = fmt.Errorf("%w: %w", err, errRollback)
+		}
+	}()
+
+	status, _, err := tx.LockPullRequest(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.PullRequest{}, false, domain.ErrNotFound
+		}
+		return domain.PullRequest{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	pr, err := tx.GetPullRequest(ctx, id)
+	if err != nil {
+		return domain.PullRequest{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	if err := domain.CheckPrVersion(version, pr.Version); err != nil {
+		return domain.PullRequest{}, false, err
+	}
+	if status == t.To {
+		return pr, false, nil
+	}
+	if err := t.Validate(status); err != nil {
+		return domain.PullRequest{}, false, err
+	}
+
+	var candidates domain.MembersHistories
+	switch t.To {
+	case domain.PrStatusOpen:
+		var reviewers domain.Members
+		candidates, reviewers, err = ps.pickReviewers(ctx, pr.Team, pr.AuthorId)
+		if err != nil {
+			return domain.PullRequest{}, false, err
+		}
+		if err := tx.AssignReviewers(ctx, id, reviewers, audit); err != nil {
+			return domain.PullRequest{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+	case domain.PrStatusClosed:
+		if err := tx.ReleaseReviewers(ctx, id, audit); err != nil {
+			return domain.PullRequest{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+	}
+
+	if err := tx.UpdateStatus(ctx, id, t.To); err != nil {
+		return domain.PullRequest{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	pr, err = tx.GetPullRequest(ctx, id)
+	if err != nil {
+		return domain.PullRequest{}, false, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	pr.Candidates = candidates
+
+	return pr, true, nil
+}
@@ -0,0 +1,224 @@
+package servpullrequests_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servpullrequests "github.com/eragon-mdi/pr-reviewer-service/internal/service/pull-requests"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/pull-requests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPrService_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	prID := domain.PrId("pr-123")
+	authorID := domain.MemberId("author")
+	team := domain.TeamName("backend")
+	candidates := domain.MembersHistories{
+		domain.NewMemberHistory("rev-1", domain.MemberStatusActive, domain.MemberRoleDefault, false),
+		domain.NewMemberHistory("rev-2", domain.MemberStatusActive, domain.MemberRoleDefault, false),
+	}
+	pr := func(status domain.PrStatus, reviewers ...domain.MemberId) domain.PullRequest {
+		members := make(domain.Members, 0, len(reviewers))
+		for _, id := range reviewers {
+			members = append(members, domain.Member{Id: id, Status: domain.MemberStatusActive})
+		}
+		return domain.PullRequest{Id: prID, AuthorId: authorID, Team: team, Status: status, AssignedReviews: members, Version: 1}
+	}
+	type change func(*servpullrequests.PrService) (domain.PullRequest, error)
+	ready := func(audit domain.AssignmentAudit) change {
+		return func(s *servpullrequests.PrService) (domain.PullRequest, error) {
+			return s.Ready(ctx, prID, domain.AnyVersion, audit)
+		}
+	}
+	closePR := func(audit domain.AssignmentAudit) change {
+		return func(s *servpullrequests.PrService) (domain.PullRequest, error) {
+			return s.Close(ctx, prID, domain.AnyVersion, audit)
+		}
+	}
+	reopen := func(audit domain.AssignmentAudit) change {
+		return func(s *servpullrequests.PrService) (domain.PullRequest, error) {
+			return s.Reopen(ctx, prID, domain.AnyVersion, audit)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		change        change
+		txSetup       func(*mocks.PullRequestsRepository, *mocks.StatusTx)
+		selectorSetup func(*mocks.ReviewerSelector)
+		wantStatus    domain.PrStatus
+		wantErr       error
+	}{
+		{
+			name:   "ready assigns reviewers",
+			change: ready(domain.AssignmentAudit{}),
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusDraft, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusDraft), nil).Once()
+				repo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				repo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(candidates, nil)
+				tx.EXPECT().AssignReviewers(ctx, prID, candidates.Members(),
+					domain.AssignmentAudit{Actor: domain.ActorAnonymous, Reason: domain.AssignmentReasonPrReady}).Return(nil)
+				tx.EXPECT().UpdateStatus(ctx, prID, domain.PrStatus(domain.PrStatusOpen)).Return(nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusOpen, "rev-1", "rev-2"), nil).Once()
+				tx.EXPECT().Commit().Return(nil)
+			},
+			selectorSetup: func(sel *mocks.ReviewerSelector) {
+				sel.EXPECT().Select(candidates, domain.DefaultRequiredReviewers).Return(candidates)
+			},
+			wantStatus: domain.PrStatusOpen,
+		},
+		{
+			name:   "ready on open pr is idempotent",
+			change: ready(domain.AssignmentAudit{}),
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusOpen, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusOpen, "rev-1"), nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantStatus: domain.PrStatusOpen,
+		},
+		{
+			name:   "ready with all candidates at capacity",
+			change: ready(domain.AssignmentAudit{}),
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusDraft, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusDraft), nil)
+				repo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				repo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(domain.MembersHistories{
+					candidates[0].WithLoad(1).WithCapacity(domain.NewReviewCapacity(1)),
+				}, nil)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrCapacityExceeded,
+		},
+		{
+			name:   "closed pr cannot be marked ready",
+			change: ready(domain.AssignmentAudit{}),
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusClosed, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusClosed), nil)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrInvalidPrState,
+		},
+		{
+			name:   "close releases reviewers",
+			change: closePR(domain.AssignmentAudit{Actor: "alice", Reason: "abandoned"}),
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusOpen, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusOpen, "rev-1"), nil).Once()
+				tx.EXPECT().ReleaseReviewers(ctx, prID, domain.AssignmentAudit{Actor: "alice", Reason: "abandoned"}).Return(nil)
+				tx.EXPECT().UpdateStatus(ctx, prID, domain.PrStatus(domain.PrStatusClosed)).Return(nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusClosed), nil).Once()
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantStatus: domain.PrStatusClosed,
+		},
+		{
+			name:   "merged pr cannot be closed",
+			change: closePR(domain.AssignmentAudit{}),
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusMerged, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusMerged), nil)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrInvalidPrState,
+		},
+		{
+			name:   "reopen assigns fresh reviewers",
+			change: reopen(domain.AssignmentAudit{}),
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusClosed, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusClosed), nil).Once()
+				repo.EXPECT().GetTeamSettings(team).Return(domain.TeamSettings{RequiredReviewers: 1}, nil)
+				repo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(candidates, nil)
+				tx.EXPECT().AssignReviewers(ctx, prID, candidates[1:].Members(),
+					domain.AssignmentAudit{Actor: domain.ActorAnonymous, Reason: domain.AssignmentReasonPrReopened}).Return(nil)
+				tx.EXPECT().UpdateStatus(ctx, prID, domain.PrStatus(domain.PrStatusOpen)).Return(nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusOpen, "rev-2"), nil).Once()
+				tx.EXPECT().Commit().Return(nil)
+			},
+			selectorSetup: func(sel *mocks.ReviewerSelector) {
+				sel.EXPECT().Select(candidates, 1).Return(candidates[1:])
+			},
+			wantStatus: domain.PrStatusOpen,
+		},
+		{
+			name:   "pr not found",
+			change: reopen(domain.AssignmentAudit{}),
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatus(0), 0, domain.ErrNotFound)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "stale version is not changed",
+			change: func(s *servpullrequests.PrService) (domain.PullRequest, error) {
+				return s.Close(ctx, prID, 2, domain.AssignmentAudit{})
+			},
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusOpen, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusOpen, "rev-1"), nil)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrVersionMismatch,
+		},
+		{
+			name: "current version is changed",
+			change: func(s *servpullrequests.PrService) (domain.PullRequest, error) {
+				return s.Close(ctx, prID, 1, domain.AssignmentAudit{})
+			},
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusOpen, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusOpen, "rev-1"), nil).Once()
+				tx.EXPECT().ReleaseReviewers(ctx, prID, mock.Anything).Return(nil)
+				tx.EXPECT().UpdateStatus(ctx, prID, domain.PrStatus(domain.PrStatusClosed)).Return(nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusClosed), nil).Once()
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantStatus: domain.PrStatusClosed,
+		},
+		{
+			name:   "status update error",
+			change: closePR(domain.AssignmentAudit{}),
+			txSetup: func(repo *mocks.PullRequestsRepository, tx *mocks.StatusTx) {
+				tx.EXPECT().LockPullRequest(ctx, prID).Return(domain.PrStatusDraft, 1, nil)
+				tx.EXPECT().GetPullRequest(ctx, prID).Return(pr(domain.PrStatusDraft), nil)
+				tx.EXPECT().ReleaseReviewers(ctx, prID, mock.Anything).Return(nil)
+				tx.EXPECT().UpdateStatus(ctx, prID, domain.PrStatus(domain.PrStatusClosed)).Return(errors.New("database error"))
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewPullRequestsRepository(t)
+			mockTx := mocks.NewStatusTx(t)
+			mockSelector := mocks.NewReviewerSelector(t)
+			mockRepo.EXPECT().BeginStatusTx(ctx).Return(mockTx, nil)
+			tt.txSetup(mockRepo, mockTx)
+			if tt.selectorSetup != nil {
+				tt.selectorSetup(mockSelector)
+			}
+
+			service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mockRepo, mocks.NewMemberService(t), mockSelector, nil)
+			got, err := tt.change(service)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, got.Status)
+		})
+	}
+}
@@ -82,6 +82,64 @@ func (_c *PullRequestsRepository_BeginReasignTx_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// BeginStatusTx provides a mock function with given fields: _a0
+func (_m *PullRequestsRepository) BeginStatusTx(_a0 context.Context) (servpullrequests.StatusTx, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginStatusTx")
+	}
+
+	var r0 servpullrequests.StatusTx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (servpullrequests.StatusTx, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) servpullrequests.StatusTx); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(servpullrequests.StatusTx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestsRepository_BeginStatusTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginStatusTx'
+type PullRequestsRepository_BeginStatusTx_Call struct {
+	*mock.Call
+}
+
+// BeginStatusTx is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *PullRequestsRepository_Expecter) BeginStatusTx(_a0 interface{}) *PullRequestsRepository_BeginStatusTx_Call {
+	return &PullRequestsRepository_BeginStatusTx_Call{Call: _e.mock.On("BeginStatusTx", _a0)}
+}
+
+func (_c *PullRequestsRepository_BeginStatusTx_Call) Run(run func(_a0 context.Context)) *PullRequestsRepository_BeginStatusTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PullRequestsRepository_BeginStatusTx_Call) Return(_a0 servpullrequests.StatusTx, _a1 error) *PullRequestsRepository_BeginStatusTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestsRepository_BeginStatusTx_Call) RunAndReturn(run func(context.Context) (servpullrequests.StatusTx, error)) *PullRequestsRepository_BeginStatusTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePullRequest provides a mock function with given fields: _a0
 func (_m *PullRequestsRepository) CreatePullRequest(_a0 domain.PullRequest) (domain.PullRequest, error) {
 	ret := _m.Called(_a0)
@@ -138,26 +196,316 @@ func (_c *PullRequestsRepository_CreatePullRequest_Call) RunAndReturn(run func(d
 	return _c
 }
 
-// MergePullRequest provides a mock function with given fields: _a0
-func (_m *PullRequestsRepository) MergePullRequest(_a0 domain.PrId) (domain.PullRequest, error) {
-	ret := _m.Called(_a0)
+// GetMemberTeam provides a mock function with given fields: _a0, _a1
+func (_m *PullRequestsRepository) GetMemberTeam(_a0 domain.MemberId, _a1 domain.TeamName) (domain.TeamName, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MergePullRequest")
+		panic("no return value specified for GetMemberTeam")
+	}
+
+	var r0 domain.TeamName
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.TeamName) (domain.TeamName, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.TeamName) domain.TeamName); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.TeamName)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberId, domain.TeamName) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestsRepository_GetMemberTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberTeam'
+type PullRequestsRepository_GetMemberTeam_Call struct {
+	*mock.Call
+}
+
+// GetMemberTeam is a helper method to define mock.On call
+//   - _a0 domain.MemberId
+//   - _a1 domain.TeamName
+func (_e *PullRequestsRepository_Expecter) GetMemberTeam(_a0 interface{}, _a1 interface{}) *PullRequestsRepository_GetMemberTeam_Call {
+	return &PullRequestsRepository_GetMemberTeam_Call{Call: _e.mock.On("GetMemberTeam", _a0, _a1)}
+}
+
+func (_c *PullRequestsRepository_GetMemberTeam_Call) Run(run func(_a0 domain.MemberId, _a1 domain.TeamName)) *PullRequestsRepository_GetMemberTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberId), args[1].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetMemberTeam_Call) Return(_a0 domain.TeamName, _a1 error) *PullRequestsRepository_GetMemberTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetMemberTeam_Call) RunAndReturn(run func(domain.MemberId, domain.TeamName) (domain.TeamName, error)) *PullRequestsRepository_GetMemberTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPullRequestByUUID provides a mock function with given fields: _a0, _a1
+func (_m *PullRequestsRepository) GetPullRequestByUUID(_a0 context.Context, _a1 domain.PrId) (domain.PullRequest, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullRequestByUUID")
 	}
 
 	var r0 domain.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.PrId) (domain.PullRequest, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) (domain.PullRequest, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) domain.PullRequest); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestsRepository_GetPullRequestByUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPullRequestByUUID'
+type PullRequestsRepository_GetPullRequestByUUID_Call struct {
+	*mock.Call
+}
+
+// GetPullRequestByUUID is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.PrId
+func (_e *PullRequestsRepository_Expecter) GetPullRequestByUUID(_a0 interface{}, _a1 interface{}) *PullRequestsRepository_GetPullRequestByUUID_Call {
+	return &PullRequestsRepository_GetPullRequestByUUID_Call{Call: _e.mock.On("GetPullRequestByUUID", _a0, _a1)}
+}
+
+func (_c *PullRequestsRepository_GetPullRequestByUUID_Call) Run(run func(_a0 context.Context, _a1 domain.PrId)) *PullRequestsRepository_GetPullRequestByUUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId))
+	})
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetPullRequestByUUID_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestsRepository_GetPullRequestByUUID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetPullRequestByUUID_Call) RunAndReturn(run func(context.Context, domain.PrId) (domain.PullRequest, error)) *PullRequestsRepository_GetPullRequestByUUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPullRequestCandidates provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PullRequestsRepository) GetPullRequestCandidates(_a0 context.Context, _a1 domain.TeamName, _a2 domain.MemberId) (domain.MembersHistories, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullRequestCandidates")
+	}
+
+	var r0 domain.MembersHistories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, domain.MemberId) (domain.MembersHistories, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, domain.MemberId) domain.MembersHistories); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.MembersHistories)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName, domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestsRepository_GetPullRequestCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPullRequestCandidates'
+type PullRequestsRepository_GetPullRequestCandidates_Call struct {
+	*mock.Call
+}
+
+// GetPullRequestCandidates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+//   - _a2 domain.MemberId
+func (_e *PullRequestsRepository_Expecter) GetPullRequestCandidates(_a0 interface{}, _a1 interface{}, _a2 interface{}) *PullRequestsRepository_GetPullRequestCandidates_Call {
+	return &PullRequestsRepository_GetPullRequestCandidates_Call{Call: _e.mock.On("GetPullRequestCandidates", _a0, _a1, _a2)}
+}
+
+func (_c *PullRequestsRepository_GetPullRequestCandidates_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName, _a2 domain.MemberId)) *PullRequestsRepository_GetPullRequestCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].(domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetPullRequestCandidates_Call) Return(_a0 domain.MembersHistories, _a1 error) *PullRequestsRepository_GetPullRequestCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetPullRequestCandidates_Call) RunAndReturn(run func(context.Context, domain.TeamName, domain.MemberId) (domain.MembersHistories, error)) *PullRequestsRepository_GetPullRequestCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPullRequestHistory provides a mock function with given fields: _a0, _a1
+func (_m *PullRequestsRepository) GetPullRequestHistory(_a0 context.Context, _a1 domain.PrId) (domain.AssignmentEvents, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullRequestHistory")
+	}
+
+	var r0 domain.AssignmentEvents
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) (domain.AssignmentEvents, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) domain.AssignmentEvents); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.AssignmentEvents)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestsRepository_GetPullRequestHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPullRequestHistory'
+type PullRequestsRepository_GetPullRequestHistory_Call struct {
+	*mock.Call
+}
+
+// GetPullRequestHistory is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.PrId
+func (_e *PullRequestsRepository_Expecter) GetPullRequestHistory(_a0 interface{}, _a1 interface{}) *PullRequestsRepository_GetPullRequestHistory_Call {
+	return &PullRequestsRepository_GetPullRequestHistory_Call{Call: _e.mock.On("GetPullRequestHistory", _a0, _a1)}
+}
+
+func (_c *PullRequestsRepository_GetPullRequestHistory_Call) Run(run func(_a0 context.Context, _a1 domain.PrId)) *PullRequestsRepository_GetPullRequestHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId))
+	})
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetPullRequestHistory_Call) Return(_a0 domain.AssignmentEvents, _a1 error) *PullRequestsRepository_GetPullRequestHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetPullRequestHistory_Call) RunAndReturn(run func(context.Context, domain.PrId) (domain.AssignmentEvents, error)) *PullRequestsRepository_GetPullRequestHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPullRequestIdByExternalKey provides a mock function with given fields: _a0, _a1
+func (_m *PullRequestsRepository) GetPullRequestIdByExternalKey(_a0 context.Context, _a1 domain.ExternalPrKey) (domain.PrId, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullRequestIdByExternalKey")
+	}
+
+	var r0 domain.PrId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ExternalPrKey) (domain.PrId, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ExternalPrKey) domain.PrId); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.PrId)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ExternalPrKey) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestsRepository_GetPullRequestIdByExternalKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPullRequestIdByExternalKey'
+type PullRequestsRepository_GetPullRequestIdByExternalKey_Call struct {
+	*mock.Call
+}
+
+// GetPullRequestIdByExternalKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.ExternalPrKey
+func (_e *PullRequestsRepository_Expecter) GetPullRequestIdByExternalKey(_a0 interface{}, _a1 interface{}) *PullRequestsRepository_GetPullRequestIdByExternalKey_Call {
+	return &PullRequestsRepository_GetPullRequestIdByExternalKey_Call{Call: _e.mock.On("GetPullRequestIdByExternalKey", _a0, _a1)}
+}
+
+func (_c *PullRequestsRepository_GetPullRequestIdByExternalKey_Call) Run(run func(_a0 context.Context, _a1 domain.ExternalPrKey)) *PullRequestsRepository_GetPullRequestIdByExternalKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ExternalPrKey))
+	})
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetPullRequestIdByExternalKey_Call) Return(_a0 domain.PrId, _a1 error) *PullRequestsRepository_GetPullRequestIdByExternalKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetPullRequestIdByExternalKey_Call) RunAndReturn(run func(context.Context, domain.ExternalPrKey) (domain.PrId, error)) *PullRequestsRepository_GetPullRequestIdByExternalKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamSettings provides a mock function with given fields: _a0
+func (_m *PullRequestsRepository) GetTeamSettings(_a0 domain.TeamName) (domain.TeamSettings, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamSettings")
+	}
+
+	var r0 domain.TeamSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName) (domain.TeamSettings, error)); ok {
 		return rf(_a0)
 	}
-	if rf, ok := ret.Get(0).(func(domain.PrId) domain.PullRequest); ok {
+	if rf, ok := ret.Get(0).(func(domain.TeamName) domain.TeamSettings); ok {
 		r0 = rf(_a0)
 	} else {
-		r0 = ret.Get(0).(domain.PullRequest)
+		r0 = ret.Get(0).(domain.TeamSettings)
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.PrId) error); ok {
+	if rf, ok := ret.Get(1).(func(domain.TeamName) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -166,6 +514,122 @@ func (_m *PullRequestsRepository) MergePullRequest(_a0 domain.PrId) (domain.Pull
 	return r0, r1
 }
 
+// PullRequestsRepository_GetTeamSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamSettings'
+type PullRequestsRepository_GetTeamSettings_Call struct {
+	*mock.Call
+}
+
+// GetTeamSettings is a helper method to define mock.On call
+//   - _a0 domain.TeamName
+func (_e *PullRequestsRepository_Expecter) GetTeamSettings(_a0 interface{}) *PullRequestsRepository_GetTeamSettings_Call {
+	return &PullRequestsRepository_GetTeamSettings_Call{Call: _e.mock.On("GetTeamSettings", _a0)}
+}
+
+func (_c *PullRequestsRepository_GetTeamSettings_Call) Run(run func(_a0 domain.TeamName)) *PullRequestsRepository_GetTeamSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetTeamSettings_Call) Return(_a0 domain.TeamSettings, _a1 error) *PullRequestsRepository_GetTeamSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestsRepository_GetTeamSettings_Call) RunAndReturn(run func(domain.TeamName) (domain.TeamSettings, error)) *PullRequestsRepository_GetTeamSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPullRequests provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PullRequestsRepository) ListPullRequests(_a0 context.Context, _a1 domain.PrFilter, _a2 domain.PageRequest) ([]domain.PullRequest, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPullRequests")
+	}
+
+	var r0 []domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrFilter, domain.PageRequest) ([]domain.PullRequest, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrFilter, domain.PageRequest) []domain.PullRequest); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrFilter, domain.PageRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestsRepository_ListPullRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPullRequests'
+type PullRequestsRepository_ListPullRequests_Call struct {
+	*mock.Call
+}
+
+// ListPullRequests is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.PrFilter
+//   - _a2 domain.PageRequest
+func (_e *PullRequestsRepository_Expecter) ListPullRequests(_a0 interface{}, _a1 interface{}, _a2 interface{}) *PullRequestsRepository_ListPullRequests_Call {
+	return &PullRequestsRepository_ListPullRequests_Call{Call: _e.mock.On("ListPullRequests", _a0, _a1, _a2)}
+}
+
+func (_c *PullRequestsRepository_ListPullRequests_Call) Run(run func(_a0 context.Context, _a1 domain.PrFilter, _a2 domain.PageRequest)) *PullRequestsRepository_ListPullRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrFilter), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *PullRequestsRepository_ListPullRequests_Call) Return(_a0 []domain.PullRequest, _a1 error) *PullRequestsRepository_ListPullRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestsRepository_ListPullRequests_Call) RunAndReturn(run func(context.Context, domain.PrFilter, domain.PageRequest) ([]domain.PullRequest, error)) *PullRequestsRepository_ListPullRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergePullRequest provides a mock function with given fields: _a0, _a1
+func (_m *PullRequestsRepository) MergePullRequest(_a0 domain.PrId, _a1 int) (domain.PullRequest, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergePullRequest")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.PrId, int) (domain.PullRequest, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.PrId, int) domain.PullRequest); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.PrId, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PullRequestsRepository_MergePullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergePullRequest'
 type PullRequestsRepository_MergePullRequest_Call struct {
 	*mock.Call
@@ -173,13 +637,14 @@ type PullRequestsRepository_MergePullRequest_Call struct {
 
 // MergePullRequest is a helper method to define mock.On call
 //   - _a0 domain.PrId
-func (_e *PullRequestsRepository_Expecter) MergePullRequest(_a0 interface{}) *PullRequestsRepository_MergePullRequest_Call {
-	return &PullRequestsRepository_MergePullRequest_Call{Call: _e.mock.On("MergePullRequest", _a0)}
+//   - _a1 int
+func (_e *PullRequestsRepository_Expecter) MergePullRequest(_a0 interface{}, _a1 interface{}) *PullRequestsRepository_MergePullRequest_Call {
+	return &PullRequestsRepository_MergePullRequest_Call{Call: _e.mock.On("MergePullRequest", _a0, _a1)}
 }
 
-func (_c *PullRequestsRepository_MergePullRequest_Call) Run(run func(_a0 domain.PrId)) *PullRequestsRepository_MergePullRequest_Call {
+func (_c *PullRequestsRepository_MergePullRequest_Call) Run(run func(_a0 domain.PrId, _a1 int)) *PullRequestsRepository_MergePullRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.PrId))
+		run(args[0].(domain.PrId), args[1].(int))
 	})
 	return _c
 }
@@ -189,7 +654,64 @@ func (_c *PullRequestsRepository_MergePullRequest_Call) Return(_a0 domain.PullRe
 	return _c
 }
 
-func (_c *PullRequestsRepository_MergePullRequest_Call) RunAndReturn(run func(domain.PrId) (domain.PullRequest, error)) *PullRequestsRepository_MergePullRequest_Call {
+func (_c *PullRequestsRepository_MergePullRequest_Call) RunAndReturn(run func(domain.PrId, int) (domain.PullRequest, error)) *PullRequestsRepository_MergePullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitReview provides a mock function with given fields: _a0, _a1
+func (_m *PullRequestsRepository) SubmitReview(_a0 domain.Review, _a1 int) (domain.PullRequest, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitReview")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.Review, int) (domain.PullRequest, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.Review, int) domain.PullRequest); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.Review, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestsRepository_SubmitReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitReview'
+type PullRequestsRepository_SubmitReview_Call struct {
+	*mock.Call
+}
+
+// SubmitReview is a helper method to define mock.On call
+//   - _a0 domain.Review
+//   - _a1 int
+func (_e *PullRequestsRepository_Expecter) SubmitReview(_a0 interface{}, _a1 interface{}) *PullRequestsRepository_SubmitReview_Call {
+	return &PullRequestsRepository_SubmitReview_Call{Call: _e.mock.On("SubmitReview", _a0, _a1)}
+}
+
+func (_c *PullRequestsRepository_SubmitReview_Call) Run(run func(_a0 domain.Review, _a1 int)) *PullRequestsRepository_SubmitReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.Review), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PullRequestsRepository_SubmitReview_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestsRepository_SubmitReview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestsRepository_SubmitReview_Call) RunAndReturn(run func(domain.Review, int) (domain.PullRequest, error)) *PullRequestsRepository_SubmitReview_Call {
 	_c.Call.Return(run)
 	return _c
 }
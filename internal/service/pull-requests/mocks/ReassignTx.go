@@ -22,9 +22,9 @@ func (_m *ReassignTx) EXPECT() *ReassignTx_Expecter {
 	return &ReassignTx_Expecter{mock: &_m.Mock}
 }
 
-// AssignMember provides a mock function with given fields: _a0, _a1, _a2
-func (_m *ReassignTx) AssignMember(_a0 context.Context, _a1 domain.PrId, _a2 domain.MemberId) (domain.PullRequest, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// AssignMember provides a mock function with given fields: ctx, prId, oldMemberId, newMemberId, audit
+func (_m *ReassignTx) AssignMember(ctx context.Context, prId domain.PrId, oldMemberId domain.MemberId, newMemberId domain.MemberId, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, prId, oldMemberId, newMemberId, audit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AssignMember")
@@ -32,17 +32,17 @@ func (_m *ReassignTx) AssignMember(_a0 context.Context, _a1 domain.PrId, _a2 dom
 
 	var r0 domain.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.MemberId) (domain.PullRequest, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.MemberId, domain.MemberId, domain.AssignmentAudit) (domain.PullRequest, error)); ok {
+		return rf(ctx, prId, oldMemberId, newMemberId, audit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.MemberId) domain.PullRequest); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.MemberId, domain.MemberId, domain.AssignmentAudit) domain.PullRequest); ok {
+		r0 = rf(ctx, prId, oldMemberId, newMemberId, audit)
 	} else {
 		r0 = ret.Get(0).(domain.PullRequest)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, domain.MemberId) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, domain.MemberId, domain.MemberId, domain.AssignmentAudit) error); ok {
+		r1 = rf(ctx, prId, oldMemberId, newMemberId, audit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,16 +56,18 @@ type ReassignTx_AssignMember_Call struct {
 }
 
 // AssignMember is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 domain.PrId
-//   - _a2 domain.MemberId
-func (_e *ReassignTx_Expecter) AssignMember(_a0 interface{}, _a1 interface{}, _a2 interface{}) *ReassignTx_AssignMember_Call {
-	return &ReassignTx_AssignMember_Call{Call: _e.mock.On("AssignMember", _a0, _a1, _a2)}
+//   - ctx context.Context
+//   - prId domain.PrId
+//   - oldMemberId domain.MemberId
+//   - newMemberId domain.MemberId
+//   - audit domain.AssignmentAudit
+func (_e *ReassignTx_Expecter) AssignMember(ctx interface{}, prId interface{}, oldMemberId interface{}, newMemberId interface{}, audit interface{}) *ReassignTx_AssignMember_Call {
+	return &ReassignTx_AssignMember_Call{Call: _e.mock.On("AssignMember", ctx, prId, oldMemberId, newMemberId, audit)}
 }
 
-func (_c *ReassignTx_AssignMember_Call) Run(run func(_a0 context.Context, _a1 domain.PrId, _a2 domain.MemberId)) *ReassignTx_AssignMember_Call {
+func (_c *ReassignTx_AssignMember_Call) Run(run func(ctx context.Context, prId domain.PrId, oldMemberId domain.MemberId, newMemberId domain.MemberId, audit domain.AssignmentAudit)) *ReassignTx_AssignMember_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(domain.MemberId))
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(domain.MemberId), args[3].(domain.MemberId), args[4].(domain.AssignmentAudit))
 	})
 	return _c
 }
@@ -75,7 +77,7 @@ func (_c *ReassignTx_AssignMember_Call) Return(_a0 domain.PullRequest, _a1 error
 	return _c
 }
 
-func (_c *ReassignTx_AssignMember_Call) RunAndReturn(run func(context.Context, domain.PrId, domain.MemberId) (domain.PullRequest, error)) *ReassignTx_AssignMember_Call {
+func (_c *ReassignTx_AssignMember_Call) RunAndReturn(run func(context.Context, domain.PrId, domain.MemberId, domain.MemberId, domain.AssignmentAudit) (domain.PullRequest, error)) *ReassignTx_AssignMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -125,9 +127,9 @@ func (_c *ReassignTx_Commit_Call) RunAndReturn(run func() error) *ReassignTx_Com
 	return _c
 }
 
-// GetPullRequestMembersHistories provides a mock function with given fields: _a0, _a1
-func (_m *ReassignTx) GetPullRequestMembersHistories(_a0 context.Context, _a1 domain.PrId) (domain.MembersHistories, error) {
-	ret := _m.Called(_a0, _a1)
+// GetPullRequestMembersHistories provides a mock function with given fields: ctx, prId, oldMemberId
+func (_m *ReassignTx) GetPullRequestMembersHistories(ctx context.Context, prId domain.PrId, oldMemberId domain.MemberId) (domain.MembersHistories, error) {
+	ret := _m.Called(ctx, prId, oldMemberId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPullRequestMembersHistories")
@@ -135,19 +137,19 @@ func (_m *ReassignTx) GetPullRequestMembersHistories(_a0 context.Context, _a1 do
 
 	var r0 domain.MembersHistories
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) (domain.MembersHistories, error)); ok {
-		return rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.MemberId) (domain.MembersHistories, error)); ok {
+		return rf(ctx, prId, oldMemberId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) domain.MembersHistories); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.MemberId) domain.MembersHistories); ok {
+		r0 = rf(ctx, prId, oldMemberId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(domain.MembersHistories)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, domain.MemberId) error); ok {
+		r1 = rf(ctx, prId, oldMemberId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -161,25 +163,148 @@ type ReassignTx_GetPullRequestMembersHistories_Call struct {
 }
 
 // GetPullRequestMembersHistories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prId domain.PrId
+//   - oldMemberId domain.MemberId
+func (_e *ReassignTx_Expecter) GetPullRequestMembersHistories(ctx interface{}, prId interface{}, oldMemberId interface{}) *ReassignTx_GetPullRequestMembersHistories_Call {
+	return &ReassignTx_GetPullRequestMembersHistories_Call{Call: _e.mock.On("GetPullRequestMembersHistories", ctx, prId, oldMemberId)}
+}
+
+func (_c *ReassignTx_GetPullRequestMembersHistories_Call) Run(run func(ctx context.Context, prId domain.PrId, oldMemberId domain.MemberId)) *ReassignTx_GetPullRequestMembersHistories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *ReassignTx_GetPullRequestMembersHistories_Call) Return(_a0 domain.MembersHistories, _a1 error) *ReassignTx_GetPullRequestMembersHistories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReassignTx_GetPullRequestMembersHistories_Call) RunAndReturn(run func(context.Context, domain.PrId, domain.MemberId) (domain.MembersHistories, error)) *ReassignTx_GetPullRequestMembersHistories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMemberAssigned provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReassignTx) IsMemberAssigned(_a0 context.Context, _a1 domain.PrId, _a2 domain.MemberId) (bool, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMemberAssigned")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.MemberId) (bool, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.MemberId) bool); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReassignTx_IsMemberAssigned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMemberAssigned'
+type ReassignTx_IsMemberAssigned_Call struct {
+	*mock.Call
+}
+
+// IsMemberAssigned is a helper method to define mock.On call
 //   - _a0 context.Context
 //   - _a1 domain.PrId
-func (_e *ReassignTx_Expecter) GetPullRequestMembersHistories(_a0 interface{}, _a1 interface{}) *ReassignTx_GetPullRequestMembersHistories_Call {
-	return &ReassignTx_GetPullRequestMembersHistories_Call{Call: _e.mock.On("GetPullRequestMembersHistories", _a0, _a1)}
+//   - _a2 domain.MemberId
+func (_e *ReassignTx_Expecter) IsMemberAssigned(_a0 interface{}, _a1 interface{}, _a2 interface{}) *ReassignTx_IsMemberAssigned_Call {
+	return &ReassignTx_IsMemberAssigned_Call{Call: _e.mock.On("IsMemberAssigned", _a0, _a1, _a2)}
 }
 
-func (_c *ReassignTx_GetPullRequestMembersHistories_Call) Run(run func(_a0 context.Context, _a1 domain.PrId)) *ReassignTx_GetPullRequestMembersHistories_Call {
+func (_c *ReassignTx_IsMemberAssigned_Call) Run(run func(_a0 context.Context, _a1 domain.PrId, _a2 domain.MemberId)) *ReassignTx_IsMemberAssigned_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.PrId))
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(domain.MemberId))
 	})
 	return _c
 }
 
-func (_c *ReassignTx_GetPullRequestMembersHistories_Call) Return(_a0 domain.MembersHistories, _a1 error) *ReassignTx_GetPullRequestMembersHistories_Call {
+func (_c *ReassignTx_IsMemberAssigned_Call) Return(_a0 bool, _a1 error) *ReassignTx_IsMemberAssigned_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ReassignTx_GetPullRequestMembersHistories_Call) RunAndReturn(run func(context.Context, domain.PrId) (domain.MembersHistories, error)) *ReassignTx_GetPullRequestMembersHistories_Call {
+func (_c *ReassignTx_IsMemberAssigned_Call) RunAndReturn(run func(context.Context, domain.PrId, domain.MemberId) (bool, error)) *ReassignTx_IsMemberAssigned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockPullRequest provides a mock function with given fields: _a0, _a1
+func (_m *ReassignTx) LockPullRequest(_a0 context.Context, _a1 domain.PrId) (domain.PrStatus, int, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPullRequest")
+	}
+
+	var r0 domain.PrStatus
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) (domain.PrStatus, int, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) domain.PrStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.PrStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId) int); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.PrId) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReassignTx_LockPullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockPullRequest'
+type ReassignTx_LockPullRequest_Call struct {
+	*mock.Call
+}
+
+// LockPullRequest is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.PrId
+func (_e *ReassignTx_Expecter) LockPullRequest(_a0 interface{}, _a1 interface{}) *ReassignTx_LockPullRequest_Call {
+	return &ReassignTx_LockPullRequest_Call{Call: _e.mock.On("LockPullRequest", _a0, _a1)}
+}
+
+func (_c *ReassignTx_LockPullRequest_Call) Run(run func(_a0 context.Context, _a1 domain.PrId)) *ReassignTx_LockPullRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId))
+	})
+	return _c
+}
+
+func (_c *ReassignTx_LockPullRequest_Call) Return(_a0 domain.PrStatus, _a1 int, _a2 error) *ReassignTx_LockPullRequest_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ReassignTx_LockPullRequest_Call) RunAndReturn(run func(context.Context, domain.PrId) (domain.PrStatus, int, error)) *ReassignTx_LockPullRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
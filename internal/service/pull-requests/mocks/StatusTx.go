@@ -0,0 +1,393 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatusTx is an autogenerated mock type for the StatusTx type
+type StatusTx struct {
+	mock.Mock
+}
+
+type StatusTx_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatusTx) EXPECT() *StatusTx_Expecter {
+	return &StatusTx_Expecter{mock: &_m.Mock}
+}
+
+// AssignReviewers provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *StatusTx) AssignReviewers(_a0 context.Context, _a1 domain.PrId, _a2 domain.Members, _a3 domain.AssignmentAudit) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.Members, domain.AssignmentAudit) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusTx_AssignReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignReviewers'
+type StatusTx_AssignReviewers_Call struct {
+	*mock.Call
+}
+
+// AssignReviewers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.PrId
+//   - _a2 domain.Members
+//   - _a3 domain.AssignmentAudit
+func (_e *StatusTx_Expecter) AssignReviewers(_a0 interface{}, _a1 interface{}, _a2 interface{}, _a3 interface{}) *StatusTx_AssignReviewers_Call {
+	return &StatusTx_AssignReviewers_Call{Call: _e.mock.On("AssignReviewers", _a0, _a1, _a2, _a3)}
+}
+
+func (_c *StatusTx_AssignReviewers_Call) Run(run func(_a0 context.Context, _a1 domain.PrId, _a2 domain.Members, _a3 domain.AssignmentAudit)) *StatusTx_AssignReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(domain.Members), args[3].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *StatusTx_AssignReviewers_Call) Return(_a0 error) *StatusTx_AssignReviewers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusTx_AssignReviewers_Call) RunAndReturn(run func(context.Context, domain.PrId, domain.Members, domain.AssignmentAudit) error) *StatusTx_AssignReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Commit provides a mock function with no fields
+func (_m *StatusTx) Commit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusTx_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type StatusTx_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+func (_e *StatusTx_Expecter) Commit() *StatusTx_Commit_Call {
+	return &StatusTx_Commit_Call{Call: _e.mock.On("Commit")}
+}
+
+func (_c *StatusTx_Commit_Call) Run(run func()) *StatusTx_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *StatusTx_Commit_Call) Return(_a0 error) *StatusTx_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusTx_Commit_Call) RunAndReturn(run func() error) *StatusTx_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPullRequest provides a mock function with given fields: _a0, _a1
+func (_m *StatusTx) GetPullRequest(_a0 context.Context, _a1 domain.PrId) (domain.PullRequest, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullRequest")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) (domain.PullRequest, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) domain.PullRequest); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusTx_GetPullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPullRequest'
+type StatusTx_GetPullRequest_Call struct {
+	*mock.Call
+}
+
+// GetPullRequest is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.PrId
+func (_e *StatusTx_Expecter) GetPullRequest(_a0 interface{}, _a1 interface{}) *StatusTx_GetPullRequest_Call {
+	return &StatusTx_GetPullRequest_Call{Call: _e.mock.On("GetPullRequest", _a0, _a1)}
+}
+
+func (_c *StatusTx_GetPullRequest_Call) Run(run func(_a0 context.Context, _a1 domain.PrId)) *StatusTx_GetPullRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId))
+	})
+	return _c
+}
+
+func (_c *StatusTx_GetPullRequest_Call) Return(_a0 domain.PullRequest, _a1 error) *StatusTx_GetPullRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusTx_GetPullRequest_Call) RunAndReturn(run func(context.Context, domain.PrId) (domain.PullRequest, error)) *StatusTx_GetPullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockPullRequest provides a mock function with given fields: _a0, _a1
+func (_m *StatusTx) LockPullRequest(_a0 context.Context, _a1 domain.PrId) (domain.PrStatus, int, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPullRequest")
+	}
+
+	var r0 domain.PrStatus
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) (domain.PrStatus, int, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) domain.PrStatus); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.PrStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId) int); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.PrId) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StatusTx_LockPullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockPullRequest'
+type StatusTx_LockPullRequest_Call struct {
+	*mock.Call
+}
+
+// LockPullRequest is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.PrId
+func (_e *StatusTx_Expecter) LockPullRequest(_a0 interface{}, _a1 interface{}) *StatusTx_LockPullRequest_Call {
+	return &StatusTx_LockPullRequest_Call{Call: _e.mock.On("LockPullRequest", _a0, _a1)}
+}
+
+func (_c *StatusTx_LockPullRequest_Call) Run(run func(_a0 context.Context, _a1 domain.PrId)) *StatusTx_LockPullRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId))
+	})
+	return _c
+}
+
+func (_c *StatusTx_LockPullRequest_Call) Return(_a0 domain.PrStatus, _a1 int, _a2 error) *StatusTx_LockPullRequest_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *StatusTx_LockPullRequest_Call) RunAndReturn(run func(context.Context, domain.PrId) (domain.PrStatus, int, error)) *StatusTx_LockPullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReviewers provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StatusTx) ReleaseReviewers(_a0 context.Context, _a1 domain.PrId, _a2 domain.AssignmentAudit) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.AssignmentAudit) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusTx_ReleaseReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReviewers'
+type StatusTx_ReleaseReviewers_Call struct {
+	*mock.Call
+}
+
+// ReleaseReviewers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.PrId
+//   - _a2 domain.AssignmentAudit
+func (_e *StatusTx_Expecter) ReleaseReviewers(_a0 interface{}, _a1 interface{}, _a2 interface{}) *StatusTx_ReleaseReviewers_Call {
+	return &StatusTx_ReleaseReviewers_Call{Call: _e.mock.On("ReleaseReviewers", _a0, _a1, _a2)}
+}
+
+func (_c *StatusTx_ReleaseReviewers_Call) Run(run func(_a0 context.Context, _a1 domain.PrId, _a2 domain.AssignmentAudit)) *StatusTx_ReleaseReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *StatusTx_ReleaseReviewers_Call) Return(_a0 error) *StatusTx_ReleaseReviewers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusTx_ReleaseReviewers_Call) RunAndReturn(run func(context.Context, domain.PrId, domain.AssignmentAudit) error) *StatusTx_ReleaseReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with no fields
+func (_m *StatusTx) Rollback() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusTx_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type StatusTx_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+func (_e *StatusTx_Expecter) Rollback() *StatusTx_Rollback_Call {
+	return &StatusTx_Rollback_Call{Call: _e.mock.On("Rollback")}
+}
+
+func (_c *StatusTx_Rollback_Call) Run(run func()) *StatusTx_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *StatusTx_Rollback_Call) Return(_a0 error) *StatusTx_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusTx_Rollback_Call) RunAndReturn(run func() error) *StatusTx_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StatusTx) UpdateStatus(_a0 context.Context, _a1 domain.PrId, _a2 domain.PrStatus) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, domain.PrStatus) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusTx_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type StatusTx_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.PrId
+//   - _a2 domain.PrStatus
+func (_e *StatusTx_Expecter) UpdateStatus(_a0 interface{}, _a1 interface{}, _a2 interface{}) *StatusTx_UpdateStatus_Call {
+	return &StatusTx_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", _a0, _a1, _a2)}
+}
+
+func (_c *StatusTx_UpdateStatus_Call) Run(run func(_a0 context.Context, _a1 domain.PrId, _a2 domain.PrStatus)) *StatusTx_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(domain.PrStatus))
+	})
+	return _c
+}
+
+func (_c *StatusTx_UpdateStatus_Call) Return(_a0 error) *StatusTx_UpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusTx_UpdateStatus_Call) RunAndReturn(run func(context.Context, domain.PrId, domain.PrStatus) error) *StatusTx_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatusTx creates a new instance of StatusTx. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatusTx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatusTx {
+	mock := &StatusTx{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -10,13 +10,24 @@ import (
 
 type PullRequestsRepository interface {
 	CreatePullRequest(domain.PullRequest) (domain.PullRequest, error)
-	MergePullRequest(domain.PrId) (domain.PullRequest, error)
+	GetMemberTeam(domain.MemberId, domain.TeamName) (domain.TeamName, error)
+	GetPullRequestCandidates(context.Context, domain.TeamName, domain.MemberId) (domain.MembersHistories, error)
+	GetTeamSettings(domain.TeamName) (domain.TeamSettings, error)
+	GetPullRequestByUUID(context.Context, domain.PrId) (domain.PullRequest, error)
+	MergePullRequest(domain.PrId, int) (domain.PullRequest, error)
+	SubmitReview(domain.Review, int) (domain.PullRequest, error)
 	BeginReasignTx(context.Context) (ReassignTx, error)
+	BeginStatusTx(context.Context) (StatusTx, error)
+	GetPullRequestHistory(context.Context, domain.PrId) (domain.AssignmentEvents, error)
+	ListPullRequests(context.Context, domain.PrFilter, domain.PageRequest) ([]domain.PullRequest, error)
+	GetPullRequestIdByExternalKey(context.Context, domain.ExternalPrKey) (domain.PrId, error)
 }
 
 type ReassignTx interface {
-	GetPullRequestMembersHistories(context.Context, domain.PrId) (domain.MembersHistories, error)
-	AssignMember(context.Context, domain.PrId, domain.MemberId) (domain.PullRequest, error)
+	LockPullRequest(context.Context, domain.PrId) (domain.PrStatus, int, error)
+	IsMemberAssigned(context.Context, domain.PrId, domain.MemberId) (bool, error)
+	GetPullRequestMembersHistories(ctx context.Context, prId domain.PrId, oldMemberId domain.MemberId) (domain.MembersHistories, error)
+	AssignMember(ctx context.Context, prId domain.PrId, oldMemberId, newMemberId domain.MemberId, audit domain.AssignmentAudit) (domain.PullRequest, error)
 	Commit() error
 	Rollback() error
 }
@@ -25,7 +36,27 @@ type MemberService interface {
 	ReasignMember(context.Context, domain.MemberId, domain.MembersHistories) (domain.MemberId, error)
 }
 
-func (ps *PrService) Reasign(ctx context.Context, prReasMem domain.PrReasignMember) (_ domain.PrWithReasignMember, err error) {
+type ReviewerSelector interface {
+	Select(candidates domain.MembersHistories, n int) domain.MembersHistories
+}
+
+// Reasign replaces the reviewer and announces the replacement once the transaction is committed.
+func (ps *PrService) Reasign(ctx context.Context, prReasMem domain.PrReasignMember) (domain.PrWithReasignMember, error) {
+	res, err := ps.reasign(ctx, prReasMem)
+	if err != nil {
+		return domain.PrWithReasignMember{}, err
+	}
+
+	ps.publish(ctx, domain.NewReviewerReassignedEvent(domain.Reassignment{
+		PrId:        prReasMem.PrId,
+		OldMemberId: prReasMem.MemberId,
+		NewMemberId: res.MemberId,
+	}))
+
+	return res, nil
+}
+
+func (ps *PrService) reasign(ctx context.Context, prReasMem domain.PrReasignMember) (_ domain.PrWithReasignMember, err error) {
 	tx, err := ps.repo.BeginReasignTx(ctx)
 	if err != nil {
 		return domain.PrWithReasignMember{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
@@ -42,13 +73,38 @@ func (ps *PrService) Reasign(ctx context.Context, prReasMem domain.PrReasignMemb
 		}
 	}()
 
-	candidatesHistories, err := tx.GetPullRequestMembersHistories(ctx, prReasMem.PrId)
+	status, version, err := tx.LockPullRequest(ctx, prReasMem.PrId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.PrWithReasignMember{}, domain.ErrNotFound
+		}
+		return domain.PrWithReasignMember{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	if err := domain.CheckPrVersion(prReasMem.Version, version); err != nil {
+		return domain.PrWithReasignMember{}, err
+	}
+	if status == domain.PrStatusMerged {
+		return domain.PrWithReasignMember{}, domain.ErrConflict
+	}
+	if status != domain.PrStatusOpen {
+		return domain.PrWithReasignMember{}, domain.ErrInvalidPrState
+	}
+
+	assigned, err := tx.IsMemberAssigned(ctx, prReasMem.PrId, prReasMem.MemberId)
+	if err != nil {
+		return domain.PrWithReasignMember{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	if !assigned {
+		return domain.PrWithReasignMember{}, domain.ErrForbidden
+	}
+
+	candidatesHistories, err := tx.GetPullRequestMembersHistories(ctx, prReasMem.PrId, prReasMem.MemberId)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return domain.PrWithReasignMember{}, domain.ErrNotFound
 		}
 		if errors.Is(err, domain.ErrNoContent) {
-			return domain.PrWithReasignMember{}, domain.ErrNoContent
+			return domain.PrWithReasignMember{}, fmt.Errorf("%w: %w", domain.ErrForbidden, domain.ErrNoContent)
 		}
 		return domain.PrWithReasignMember{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
 	}
@@ -58,10 +114,15 @@ func (ps *PrService) Reasign(ctx context.Context, prReasMem domain.PrReasignMemb
 		return domain.PrWithReasignMember{}, err
 	}
 
-	pr, err := tx.AssignMember(ctx, prReasMem.PrId, memberIdToAssign)
+	audit := prReasMem.Audit.WithDefaults(domain.ActorAnonymous, domain.AssignmentReasonManual)
+	pr, err := tx.AssignMember(ctx, prReasMem.PrId, prReasMem.MemberId, memberIdToAssign, audit)
 	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			return domain.PrWithReasignMember{}, domain.ErrForbidden
+		}
 		return domain.PrWithReasignMember{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
 	}
+	pr.Candidates = candidatesHistories
 
 	return domain.PrWithReasignMember{
 		PullRequest: pr,
@@ -69,10 +130,31 @@ func (ps *PrService) Reasign(ctx context.Context, prReasMem domain.PrReasignMemb
 	}, nil
 }
 
-func (ps *PrService) NewPullRequest(basePR domain.PullRequestShort) (domain.PullRequest, error) {
+func (ps *PrService) NewPullRequest(ctx context.Context, basePR domain.PullRequestShort) (domain.PullRequest, error) {
+	if !basePR.External.Empty() && (!basePR.External.Valid() || basePR.External.PrId() != basePR.Id) {
+		return domain.PullRequest{}, domain.ErrValidation
+	}
 
 	pr := basePR.Create()
 
+	team, err := ps.repo.GetMemberTeam(pr.AuthorId, pr.Team)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.PullRequest{}, domain.ErrNotFound
+		}
+		return domain.PullRequest{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	pr.Team = team
+
+	// a draft gets its reviewers when it is marked ready
+	var candidates domain.MembersHistories
+	if pr.Status != domain.PrStatusDraft {
+		candidates, pr.AssignedReviews, err = ps.pickReviewers(ctx, team, pr.AuthorId)
+		if err != nil {
+			return domain.PullRequest{}, err
+		}
+	}
+
 	createdPr, err := ps.repo.CreatePullRequest(pr)
 	if err != nil {
 		if errors.Is(err, domain.ErrDuplicate) {
@@ -83,21 +165,199 @@ func (ps *PrService) NewPullRequest(basePR domain.PullRequestShort) (domain.Pull
 		}
 		return domain.PullRequest{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
 	}
+	createdPr.Candidates = candidates
+
+	events := append([]domain.Event{domain.NewPrCreatedEvent(createdPr)}, domain.NewReviewersAssignedEvents(createdPr)...)
+	ps.publish(ctx, events...)
 
 	return createdPr, nil
 }
 
-func (ps *PrService) Merge(id domain.PrId) (domain.PullRequest, error) {
-	merged, err := ps.repo.MergePullRequest(id)
+// pickReviewers selects reviewers for a PR of the team among members with free capacity.
+func (ps *PrService) pickReviewers(ctx context.Context, team domain.TeamName, author domain.MemberId) (domain.MembersHistories, domain.Members, error) {
+	settings, err := ps.repo.GetTeamSettings(team)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	candidates, err := ps.repo.GetPullRequestCandidates(ctx, team, author)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil, domain.ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	free := candidates.WithFreeCapacity()
+	if settings.RequiredReviewers > 0 && free.Empty() && !candidates.Empty() {
+		return nil, nil, domain.ErrCapacityExceeded
+	}
+
+	return candidates, ps.selector.Select(free, settings.RequiredReviewers).Members(), nil
+}
+
+// Merge merges the PR if it is still at the expected version, domain.AnyVersion skips the check.
+// The PR changed after the merge policy was checked is domain.ErrVersionMismatch.
+func (ps *PrService) Merge(ctx context.Context, id domain.PrId, version int) (domain.PullRequest, error) {
+	pr, err := ps.mergeable(ctx, id, version)
+	if err != nil || pr.Status == domain.PrStatusMerged {
+		return pr, err
+	}
+
+	settings, err := ps.repo.GetTeamSettings(pr.Team)
+	if err != nil {
+		return domain.PullRequest{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	if unmet := settings.MergePolicy.Apply(ps.mergePolicy).Unmet(pr); len(unmet) > 0 {
+		return domain.PullRequest{}, &domain.MergeBlockedError{Unmet: unmet}
+	}
+
+	// the policy holds for this snapshot only, a review or reassign committed since must not slip through
+	return ps.merge(ctx, id, pr.Version)
+}
+
+// RecordMerge records a merge already done in the VCS. The merge policy is not checked,
+// the PR is merged there whatever it says, the state machine and the version still are.
+func (ps *PrService) RecordMerge(ctx context.Context, id domain.PrId, version int) (domain.PullRequest, error) {
+	pr, err := ps.mergeable(ctx, id, version)
+	if err != nil || pr.Status == domain.PrStatusMerged {
+		return pr, err
+	}
+
+	return ps.merge(ctx, id, version)
+}
+
+// mergeable loads the PR to merge, an already merged PR is returned as is.
+func (ps *PrService) mergeable(ctx context.Context, id domain.PrId, version int) (domain.PullRequest, error) {
+	pr, err := ps.repo.GetPullRequestByUUID(ctx, id)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return domain.PullRequest{}, domain.ErrNotFound
 		}
+		return domain.PullRequest{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	if err := domain.CheckPrVersion(version, pr.Version); err != nil {
+		return domain.PullRequest{}, err
+	}
+	if pr.Status == domain.PrStatusMerged {
+		return pr, nil
+	}
+	if err := domain.PrTransitionMerge.Validate(pr.Status); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return pr, nil
+}
+
+func (ps *PrService) merge(ctx context.Context, id domain.PrId, version int) (domain.PullRequest, error) {
+	merged, err := ps.repo.MergePullRequest(id, version)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.PullRequest{}, domain.ErrNotFound
+		}
+		if errors.Is(err, domain.ErrVersionMismatch) {
+			return domain.PullRequest{}, domain.ErrVersionMismatch
+		}
 		if errors.Is(err, domain.ErrConflict) {
 			return domain.PullRequest{}, domain.ErrConflict
 		}
+		if errors.Is(err, domain.ErrInvalidPrState) {
+			return domain.PullRequest{}, domain.ErrInvalidPrState
+		}
 		return domain.PullRequest{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
 	}
 
+	ps.publish(ctx, domain.NewPrMergedEvent(merged))
+
 	return merged, nil
 }
+
+func (ps *PrService) SubmitReview(review domain.Review, version int) (domain.PullRequest, error) {
+	if !review.State.IsVerdict() {
+		return domain.PullRequest{}, domain.ErrValidation
+	}
+
+	pr, err := ps.repo.SubmitReview(review, version)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.PullRequest{}, domain.ErrNotFound
+		}
+		if errors.Is(err, domain.ErrVersionMismatch) {
+			return domain.PullRequest{}, domain.ErrVersionMismatch
+		}
+		if errors.Is(err, domain.ErrConflict) {
+			return domain.PullRequest{}, domain.ErrConflict
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return domain.PullRequest{}, domain.ErrForbidden
+		}
+		if errors.Is(err, domain.ErrInvalidPrState) {
+			return domain.PullRequest{}, domain.ErrInvalidPrState
+		}
+		return domain.PullRequest{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return pr, nil
+}
+
+func (ps *PrService) History(ctx context.Context, id domain.PrId) (domain.AssignmentEvents, error) {
+	events, err := ps.repo.GetPullRequestHistory(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return events, nil
+}
+
+func (ps *PrService) Get(ctx context.Context, id domain.PrId) (domain.PullRequest, error) {
+	pr, err := ps.repo.GetPullRequestByUUID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.PullRequest{}, domain.ErrNotFound
+		}
+		return domain.PullRequest{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return pr, nil
+}
+
+// ResolvePullRequest turns a PR reference into the PR id. A UUID is returned as is,
+// whether the PR exists is up to the operation using it; an unknown external key is domain.ErrNotFound.
+func (ps *PrService) ResolvePullRequest(ctx context.Context, ref domain.PrRef) (domain.PrId, error) {
+	if id, ok := ref.PrId(); ok {
+		return id, nil
+	}
+
+	key, ok := ref.ExternalKey()
+	if !ok {
+		return "", domain.ErrValidation
+	}
+
+	id, err := ps.repo.GetPullRequestIdByExternalKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return "", domain.ErrNotFound
+		}
+		return "", fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return id, nil
+}
+
+func (ps *PrService) List(ctx context.Context, filter domain.PrFilter, page domain.PageRequest) (domain.PullRequestsPage, error) {
+	if !filter.Valid() {
+		return domain.PullRequestsPage{}, domain.ErrValidation
+	}
+
+	prs, err := ps.repo.ListPullRequests(ctx, filter, page)
+	if err != nil {
+		return domain.PullRequestsPage{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return domain.NewPullRequestsPage(prs, page.Limit), nil
+}
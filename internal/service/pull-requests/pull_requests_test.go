@@ -6,73 +6,176 @@ import (
 	"testing"
 	"time"
 
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	servpullrequests "github.com/eragon-mdi/pr-reviewer-service/internal/service/pull-requests"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/service/pull-requests/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestPrService_Merge(t *testing.T) {
+	authorID := domain.MemberId(uuid.New().String())
+	openPR := domain.PullRequest{
+		Id:        domain.PrId("pr-123"),
+		Name:      domain.PrName("Test PR"),
+		AuthorId:  authorID,
+		Team:      domain.TeamName("backend"),
+		Status:    domain.PrStatusOpen,
+		CreatedAt: time.Now(),
+		Version:   3,
+		AssignedReviews: domain.Members{
+			{Id: "rev-1", Status: domain.MemberStatusActive},
+			{Id: "rev-2", Status: domain.MemberStatusInactive},
+		},
+		Reviews: domain.Reviews{
+			{MemberId: "rev-1", State: domain.ReviewStateApproved},
+			{MemberId: "rev-2", State: domain.ReviewStatePending},
+		},
+	}
+	mergedPR := openPR
+	mergedPR.Status = domain.PrStatusMerged
+	mergedPR.MergedAt = time.Now()
+	two := 2
+
 	tests := []struct {
 		name      string
 		prId      domain.PrId
+		version   int
+		cfg       *configs.BussinesLogic
 		repoSetup func(*mocks.PullRequestsRepository)
 		want      domain.PullRequest
 		wantErr   error
+		wantUnmet []string
 	}{
 		{
 			name: "successful merge",
-			prId: domain.PrId("pr-123"),
+			prId: openPR.Id,
+			cfg:  &configs.BussinesLogic{MergeMinApprovals: 1, MergeBlockOnChangesRequested: true},
 			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
-				mockRepo.EXPECT().MergePullRequest(
-					domain.PrId("pr-123"),
-				).Return(domain.PullRequest{
-					Id:        domain.PrId("pr-123"),
-					Name:      domain.PrName("Test PR"),
-					AuthorId:  domain.MemberId(uuid.New().String()),
-					Status:    domain.PrStatusMerged,
-					CreatedAt: time.Now(),
-					MergedAt:  time.Now(),
-				}, nil)
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(openPR, nil)
+				mockRepo.EXPECT().GetTeamSettings(openPR.Team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().MergePullRequest(openPR.Id, openPR.Version).Return(mergedPR, nil)
 			},
 			want: domain.PullRequest{
-				Id:     domain.PrId("pr-123"),
+				Id:     openPR.Id,
 				Status: domain.PrStatusMerged,
 			},
-			wantErr: nil,
+		},
+		{
+			name: "already merged is idempotent and skips policy",
+			prId: openPR.Id,
+			cfg:  &configs.BussinesLogic{MergeMinApprovals: 5},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(mergedPR, nil)
+			},
+			want: domain.PullRequest{
+				Id:     openPR.Id,
+				Status: domain.PrStatusMerged,
+			},
+		},
+		{
+			name: "blocked by global policy",
+			prId: openPR.Id,
+			cfg:  &configs.BussinesLogic{MergeMinApprovals: 2, MergeRequireActiveReviewers: true},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(openPR, nil)
+				mockRepo.EXPECT().GetTeamSettings(openPR.Team).Return(domain.DefaultTeamSettings(), nil)
+			},
+			wantErr:   domain.ErrMergeBlocked,
+			wantUnmet: []string{"approvals: 1 of 2 required", "reviewer rev-2 is inactive"},
+		},
+		{
+			name: "blocked by team override",
+			prId: openPR.Id,
+			cfg:  &configs.BussinesLogic{},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				settings := domain.DefaultTeamSettings()
+				settings.MergePolicy.MinApprovals = &two
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(openPR, nil)
+				mockRepo.EXPECT().GetTeamSettings(openPR.Team).Return(settings, nil)
+			},
+			wantErr:   domain.ErrMergeBlocked,
+			wantUnmet: []string{"approvals: 1 of 2 required"},
+		},
+		{
+			name: "draft cannot be merged",
+			prId: openPR.Id,
+			cfg:  &configs.BussinesLogic{},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				draftPR := openPR
+				draftPR.Status = domain.PrStatusDraft
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(draftPR, nil)
+			},
+			wantErr: domain.ErrInvalidPrState,
 		},
 		{
 			name: "pr not found",
 			prId: domain.PrId("pr-999"),
+			cfg:  &configs.BussinesLogic{},
 			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
-				mockRepo.EXPECT().MergePullRequest(
-					domain.PrId("pr-999"),
-				).Return(domain.PullRequest{}, domain.ErrNotFound)
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, domain.PrId("pr-999")).
+					Return(domain.PullRequest{}, domain.ErrNotFound)
 			},
-			want:    domain.PullRequest{},
 			wantErr: domain.ErrNotFound,
 		},
 		{
 			name: "conflict error",
-			prId: domain.PrId("pr-456"),
+			prId: openPR.Id,
+			cfg:  &configs.BussinesLogic{},
 			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
-				mockRepo.EXPECT().MergePullRequest(
-					domain.PrId("pr-456"),
-				).Return(domain.PullRequest{}, domain.ErrConflict)
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(openPR, nil)
+				mockRepo.EXPECT().GetTeamSettings(openPR.Team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().MergePullRequest(openPR.Id, openPR.Version).Return(domain.PullRequest{}, domain.ErrConflict)
 			},
-			want:    domain.PullRequest{},
 			wantErr: domain.ErrConflict,
 		},
+		{
+			name:    "stale version",
+			prId:    openPR.Id,
+			version: 7,
+			cfg:     &configs.BussinesLogic{},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(openPR, nil)
+			},
+			wantErr: domain.ErrVersionMismatch,
+		},
+		{
+			name:    "matching version",
+			prId:    openPR.Id,
+			version: 3,
+			cfg:     &configs.BussinesLogic{},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(openPR, nil)
+				mockRepo.EXPECT().GetTeamSettings(openPR.Team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().MergePullRequest(openPR.Id, openPR.Version).Return(mergedPR, nil)
+			},
+			want: domain.PullRequest{
+				Id:     openPR.Id,
+				Status: domain.PrStatusMerged,
+			},
+		},
+		{
+			name: "changed after the policy check",
+			prId: openPR.Id,
+			cfg:  &configs.BussinesLogic{},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(openPR, nil)
+				mockRepo.EXPECT().GetTeamSettings(openPR.Team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().MergePullRequest(openPR.Id, openPR.Version).Return(domain.PullRequest{}, domain.ErrVersionMismatch)
+			},
+			wantErr: domain.ErrVersionMismatch,
+		},
 		{
 			name: "internal error",
-			prId: domain.PrId("pr-789"),
+			prId: openPR.Id,
+			cfg:  &configs.BussinesLogic{},
 			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
-				mockRepo.EXPECT().MergePullRequest(
-					domain.PrId("pr-789"),
-				).Return(domain.PullRequest{}, errors.New("database error"))
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(openPR, nil)
+				mockRepo.EXPECT().GetTeamSettings(openPR.Team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().MergePullRequest(openPR.Id, openPR.Version).Return(domain.PullRequest{}, errors.New("database error"))
 			},
-			want:    domain.PullRequest{},
 			wantErr: domain.ErrInternal,
 		},
 	}
@@ -83,17 +186,94 @@ func TestPrService_Merge(t *testing.T) {
 			mockMemberService := mocks.NewMemberService(t)
 			tt.repoSetup(mockRepo)
 
-			service := servpullrequests.NewPullRequestService(mockRepo, mockMemberService)
-			got, err := service.Merge(tt.prId)
+			service := servpullrequests.NewPullRequestService(tt.cfg, mockRepo, mockMemberService, mocks.NewReviewerSelector(t), nil)
+			got, err := service.Merge(context.Background(), tt.prId, tt.version)
 
 			if tt.wantErr != nil {
 				assert.Error(t, err)
 				assert.True(t, errors.Is(err, tt.wantErr))
-			} else {
-				assert.NoError(t, err)
-				assert.Equal(t, tt.want.Id, got.Id)
-				assert.Equal(t, tt.want.Status, got.Status)
+
+				var blocked *domain.MergeBlockedError
+				if errors.As(err, &blocked) {
+					assert.Equal(t, tt.wantUnmet, blocked.Unmet)
+				}
+				return
 			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.Id, got.Id)
+			assert.Equal(t, tt.want.Status, got.Status)
+		})
+	}
+}
+
+func TestPrService_RecordMerge(t *testing.T) {
+	openPR := domain.PullRequest{
+		Id:      domain.PrId("pr-123"),
+		Team:    domain.TeamName("backend"),
+		Status:  domain.PrStatusOpen,
+		Version: 3,
+		Reviews: domain.Reviews{{MemberId: "rev-1", State: domain.ReviewStateChangesRequested}},
+	}
+	mergedPR := openPR
+	mergedPR.Status = domain.PrStatusMerged
+	closedPR := openPR
+	closedPR.Status = domain.PrStatusClosed
+
+	// the policy below would block the PR, a VCS merge is recorded regardless
+	cfg := &configs.BussinesLogic{MergeMinApprovals: 2, MergeBlockOnChangesRequested: true}
+
+	tests := []struct {
+		name      string
+		repoSetup func(*mocks.PullRequestsRepository)
+		want      domain.PullRequest
+		wantErr   error
+	}{
+		{
+			name: "merged without the policy",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(openPR, nil)
+				mockRepo.EXPECT().MergePullRequest(openPR.Id, domain.AnyVersion).Return(mergedPR, nil)
+			},
+			want: mergedPR,
+		},
+		{
+			name: "already merged is idempotent",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(mergedPR, nil)
+			},
+			want: mergedPR,
+		},
+		{
+			name: "closed cannot be merged",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(closedPR, nil)
+			},
+			wantErr: domain.ErrInvalidPrState,
+		},
+		{
+			name: "pr not found",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(mock.Anything, openPR.Id).Return(domain.PullRequest{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewPullRequestsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servpullrequests.NewPullRequestService(cfg, mockRepo, mocks.NewMemberService(t), mocks.NewReviewerSelector(t), nil)
+			got, err := service.RecordMerge(context.Background(), openPR.Id, domain.AnyVersion)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -114,6 +294,7 @@ func TestPrService_Reasign(t *testing.T) {
 				return domain.PrReasignMember{
 					PrId:     domain.PrId("pr-123"),
 					MemberId: domain.MemberId(oldMemberID),
+					Audit:    domain.AssignmentAudit{Actor: "alice", Reason: "vacation"},
 				}
 			}(),
 			repoSetup: func(mockRepo *mocks.PullRequestsRepository, mockTx *mocks.ReassignTx, prReasMem domain.PrReasignMember) {
@@ -121,9 +302,14 @@ func TestPrService_Reasign(t *testing.T) {
 				newMemberID := "new-member-123"
 
 				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatusOpen, 1, nil)
+				mockTx.EXPECT().IsMemberAssigned(context.Background(), prReasMem.PrId, prReasMem.MemberId).
+					Return(true, nil)
 				mockTx.EXPECT().GetPullRequestMembersHistories(
 					context.Background(),
 					prReasMem.PrId,
+					prReasMem.MemberId,
 				).Return(domain.MembersHistories{
 					domain.NewMemberHistory(
 						domain.MemberId(candidateID),
@@ -135,7 +321,9 @@ func TestPrService_Reasign(t *testing.T) {
 				mockTx.EXPECT().AssignMember(
 					context.Background(),
 					prReasMem.PrId,
+					prReasMem.MemberId,
 					domain.MemberId(newMemberID),
+					domain.AssignmentAudit{Actor: "alice", Reason: "vacation"},
 				).Return(domain.PullRequest{
 					Id:     prReasMem.PrId,
 					Status: domain.PrStatusOpen,
@@ -175,10 +363,8 @@ func TestPrService_Reasign(t *testing.T) {
 			},
 			repoSetup: func(mockRepo *mocks.PullRequestsRepository, mockTx *mocks.ReassignTx, prReasMem domain.PrReasignMember) {
 				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
-				mockTx.EXPECT().GetPullRequestMembersHistories(
-					context.Background(),
-					prReasMem.PrId,
-				).Return(domain.MembersHistories{}, domain.ErrNotFound)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatus(0), 0, domain.ErrNotFound)
 				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			memberSetup: func(mockMemberService *mocks.MemberService, prReasMem domain.PrReasignMember) {},
@@ -186,16 +372,72 @@ func TestPrService_Reasign(t *testing.T) {
 			wantErr:     domain.ErrNotFound,
 		},
 		{
-			name: "no content",
+			name: "stale version",
 			prReasMem: domain.PrReasignMember{
 				PrId:     domain.PrId("pr-123"),
 				MemberId: domain.MemberId(uuid.New().String()),
+				Version:  2,
 			},
 			repoSetup: func(mockRepo *mocks.PullRequestsRepository, mockTx *mocks.ReassignTx, prReasMem domain.PrReasignMember) {
 				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatusOpen, 1, nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+			},
+			memberSetup: func(mockMemberService *mocks.MemberService, prReasMem domain.PrReasignMember) {},
+			want:        domain.PrWithReasignMember{},
+			wantErr:     domain.ErrVersionMismatch,
+		},
+		{
+			name: "pr merged",
+			prReasMem: domain.PrReasignMember{
+				PrId:     domain.PrId("pr-123"),
+				MemberId: domain.MemberId(uuid.New().String()),
+			},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository, mockTx *mocks.ReassignTx, prReasMem domain.PrReasignMember) {
+				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatusMerged, 1, nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+			},
+			memberSetup: func(mockMemberService *mocks.MemberService, prReasMem domain.PrReasignMember) {},
+			want:        domain.PrWithReasignMember{},
+			wantErr:     domain.ErrConflict,
+		},
+		{
+			name: "reviewer not assigned",
+			prReasMem: domain.PrReasignMember{
+				PrId:     domain.PrId("pr-123"),
+				MemberId: domain.MemberId(uuid.New().String()),
+			},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository, mockTx *mocks.ReassignTx, prReasMem domain.PrReasignMember) {
+				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatusOpen, 1, nil)
+				mockTx.EXPECT().IsMemberAssigned(context.Background(), prReasMem.PrId, prReasMem.MemberId).
+					Return(false, nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+			},
+			memberSetup: func(mockMemberService *mocks.MemberService, prReasMem domain.PrReasignMember) {},
+			want:        domain.PrWithReasignMember{},
+			wantErr:     domain.ErrForbidden,
+		},
+		{
+			name: "no candidates",
+			prReasMem: domain.PrReasignMember{
+				PrId:     domain.PrId("pr-123"),
+				MemberId: domain.MemberId(uuid.New().String()),
+			},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository, mockTx *mocks.ReassignTx, prReasMem domain.PrReasignMember) {
+				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatusOpen, 1, nil)
+				mockTx.EXPECT().IsMemberAssigned(context.Background(), prReasMem.PrId, prReasMem.MemberId).
+					Return(true, nil)
 				mockTx.EXPECT().GetPullRequestMembersHistories(
 					context.Background(),
 					prReasMem.PrId,
+					prReasMem.MemberId,
 				).Return(domain.MembersHistories{}, domain.ErrNoContent)
 				mockTx.EXPECT().Rollback().Return(nil)
 			},
@@ -216,9 +458,14 @@ func TestPrService_Reasign(t *testing.T) {
 				candidateID := "candidate-456"
 
 				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatusOpen, 1, nil)
+				mockTx.EXPECT().IsMemberAssigned(context.Background(), prReasMem.PrId, prReasMem.MemberId).
+					Return(true, nil)
 				mockTx.EXPECT().GetPullRequestMembersHistories(
 					context.Background(),
 					prReasMem.PrId,
+					prReasMem.MemberId,
 				).Return(domain.MembersHistories{
 					domain.NewMemberHistory(
 						domain.MemberId(candidateID),
@@ -248,6 +495,78 @@ func TestPrService_Reasign(t *testing.T) {
 			want:    domain.PrWithReasignMember{},
 			wantErr: domain.ErrForbidden,
 		},
+		{
+			name: "closed pr",
+			prReasMem: domain.PrReasignMember{
+				PrId:     domain.PrId("pr-123"),
+				MemberId: domain.MemberId(uuid.New().String()),
+			},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository, mockTx *mocks.ReassignTx, prReasMem domain.PrReasignMember) {
+				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatusClosed, 1, nil)
+				mockTx.EXPECT().Rollback().Return(nil)
+			},
+			memberSetup: func(mockMemberService *mocks.MemberService, prReasMem domain.PrReasignMember) {},
+			want:        domain.PrWithReasignMember{},
+			wantErr:     domain.ErrInvalidPrState,
+		},
+		{
+			name: "old reviewer already replaced concurrently",
+			prReasMem: domain.PrReasignMember{
+				PrId:     domain.PrId("pr-123"),
+				MemberId: domain.MemberId(uuid.New().String()),
+			},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository, mockTx *mocks.ReassignTx, prReasMem domain.PrReasignMember) {
+				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatusOpen, 1, nil)
+				mockTx.EXPECT().IsMemberAssigned(context.Background(), prReasMem.PrId, prReasMem.MemberId).
+					Return(true, nil)
+				mockTx.EXPECT().GetPullRequestMembersHistories(context.Background(), prReasMem.PrId, prReasMem.MemberId).
+					Return(domain.MembersHistories{
+						domain.NewMemberHistory("candidate-789", domain.MemberStatusActive, domain.MemberRoleDefault, false),
+					}, nil)
+				mockTx.EXPECT().AssignMember(context.Background(), prReasMem.PrId, prReasMem.MemberId, domain.MemberId("candidate-789"),
+					domain.AssignmentAudit{Actor: domain.ActorAnonymous, Reason: domain.AssignmentReasonManual}).
+					Return(domain.PullRequest{}, domain.ErrForbidden)
+				mockTx.EXPECT().Rollback().Return(nil)
+			},
+			memberSetup: func(mockMemberService *mocks.MemberService, prReasMem domain.PrReasignMember) {
+				mockMemberService.EXPECT().ReasignMember(context.Background(), prReasMem.MemberId, mock.Anything).
+					Return(domain.MemberId("candidate-789"), nil)
+			},
+			want:    domain.PrWithReasignMember{},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name: "commit failure",
+			prReasMem: domain.PrReasignMember{
+				PrId:     domain.PrId("pr-123"),
+				MemberId: domain.MemberId(uuid.New().String()),
+			},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository, mockTx *mocks.ReassignTx, prReasMem domain.PrReasignMember) {
+				mockRepo.EXPECT().BeginReasignTx(context.Background()).Return(mockTx, nil)
+				mockTx.EXPECT().LockPullRequest(context.Background(), prReasMem.PrId).
+					Return(domain.PrStatusOpen, 1, nil)
+				mockTx.EXPECT().IsMemberAssigned(context.Background(), prReasMem.PrId, prReasMem.MemberId).
+					Return(true, nil)
+				mockTx.EXPECT().GetPullRequestMembersHistories(context.Background(), prReasMem.PrId, prReasMem.MemberId).
+					Return(domain.MembersHistories{
+						domain.NewMemberHistory("candidate-789", domain.MemberStatusActive, domain.MemberRoleDefault, false),
+					}, nil)
+				mockTx.EXPECT().AssignMember(context.Background(), prReasMem.PrId, prReasMem.MemberId, domain.MemberId("candidate-789"),
+					domain.AssignmentAudit{Actor: domain.ActorAnonymous, Reason: domain.AssignmentReasonManual}).
+					Return(domain.PullRequest{Id: prReasMem.PrId}, nil)
+				mockTx.EXPECT().Commit().Return(errors.New("connection reset"))
+			},
+			memberSetup: func(mockMemberService *mocks.MemberService, prReasMem domain.PrReasignMember) {
+				mockMemberService.EXPECT().ReasignMember(context.Background(), prReasMem.MemberId, mock.Anything).
+					Return(domain.MemberId("candidate-789"), nil)
+			},
+			want:    domain.PrWithReasignMember{},
+			wantErr: domain.ErrInternal,
+		},
 	}
 
 	for _, tt := range tests {
@@ -259,7 +578,7 @@ func TestPrService_Reasign(t *testing.T) {
 			tt.repoSetup(mockRepo, mockTx, tt.prReasMem)
 			tt.memberSetup(mockMemberService, tt.prReasMem)
 
-			service := servpullrequests.NewPullRequestService(mockRepo, mockMemberService)
+			service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mockRepo, mockMemberService, mocks.NewReviewerSelector(t), nil)
 			got, err := service.Reasign(context.Background(), tt.prReasMem)
 
 			if tt.wantErr != nil {
@@ -272,3 +591,599 @@ func TestPrService_Reasign(t *testing.T) {
 		})
 	}
 }
+
+func TestPrService_NewPullRequest(t *testing.T) {
+	authorID := domain.MemberId(uuid.New().String())
+	candidates := domain.MembersHistories{
+		domain.NewMemberHistory(domain.MemberId("rev-1"), domain.MemberStatusActive, domain.MemberRoleDefault, false),
+		domain.NewMemberHistory(domain.MemberId("rev-2"), domain.MemberStatusActive, domain.MemberRoleDefault, false),
+		domain.NewMemberHistory(domain.MemberId("rev-3"), domain.MemberStatusActive, domain.MemberRoleDefault, false),
+	}
+	team := domain.TeamName("backend")
+	basePR := domain.PullRequestShort{
+		Id:       domain.PrId("pr-123"),
+		Name:     domain.PrName("Test PR"),
+		AuthorId: authorID,
+	}
+
+	tests := []struct {
+		name          string
+		team          domain.TeamName
+		draft         bool
+		repoSetup     func(*mocks.PullRequestsRepository)
+		selectorSetup func(*mocks.ReviewerSelector)
+		wantReviewers []domain.MemberId
+		wantErr       error
+	}{
+		{
+			name: "reviewers picked by selector",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(candidates, nil)
+				mockRepo.EXPECT().CreatePullRequest(mock.MatchedBy(func(pr domain.PullRequest) bool {
+					return pr.Id == basePR.Id && len(pr.AssignedReviews) == 2
+				})).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+					return pr, nil
+				})
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {
+				mockSelector.EXPECT().Select(candidates, domain.DefaultRequiredReviewers).Return(candidates[1:])
+			},
+			wantReviewers: []domain.MemberId{"rev-2", "rev-3"},
+		},
+		{
+			name: "no candidates",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(domain.MembersHistories{}, nil)
+				mockRepo.EXPECT().CreatePullRequest(mock.Anything).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+					return pr, nil
+				})
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {
+				mockSelector.EXPECT().Select(domain.MembersHistories{}, domain.DefaultRequiredReviewers).Return(domain.MembersHistories{})
+			},
+			wantReviewers: []domain.MemberId{},
+		},
+		{
+			name: "saturated candidates skipped",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(domain.MembersHistories{
+					candidates[0].WithLoad(2).WithCapacity(domain.NewReviewCapacity(2)),
+					candidates[1].WithLoad(1).WithCapacity(domain.NewReviewCapacity(2)),
+				}, nil)
+				mockRepo.EXPECT().CreatePullRequest(mock.Anything).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+					return pr, nil
+				})
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {
+				free := domain.MembersHistories{candidates[1].WithLoad(1).WithCapacity(domain.NewReviewCapacity(2))}
+				mockSelector.EXPECT().Select(free, domain.DefaultRequiredReviewers).Return(free)
+			},
+			wantReviewers: []domain.MemberId{"rev-2"},
+		},
+		{
+			name: "all candidates at capacity",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(domain.MembersHistories{
+					candidates[0].WithLoad(1).WithCapacity(domain.NewReviewCapacity(1)),
+				}, nil)
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {},
+			wantErr:       domain.ErrCapacityExceeded,
+		},
+		{
+			name: "candidates error",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(nil, errors.New("database error"))
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {},
+			wantErr:       domain.ErrInternal,
+		},
+		{
+			name: "team requires one reviewer",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().GetTeamSettings(team).Return(domain.TeamSettings{RequiredReviewers: 1}, nil)
+				mockRepo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(candidates, nil)
+				mockRepo.EXPECT().CreatePullRequest(mock.Anything).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+					return pr, nil
+				})
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {
+				mockSelector.EXPECT().Select(candidates, 1).Return(candidates[:1])
+			},
+			wantReviewers: []domain.MemberId{"rev-1"},
+		},
+		{
+			name: "team requires no reviewers, saturation ignored",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().GetTeamSettings(team).Return(domain.TeamSettings{RequiredReviewers: 0}, nil)
+				mockRepo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(domain.MembersHistories{
+					candidates[0].WithLoad(1).WithCapacity(domain.NewReviewCapacity(1)),
+				}, nil)
+				mockRepo.EXPECT().CreatePullRequest(mock.Anything).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+					return pr, nil
+				})
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {
+				mockSelector.EXPECT().Select(domain.MembersHistories{}, 0).Return(domain.MembersHistories{})
+			},
+			wantReviewers: []domain.MemberId{},
+		},
+		{
+			name: "team settings error",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().GetTeamSettings(team).Return(domain.TeamSettings{}, errors.New("database error"))
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {},
+			wantErr:       domain.ErrInternal,
+		},
+		{
+			name: "explicit team",
+			team: domain.TeamName("platform"),
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				platform := domain.TeamName("platform")
+				mockRepo.EXPECT().GetMemberTeam(authorID, platform).Return(platform, nil)
+				mockRepo.EXPECT().GetTeamSettings(platform).Return(domain.TeamSettings{RequiredReviewers: 1}, nil)
+				mockRepo.EXPECT().GetPullRequestCandidates(mock.Anything, platform, authorID).Return(candidates, nil)
+				mockRepo.EXPECT().CreatePullRequest(mock.MatchedBy(func(pr domain.PullRequest) bool {
+					return pr.Team == platform
+				})).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+					return pr, nil
+				})
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {
+				mockSelector.EXPECT().Select(candidates, 1).Return(candidates[2:])
+			},
+			wantReviewers: []domain.MemberId{"rev-3"},
+		},
+		{
+			name: "author not in team",
+			team: domain.TeamName("frontend"),
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("frontend")).
+					Return(domain.TeamName(""), domain.ErrNotFound)
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {},
+			wantErr:       domain.ErrNotFound,
+		},
+		{
+			name:  "draft gets no reviewers",
+			draft: true,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().CreatePullRequest(mock.MatchedBy(func(pr domain.PullRequest) bool {
+					return pr.Status == domain.PrStatusDraft
+				})).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+					return pr, nil
+				})
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {},
+			wantReviewers: []domain.MemberId{},
+		},
+		{
+			name: "duplicate pr",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+				mockRepo.EXPECT().GetTeamSettings(team).Return(domain.DefaultTeamSettings(), nil)
+				mockRepo.EXPECT().GetPullRequestCandidates(mock.Anything, team, authorID).Return(candidates, nil)
+				mockRepo.EXPECT().CreatePullRequest(mock.Anything).Return(domain.PullRequest{}, domain.ErrDuplicate)
+			},
+			selectorSetup: func(mockSelector *mocks.ReviewerSelector) {
+				mockSelector.EXPECT().Select(candidates, domain.DefaultRequiredReviewers).Return(candidates[:2])
+			},
+			wantErr: domain.ErrDuplicate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewPullRequestsRepository(t)
+			mockMemberService := mocks.NewMemberService(t)
+			mockSelector := mocks.NewReviewerSelector(t)
+			tt.repoSetup(mockRepo)
+			tt.selectorSetup(mockSelector)
+
+			service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mockRepo, mockMemberService, mockSelector, nil)
+			pr := basePR
+			pr.Team = tt.team
+			pr.Draft = tt.draft
+			got, err := service.NewPullRequest(context.Background(), pr)
+
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			reviewers := make([]domain.MemberId, 0, len(got.AssignedReviews))
+			for _, m := range got.AssignedReviews {
+				reviewers = append(reviewers, m.Id)
+			}
+			assert.Equal(t, tt.wantReviewers, reviewers)
+		})
+	}
+}
+
+func TestPrService_SubmitReview(t *testing.T) {
+	review := domain.Review{
+		PrId:     domain.PrId("pr-123"),
+		MemberId: domain.MemberId("rev-1"),
+		State:    domain.ReviewStateApproved,
+	}
+
+	tests := []struct {
+		name      string
+		review    domain.Review
+		repoSetup func(*mocks.PullRequestsRepository)
+		wantErr   error
+	}{
+		{
+			name:   "successful review",
+			review: review,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().SubmitReview(review, domain.AnyVersion).Return(domain.PullRequest{
+					Id: review.PrId,
+					Reviews: domain.Reviews{
+						{PrId: review.PrId, MemberId: review.MemberId, State: domain.ReviewStateApproved, ReviewedAt: time.Now()},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:      "pending is not a verdict",
+			review:    domain.Review{PrId: review.PrId, MemberId: review.MemberId, State: domain.ReviewStatePending},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:   "pr not found",
+			review: review,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().SubmitReview(review, domain.AnyVersion).Return(domain.PullRequest{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:   "pr merged",
+			review: review,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().SubmitReview(review, domain.AnyVersion).Return(domain.PullRequest{}, domain.ErrConflict)
+			},
+			wantErr: domain.ErrConflict,
+		},
+		{
+			name:   "version mismatch",
+			review: review,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().SubmitReview(review, domain.AnyVersion).Return(domain.PullRequest{}, domain.ErrVersionMismatch)
+			},
+			wantErr: domain.ErrVersionMismatch,
+		},
+		{
+			name:   "reviewer not assigned",
+			review: review,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().SubmitReview(review, domain.AnyVersion).Return(domain.PullRequest{}, domain.ErrForbidden)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:   "internal error",
+			review: review,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().SubmitReview(review, domain.AnyVersion).Return(domain.PullRequest{}, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewPullRequestsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mockRepo, mocks.NewMemberService(t), mocks.NewReviewerSelector(t), nil)
+			got, err := service.SubmitReview(tt.review, domain.AnyVersion)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.review.PrId, got.Id)
+			assert.Empty(t, got.Reviews.Pending())
+		})
+	}
+}
+
+func TestPrService_History(t *testing.T) {
+	prID := domain.PrId("pr-123")
+	events := domain.AssignmentEvents{
+		{
+			Type:            domain.AssignmentEventAssigned,
+			NewMemberId:     "rev-1",
+			AssignmentAudit: domain.SystemAudit(domain.AssignmentReasonPrCreated),
+			CreatedAt:       time.Now(),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		repoSetup func(*mocks.PullRequestsRepository)
+		want      domain.AssignmentEvents
+		wantErr   error
+	}{
+		{
+			name: "success",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestHistory(context.Background(), prID).Return(events, nil)
+			},
+			want: events,
+		},
+		{
+			name: "pr not found",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestHistory(context.Background(), prID).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "internal error",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestHistory(context.Background(), prID).Return(nil, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewPullRequestsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mockRepo, mocks.NewMemberService(t), mocks.NewReviewerSelector(t), nil)
+			got, err := service.History(context.Background(), prID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPrService_Get(t *testing.T) {
+	prID := domain.PrId("pr-123")
+	pr := domain.PullRequest{Id: prID, Name: domain.PrName("Test PR"), Status: domain.PrStatusOpen}
+
+	tests := []struct {
+		name      string
+		repoSetup func(*mocks.PullRequestsRepository)
+		want      domain.PullRequest
+		wantErr   error
+	}{
+		{
+			name: "success",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(context.Background(), prID).Return(pr, nil)
+			},
+			want: pr,
+		},
+		{
+			name: "pr not found",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(context.Background(), prID).Return(domain.PullRequest{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "internal error",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestByUUID(context.Background(), prID).Return(domain.PullRequest{}, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewPullRequestsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mockRepo, mocks.NewMemberService(t), mocks.NewReviewerSelector(t), nil)
+			got, err := service.Get(context.Background(), prID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPrService_NewPullRequest_ExternalKey(t *testing.T) {
+	authorID := domain.MemberId(uuid.New().String())
+	team := domain.TeamName("backend")
+	key := domain.NewExternalPrKey(domain.ProviderGithub, "acme/api", 42)
+
+	t.Run("stored with the key", func(t *testing.T) {
+		mockRepo := mocks.NewPullRequestsRepository(t)
+		mockRepo.EXPECT().GetMemberTeam(authorID, domain.TeamName("")).Return(team, nil)
+		mockRepo.EXPECT().CreatePullRequest(mock.MatchedBy(func(pr domain.PullRequest) bool {
+			return pr.Id == key.PrId() && pr.External == key
+		})).RunAndReturn(func(pr domain.PullRequest) (domain.PullRequest, error) {
+			return pr, nil
+		})
+
+		service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mockRepo, mocks.NewMemberService(t), mocks.NewReviewerSelector(t), nil)
+		got, err := service.NewPullRequest(context.Background(), domain.PullRequestShort{
+			Id: key.PrId(), External: key, Name: "Test PR", AuthorId: authorID, Draft: true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, key, got.External)
+	})
+
+	t.Run("id not derived from the key", func(t *testing.T) {
+		service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mocks.NewPullRequestsRepository(t), mocks.NewMemberService(t), mocks.NewReviewerSelector(t), nil)
+		_, err := service.NewPullRequest(context.Background(), domain.PullRequestShort{
+			Id: domain.PrId(uuid.New().String()), External: key, Name: "Test PR", AuthorId: authorID,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+}
+
+func TestPrService_ResolvePullRequest(t *testing.T) {
+	ctx := context.Background()
+	prID := domain.PrId(uuid.New().String())
+	key := domain.NewExternalPrKey(domain.ProviderGithub, "acme/api", 42)
+
+	tests := []struct {
+		name      string
+		ref       domain.PrRef
+		repoSetup func(*mocks.PullRequestsRepository)
+		want      domain.PrId
+		wantErr   error
+	}{
+		{
+			name:      "uuid is returned as is",
+			ref:       domain.PrRef(prID),
+			repoSetup: func(*mocks.PullRequestsRepository) {},
+			want:      prID,
+		},
+		{
+			name: "external key",
+			ref:  "github:acme/api#42",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestIdByExternalKey(ctx, key).Return(prID, nil)
+			},
+			want: prID,
+		},
+		{
+			name: "unknown external key",
+			ref:  "github:acme/api#42",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestIdByExternalKey(ctx, key).Return("", domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:      "neither uuid nor external key",
+			ref:       "pr-1",
+			repoSetup: func(*mocks.PullRequestsRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name: "internal error",
+			ref:  "github:acme/api#42",
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().GetPullRequestIdByExternalKey(ctx, key).Return("", errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewPullRequestsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mockRepo, mocks.NewMemberService(t), mocks.NewReviewerSelector(t), nil)
+			got, err := service.ResolvePullRequest(ctx, tt.ref)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPrService_List(t *testing.T) {
+	createdAt := time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC)
+	filter := domain.PrFilter{Statuses: []domain.PrStatus{domain.PrStatusOpen}, Team: domain.TeamName("backend")}
+	page := domain.PageRequest{Limit: 1}
+	prs := []domain.PullRequest{
+		{Id: domain.PrId(uuid.New().String()), CreatedAt: createdAt.Add(time.Minute)},
+		{Id: domain.PrId(uuid.New().String()), CreatedAt: createdAt},
+	}
+
+	tests := []struct {
+		name      string
+		filter    domain.PrFilter
+		repoSetup func(*mocks.PullRequestsRepository)
+		wantLen   int
+		wantNext  bool
+		wantErr   error
+	}{
+		{
+			name:   "page with next cursor",
+			filter: filter,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().ListPullRequests(context.Background(), filter, page).Return(prs, nil)
+			},
+			wantLen:  1,
+			wantNext: true,
+		},
+		{
+			name:   "last page",
+			filter: filter,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().ListPullRequests(context.Background(), filter, page).Return(prs[:1], nil)
+			},
+			wantLen: 1,
+		},
+		{
+			name:      "empty created range",
+			filter:    domain.PrFilter{From: createdAt, To: createdAt},
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:   "internal error",
+			filter: filter,
+			repoSetup: func(mockRepo *mocks.PullRequestsRepository) {
+				mockRepo.EXPECT().ListPullRequests(context.Background(), filter, page).Return(nil, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewPullRequestsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servpullrequests.NewPullRequestService(&configs.BussinesLogic{}, mockRepo, mocks.NewMemberService(t), mocks.NewReviewerSelector(t), nil)
+			got, err := service.List(context.Background(), tt.filter, page)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, got.Items, tt.wantLen)
+			assert.Equal(t, tt.wantNext, got.NextCursor != "")
+		})
+	}
+}
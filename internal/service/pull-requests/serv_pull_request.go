@@ -1,17 +1,46 @@
 package servpullrequests
 
+import (
+	"context"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
+
 type PrService struct {
-	repo    Repository
-	memServ MemberService
+	repo        Repository
+	memServ     MemberService
+	selector    ReviewerSelector
+	mergePolicy domain.MergePolicy
+	events      EventPublisher
 }
 
-func NewPullRequestService(r Repository, ms MemberService) *PrService {
+// NewPullRequestService pub announces the committed changes, nil announces nothing.
+func NewPullRequestService(cfg *configs.BussinesLogic, r Repository, ms MemberService, sel ReviewerSelector, pub EventPublisher) *PrService {
 	return &PrService{
-		repo:    r,
-		memServ: ms,
+		repo:     r,
+		memServ:  ms,
+		selector: sel,
+		events:   pub,
+		mergePolicy: domain.MergePolicy{
+			MinApprovals:            cfg.MergeMinApprovals,
+			BlockOnChangesRequested: cfg.MergeBlockOnChangesRequested,
+			RequireActiveReviewers:  cfg.MergeRequireActiveReviewers,
+		},
 	}
 }
 
 type Repository interface {
 	PullRequestsRepository
 }
+
+// EventPublisher announces committed changes to the webhook subscriptions.
+type EventPublisher interface {
+	Publish(context.Context, ...domain.Event)
+}
+
+func (ps *PrService) publish(ctx context.Context, events ...domain.Event) {
+	if ps.events != nil && len(events) > 0 {
+		ps.events.Publish(ctx, events...)
+	}
+}
@@ -0,0 +1,42 @@
+package servselector
+
+import (
+	"strings"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
+
+const (
+	StrategyRandom      = "random"
+	StrategyRoundRobin  = "round_robin"
+	StrategyLeastLoaded = "least_loaded"
+	StrategyWeighted    = "weighted"
+)
+
+type ReviewerSelector interface {
+	Select(candidates domain.MembersHistories, n int) domain.MembersHistories
+}
+
+// New returns the selector configured by strategy name, unknown names fall back to random.
+func New(strategy string) ReviewerSelector {
+	switch strings.ToLower(strings.TrimSpace(strategy)) {
+	case StrategyRoundRobin:
+		return &roundRobin{}
+	case StrategyLeastLoaded:
+		return leastLoaded{}
+	case StrategyWeighted:
+		return weighted{}
+	default:
+		return random{}
+	}
+}
+
+func limit(l, n int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > l {
+		return l
+	}
+	return n
+}
@@ -0,0 +1,127 @@
+package servselector_test
+
+import (
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servselector "github.com/eragon-mdi/pr-reviewer-service/internal/service/selector"
+	"github.com/stretchr/testify/assert"
+)
+
+func candidates(loads ...int) domain.MembersHistories {
+	res := make([]domain.MemberHistory, 0, len(loads))
+	for i, load := range loads {
+		res = append(res, domain.NewMemberHistory(
+			domain.MemberId(string(rune('a'+i))),
+			domain.MemberStatusActive,
+			domain.MemberRoleDefault,
+			false,
+		).WithLoad(load))
+	}
+	return domain.MembersHistories(res)
+}
+
+func ids(mh domain.MembersHistories) []domain.MemberId {
+	res := make([]domain.MemberId, 0, len(mh))
+	for _, m := range mh {
+		res = append(res, m.Id)
+	}
+	return res
+}
+
+func TestSelect_Count(t *testing.T) {
+	strategies := []string{
+		servselector.StrategyRandom,
+		servselector.StrategyRoundRobin,
+		servselector.StrategyLeastLoaded,
+		servselector.StrategyWeighted,
+		"unknown",
+	}
+
+	tests := []struct {
+		name       string
+		candidates domain.MembersHistories
+		n          int
+		want       int
+	}{
+		{name: "empty candidates", candidates: domain.MembersHistories{}, n: 2, want: 0},
+		{name: "nil candidates", candidates: nil, n: 2, want: 0},
+		{name: "fewer candidates than requested", candidates: candidates(0), n: 2, want: 1},
+		{name: "more candidates than requested", candidates: candidates(0, 1, 2, 3), n: 2, want: 2},
+		{name: "zero requested", candidates: candidates(0, 1), n: 0, want: 0},
+	}
+
+	for _, strategy := range strategies {
+		for _, tt := range tests {
+			t.Run(strategy+"/"+tt.name, func(t *testing.T) {
+				got := servselector.New(strategy).Select(tt.candidates, tt.n)
+
+				assert.Len(t, got, tt.want)
+
+				seen := make(map[domain.MemberId]struct{}, len(got))
+				for _, id := range ids(got) {
+					_, dup := seen[id]
+					assert.False(t, dup, "candidate selected twice")
+					seen[id] = struct{}{}
+				}
+			})
+		}
+	}
+}
+
+func TestSelect_LeastLoaded(t *testing.T) {
+	sel := servselector.New(servselector.StrategyLeastLoaded)
+
+	got := sel.Select(candidates(5, 0, 3, 1), 2)
+
+	assert.Equal(t, []domain.MemberId{"b", "d"}, ids(got))
+}
+
+func TestSelect_RoundRobin(t *testing.T) {
+	sel := servselector.New(servselector.StrategyRoundRobin)
+	cs := candidates(0, 0, 0)
+
+	assert.Equal(t, []domain.MemberId{"a", "b"}, ids(sel.Select(cs, 2)))
+	assert.Equal(t, []domain.MemberId{"b", "c"}, ids(sel.Select(cs, 2)))
+	assert.Equal(t, []domain.MemberId{"c", "a"}, ids(sel.Select(cs, 2)))
+	assert.Equal(t, []domain.MemberId{"a", "b"}, ids(sel.Select(cs, 2)))
+}
+
+func TestSelect_DoesNotMutateCandidates(t *testing.T) {
+	cs := candidates(3, 2, 1)
+	before := ids(cs)
+
+	servselector.New(servselector.StrategyLeastLoaded).Select(cs, 2)
+	servselector.New(servselector.StrategyRandom).Select(cs, 2)
+
+	assert.Equal(t, before, ids(cs))
+}
+
+func TestSelect_WeightedPrefersLowLoad(t *testing.T) {
+	sel := servselector.New(servselector.StrategyWeighted)
+	cs := candidates(0, 99)
+
+	hits := 0
+	for range 1000 {
+		if sel.Select(cs, 1)[0].Id == "a" {
+			hits++
+		}
+	}
+
+	assert.Greater(t, hits, 900)
+}
+
+func TestSelect_LeastLoadedBreaksTiesRandomly(t *testing.T) {
+	sel := servselector.New(servselector.StrategyLeastLoaded)
+	cs := candidates(1, 1, 4)
+
+	picked := make(map[domain.MemberId]int)
+	for range 200 {
+		got := sel.Select(cs, 1)
+		picked[got[0].Id]++
+	}
+
+	assert.Zero(t, picked["c"])
+	assert.NotZero(t, picked["a"])
+	assert.NotZero(t, picked["b"])
+}
@@ -0,0 +1,100 @@
+package servselector
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
+
+type random struct{}
+
+func (random) Select(candidates domain.MembersHistories, n int) domain.MembersHistories {
+	sl := slices.Clone(candidates.Slice())
+	rand.Shuffle(len(sl), func(i, j int) {
+		sl[i], sl[j] = sl[j], sl[i]
+	})
+
+	return domain.MembersHistories(sl[:limit(len(sl), n)])
+}
+
+// roundRobin walks candidates ordered by id, shifting the start on every call.
+type roundRobin struct {
+	next atomic.Uint64
+}
+
+func (rr *roundRobin) Select(candidates domain.MembersHistories, n int) domain.MembersHistories {
+	sl := slices.Clone(candidates.Slice())
+	l := len(sl)
+	if l == 0 {
+		return domain.MembersHistories{}
+	}
+
+	slices.SortFunc(sl, func(a, b domain.MemberHistory) int {
+		return strings.Compare(a.Id.String(), b.Id.String())
+	})
+
+	start := int((rr.next.Add(1) - 1) % uint64(l))
+
+	res := make([]domain.MemberHistory, 0, limit(l, n))
+	for i := range limit(l, n) {
+		res = append(res, sl[(start+i)%l])
+	}
+
+	return domain.MembersHistories(res)
+}
+
+// leastLoaded prefers candidates with fewer open reviews, equal loads are ordered randomly.
+type leastLoaded struct{}
+
+func (leastLoaded) Select(candidates domain.MembersHistories, n int) domain.MembersHistories {
+	sl := slices.Clone(candidates.Slice())
+	rand.Shuffle(len(sl), func(i, j int) {
+		sl[i], sl[j] = sl[j], sl[i]
+	})
+	slices.SortStableFunc(sl, func(a, b domain.MemberHistory) int {
+		return a.Load - b.Load
+	})
+
+	return domain.MembersHistories(sl[:limit(len(sl), n)])
+}
+
+// weighted draws candidates without replacement, weight of a candidate is 1/(1+load).
+type weighted struct{}
+
+func (weighted) Select(candidates domain.MembersHistories, n int) domain.MembersHistories {
+	sl := slices.Clone(candidates.Slice())
+	cnt := limit(len(sl), n)
+
+	res := make([]domain.MemberHistory, 0, cnt)
+	for range cnt {
+		var total float64
+		for _, c := range sl {
+			total += weight(c)
+		}
+
+		idx := len(sl) - 1
+		point := rand.Float64() * total
+		for i, c := range sl {
+			point -= weight(c)
+			if point < 0 {
+				idx = i
+				break
+			}
+		}
+
+		res = append(res, sl[idx])
+		sl = slices.Delete(sl, idx, idx+1)
+	}
+
+	return domain.MembersHistories(res)
+}
+
+func weight(c domain.MemberHistory) float64 {
+	if c.Load < 0 {
+		return 1
+	}
+	return 1 / float64(1+c.Load)
+}
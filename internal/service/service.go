@@ -1,29 +1,51 @@
 package service
 
 import (
+	"context"
+
 	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
 	servmembers "github.com/eragon-mdi/pr-reviewer-service/internal/service/members"
 	servpullrequests "github.com/eragon-mdi/pr-reviewer-service/internal/service/pull-requests"
+	servselector "github.com/eragon-mdi/pr-reviewer-service/internal/service/selector"
+	servstats "github.com/eragon-mdi/pr-reviewer-service/internal/service/stats"
+	servsubscriptions "github.com/eragon-mdi/pr-reviewer-service/internal/service/subscriptions"
 	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
+	servwebhooks "github.com/eragon-mdi/pr-reviewer-service/internal/service/webhooks"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/transport"
+	"go.uber.org/zap"
 )
 
+// Service is what the transport uses plus the background work the application runs.
+type Service interface {
+	transport.Service
+	RunDeliveries(ctx context.Context)
+}
+
 type service struct {
 	*servteams.TeamsService
 	*servmembers.MembersService
 	*servpullrequests.PrService
+	*servstats.StatsService
+	*servwebhooks.WebhooksService
+	*servsubscriptions.SubscriptionsService
 
 	r   Repository
 	cfg *configs.BussinesLogic
 }
 
-func New(r Repository, cfg *configs.BussinesLogic) transport.Service {
-	ms := servmembers.NewMembersService(cfg, r)
+func New(r Repository, cfg *configs.BussinesLogic, l *zap.SugaredLogger) Service {
+	sel := servselector.New(cfg.ReviewerSelector)
+	subs := servsubscriptions.NewSubscriptionsService(cfg, r, l)
+	ms := servmembers.NewMembersService(cfg, r, sel, subs)
+	ps := servpullrequests.NewPullRequestService(cfg, r, ms, sel, subs)
 
 	return &service{
-		TeamsService:   servteams.NewTeamsService(r),
-		MembersService: ms,
-		PrService:      servpullrequests.NewPullRequestService(r, ms),
+		TeamsService:         servteams.NewTeamsService(cfg, r, sel, subs),
+		MembersService:       ms,
+		PrService:            ps,
+		StatsService:         servstats.NewStatsService(r),
+		WebhooksService:      servwebhooks.NewWebhooksService(cfg, r, ps),
+		SubscriptionsService: subs,
 
 		r:   r,
 		cfg: cfg,
@@ -34,4 +56,7 @@ type Repository interface {
 	servteams.Repository
 	servmembers.Repository
 	servpullrequests.Repository
+	servstats.Repository
+	servwebhooks.Repository
+	servsubscriptions.Repository
 }
@@ -0,0 +1,157 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatsRepository is an autogenerated mock type for the StatsRepository type
+type StatsRepository struct {
+	mock.Mock
+}
+
+type StatsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsRepository) EXPECT() *StatsRepository_Expecter {
+	return &StatsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMemberAssignmentStats provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StatsRepository) GetMemberAssignmentStats(_a0 context.Context, _a1 domain.StatsFilter, _a2 domain.MemberPageRequest) ([]domain.MemberAssignmentStats, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberAssignmentStats")
+	}
+
+	var r0 []domain.MemberAssignmentStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsFilter, domain.MemberPageRequest) ([]domain.MemberAssignmentStats, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsFilter, domain.MemberPageRequest) []domain.MemberAssignmentStats); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MemberAssignmentStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.StatsFilter, domain.MemberPageRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetMemberAssignmentStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberAssignmentStats'
+type StatsRepository_GetMemberAssignmentStats_Call struct {
+	*mock.Call
+}
+
+// GetMemberAssignmentStats is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.StatsFilter
+//   - _a2 domain.MemberPageRequest
+func (_e *StatsRepository_Expecter) GetMemberAssignmentStats(_a0 interface{}, _a1 interface{}, _a2 interface{}) *StatsRepository_GetMemberAssignmentStats_Call {
+	return &StatsRepository_GetMemberAssignmentStats_Call{Call: _e.mock.On("GetMemberAssignmentStats", _a0, _a1, _a2)}
+}
+
+func (_c *StatsRepository_GetMemberAssignmentStats_Call) Run(run func(_a0 context.Context, _a1 domain.StatsFilter, _a2 domain.MemberPageRequest)) *StatsRepository_GetMemberAssignmentStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.StatsFilter), args[2].(domain.MemberPageRequest))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetMemberAssignmentStats_Call) Return(_a0 []domain.MemberAssignmentStats, _a1 error) *StatsRepository_GetMemberAssignmentStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetMemberAssignmentStats_Call) RunAndReturn(run func(context.Context, domain.StatsFilter, domain.MemberPageRequest) ([]domain.MemberAssignmentStats, error)) *StatsRepository_GetMemberAssignmentStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPullRequestAssignmentStats provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StatsRepository) GetPullRequestAssignmentStats(_a0 context.Context, _a1 domain.StatsFilter, _a2 domain.PageRequest) ([]domain.PrAssignmentStats, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullRequestAssignmentStats")
+	}
+
+	var r0 []domain.PrAssignmentStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsFilter, domain.PageRequest) ([]domain.PrAssignmentStats, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsFilter, domain.PageRequest) []domain.PrAssignmentStats); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PrAssignmentStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.StatsFilter, domain.PageRequest) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsRepository_GetPullRequestAssignmentStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPullRequestAssignmentStats'
+type StatsRepository_GetPullRequestAssignmentStats_Call struct {
+	*mock.Call
+}
+
+// GetPullRequestAssignmentStats is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.StatsFilter
+//   - _a2 domain.PageRequest
+func (_e *StatsRepository_Expecter) GetPullRequestAssignmentStats(_a0 interface{}, _a1 interface{}, _a2 interface{}) *StatsRepository_GetPullRequestAssignmentStats_Call {
+	return &StatsRepository_GetPullRequestAssignmentStats_Call{Call: _e.mock.On("GetPullRequestAssignmentStats", _a0, _a1, _a2)}
+}
+
+func (_c *StatsRepository_GetPullRequestAssignmentStats_Call) Run(run func(_a0 context.Context, _a1 domain.StatsFilter, _a2 domain.PageRequest)) *StatsRepository_GetPullRequestAssignmentStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.StatsFilter), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *StatsRepository_GetPullRequestAssignmentStats_Call) Return(_a0 []domain.PrAssignmentStats, _a1 error) *StatsRepository_GetPullRequestAssignmentStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsRepository_GetPullRequestAssignmentStats_Call) RunAndReturn(run func(context.Context, domain.StatsFilter, domain.PageRequest) ([]domain.PrAssignmentStats, error)) *StatsRepository_GetPullRequestAssignmentStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsRepository creates a new instance of StatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsRepository {
+	mock := &StatsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,15 @@
+package servstats
+
+type StatsService struct {
+	repo Repository
+}
+
+func NewStatsService(r Repository) *StatsService {
+	return &StatsService{
+		repo: r,
+	}
+}
+
+type Repository interface {
+	StatsRepository
+}
@@ -0,0 +1,31 @@
+package servstats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
+
+type StatsRepository interface {
+	GetMemberAssignmentStats(context.Context, domain.StatsFilter, domain.MemberPageRequest) ([]domain.MemberAssignmentStats, error)
+	GetPullRequestAssignmentStats(context.Context, domain.StatsFilter, domain.PageRequest) ([]domain.PrAssignmentStats, error)
+}
+
+func (ss *StatsService) AssignmentStats(ctx context.Context, f domain.StatsFilter, page domain.StatsPageRequest) (domain.AssignmentStats, error) {
+	if !f.Valid() {
+		return domain.AssignmentStats{}, domain.ErrValidation
+	}
+
+	members, err := ss.repo.GetMemberAssignmentStats(ctx, f, page.Members)
+	if err != nil {
+		return domain.AssignmentStats{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	prs, err := ss.repo.GetPullRequestAssignmentStats(ctx, f, page.PullRequests)
+	if err != nil {
+		return domain.AssignmentStats{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return domain.NewAssignmentStats(members, prs, page), nil
+}
@@ -0,0 +1,93 @@
+package servstats_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servstats "github.com/eragon-mdi/pr-reviewer-service/internal/service/stats"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/stats/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsService_AssignmentStats(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	members := []domain.MemberAssignmentStats{
+		{MemberId: "u1", Name: "Alice", Total: 3, Open: 1, Merged: 2, ReassignedAway: 1},
+	}
+	prs := []domain.PrAssignmentStats{
+		{PrId: "pr-1", Name: "Feature", AuthorId: "u2", Team: "backend", Reviewers: 2, Reassignments: 1},
+	}
+	page := domain.StatsPageRequest{
+		Members:      domain.MemberPageRequest{Limit: 1},
+		PullRequests: domain.PageRequest{Limit: 1},
+	}
+
+	tests := []struct {
+		name      string
+		filter    domain.StatsFilter
+		repoSetup func(*mocks.StatsRepository, domain.StatsFilter)
+		want      domain.AssignmentStats
+		wantErr   error
+	}{
+		{
+			name:   "stats for team and range",
+			filter: domain.StatsFilter{Team: "backend", From: now.Add(-time.Hour), To: now},
+			repoSetup: func(mockRepo *mocks.StatsRepository, f domain.StatsFilter) {
+				mockRepo.EXPECT().GetMemberAssignmentStats(ctx, f, page.Members).Return(members, nil)
+				mockRepo.EXPECT().GetPullRequestAssignmentStats(ctx, f, page.PullRequests).Return(prs, nil)
+			},
+			want: domain.AssignmentStats{Members: members, PullRequests: prs},
+		},
+		{
+			name:   "rows beyond the limit give the next cursors",
+			filter: domain.StatsFilter{},
+			repoSetup: func(mockRepo *mocks.StatsRepository, f domain.StatsFilter) {
+				mockRepo.EXPECT().GetMemberAssignmentStats(ctx, f, page.Members).Return(append(members, domain.MemberAssignmentStats{MemberId: "u3", Name: "Bob"}), nil)
+				mockRepo.EXPECT().GetPullRequestAssignmentStats(ctx, f, page.PullRequests).Return(append(prs, domain.PrAssignmentStats{PrId: "pr-0"}), nil)
+			},
+			want: domain.AssignmentStats{
+				Members:                members,
+				PullRequests:           prs,
+				NextMembersCursor:      domain.MemberCursor{Name: "Alice", Id: "u1"}.String(),
+				NextPullRequestsCursor: domain.PageCursor{Id: "pr-1"}.String(),
+			},
+		},
+		{
+			name:      "inverted range",
+			filter:    domain.StatsFilter{From: now, To: now.Add(-time.Hour)},
+			repoSetup: func(*mocks.StatsRepository, domain.StatsFilter) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:   "repository failure",
+			filter: domain.StatsFilter{},
+			repoSetup: func(mockRepo *mocks.StatsRepository, f domain.StatsFilter) {
+				mockRepo.EXPECT().GetMemberAssignmentStats(ctx, f, page.Members).Return(nil, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewStatsRepository(t)
+			tt.repoSetup(mockRepo, tt.filter)
+
+			service := servstats.NewStatsService(mockRepo)
+			got, err := service.AssignmentStats(ctx, tt.filter, page)
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
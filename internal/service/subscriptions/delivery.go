@@ -0,0 +1,240 @@
+package servsubscriptions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/pkg/signature"
+	"github.com/go-faster/errors"
+)
+
+const (
+	HeaderSignature  = "X-Signature-256"
+	HeaderEventType  = "X-Event-Type"
+	HeaderEventId    = "X-Event-Id"
+	HeaderDeliveryId = "X-Delivery-Id"
+
+	userAgent = "pr-reviewer-service"
+
+	// maxErrorBody is how much of a failed response body is kept in the delivery log.
+	maxErrorBody = 512
+
+	// deliveryBatch is how many due deliveries are claimed and attempted at once.
+	deliveryBatch = 16
+)
+
+type eventPayload struct {
+	Id         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       eventData `json:"data"`
+}
+
+type eventData struct {
+	PullRequestID     string   `json:"pull_request_id,omitempty"`
+	AuthorID          string   `json:"author_id,omitempty"`
+	TeamName          string   `json:"team_name,omitempty"`
+	AssignedReviewers []string `json:"assigned_reviewers,omitempty"`
+	OldReviewerID     string   `json:"old_reviewer_id,omitempty"`
+	NewReviewerID     string   `json:"new_reviewer_id,omitempty"`
+	UserID            string   `json:"user_id,omitempty"`
+}
+
+func newEventPayload(e domain.Event) eventPayload {
+	p := eventPayload{
+		Id:         string(e.Id),
+		Type:       e.Type.String(),
+		OccurredAt: e.OccurredAt,
+	}
+
+	switch e.Type {
+	case domain.EventReviewerReassigned:
+		p.Data.PullRequestID = e.PrId.String()
+		p.Data.OldReviewerID = e.MemberId.String()
+		if len(e.Reviewers) > 0 {
+			p.Data.NewReviewerID = e.Reviewers[0].String()
+		}
+	case domain.EventMemberDeactivated:
+		p.Data.UserID = e.MemberId.String()
+	default:
+		p.Data.PullRequestID = e.PrId.String()
+		p.Data.AuthorID = e.AuthorId.String()
+		p.Data.TeamName = e.Team.String()
+		p.Data.AssignedReviewers = make([]string, 0, len(e.Reviewers))
+		for _, id := range e.Reviewers {
+			p.Data.AssignedReviewers = append(p.Data.AssignedReviewers, id.String())
+		}
+	}
+
+	return p
+}
+
+// Publish logs a pending delivery of each event for every subscription of its type and wakes RunDeliveries.
+// It is called once the change is committed and never fails it, errors are logged only:
+// the outcome of each delivery is kept in the delivery log, where it can be redelivered.
+func (ss *SubscriptionsService) Publish(ctx context.Context, events ...domain.Event) {
+	if len(events) == 0 {
+		return
+	}
+	// the change is committed, a request cancelled meanwhile must not lose its events
+	ctx = context.WithoutCancel(ctx)
+
+	subs, err := ss.repo.ListSubscriptions(ctx)
+	if err != nil {
+		ss.l.Errorw("failed to list subscriptions, events are not delivered", "events", len(events), "cause", err)
+		return
+	}
+
+	created := false
+	for _, e := range events {
+		payload, err := json.Marshal(newEventPayload(e))
+		if err != nil {
+			ss.l.Errorw("failed to encode event", "event_id", e.Id, "type", e.Type, "cause", err)
+			continue
+		}
+
+		for _, sub := range subs {
+			if !sub.Matches(e.Type) {
+				continue
+			}
+
+			if _, err := ss.repo.CreateDelivery(ctx, newDelivery(sub.Id, e.Id, e.Type, payload)); err != nil {
+				if !errors.Is(err, domain.ErrNotFound) {
+					ss.l.Errorw("failed to log delivery, event is not delivered",
+						"subscription_id", sub.Id, "event_id", e.Id, "type", e.Type, "cause", err)
+				}
+				continue
+			}
+			created = true
+		}
+	}
+
+	if created {
+		select {
+		case ss.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// RunDeliveries attempts the pending deliveries until ctx is done, starting with those left pending
+// by a previous run. Claimed deliveries are held for a lease, so several instances can share the log.
+// Attempts in flight when ctx is done are finished and recorded before it returns.
+func (ss *SubscriptionsService) RunDeliveries(ctx context.Context) {
+	ticker := time.NewTicker(ss.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// a full batch means more deliveries may be due already
+		if ss.deliverDue(ctx) == deliveryBatch && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-ss.wake:
+		}
+	}
+}
+
+// deliverDue attempts a batch of due deliveries concurrently and returns how many were claimed.
+func (ss *SubscriptionsService) deliverDue(ctx context.Context) int {
+	due, err := ss.repo.ClaimDeliveries(ctx, deliveryBatch, ss.lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			ss.l.Errorw("failed to claim pending deliveries", "cause", err)
+		}
+		return 0
+	}
+
+	// an attempt is bounded by the request timeout, it is not cut short on shutdown
+	attemptCtx := context.WithoutCancel(ctx)
+
+	var wg sync.WaitGroup
+	for _, d := range due {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ss.deliver(attemptCtx, d.Subscription, d.Delivery)
+		}()
+	}
+	wg.Wait()
+
+	return len(due)
+}
+
+// deliver attempts the delivery once and schedules the next attempt, it fails once the attempts run out.
+func (ss *SubscriptionsService) deliver(ctx context.Context, sub domain.Subscription, d domain.Delivery) {
+	d = ss.attempt(ctx, sub, d)
+
+	if d.Status == domain.DeliveryPending {
+		delay, retry := ss.retry.Delay(d.Attempts)
+		if retry {
+			d.NextAttemptAt = time.Now().Add(delay)
+		} else {
+			d.Status = domain.DeliveryFailed
+		}
+	}
+
+	if err := ss.repo.UpdateDelivery(ctx, d); err != nil {
+		ss.l.Errorw("failed to record delivery attempt", "delivery_id", d.Id, "attempt", d.Attempts, "cause", err)
+		return
+	}
+
+	switch d.Status {
+	case domain.DeliveryPending:
+		ss.l.Warnw("delivery attempt failed, retrying", "delivery_id", d.Id, "subscription_id", sub.Id,
+			"attempt", d.Attempts, "next_attempt_at", d.NextAttemptAt, "cause", d.LastError)
+	case domain.DeliveryFailed:
+		ss.l.Errorw("delivery failed, attempts ran out", "delivery_id", d.Id, "subscription_id", sub.Id,
+			"attempts", d.Attempts, "cause", d.LastError)
+	}
+}
+
+// attempt sends the delivery once, a 2xx response marks it succeeded, otherwise it stays pending.
+func (ss *SubscriptionsService) attempt(ctx context.Context, sub domain.Subscription, d domain.Delivery) domain.Delivery {
+	d.Attempts++
+	d.ResponseCode = 0
+	d.LastError = ""
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Url, bytes.NewReader(d.Payload))
+	if err != nil {
+		d.LastError = err.Error()
+		return d
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderSignature, signature.SignSHA256([]byte(sub.Secret), d.Payload))
+	req.Header.Set(HeaderEventType, d.EventType.String())
+	req.Header.Set(HeaderEventId, string(d.EventId))
+	req.Header.Set(HeaderDeliveryId, d.Id.String())
+
+	resp, err := ss.client.Do(req)
+	if err != nil {
+		d.LastError = err.Error()
+		return d
+	}
+	defer resp.Body.Close()
+
+	d.ResponseCode = resp.StatusCode
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		d.Status = domain.DeliverySucceeded
+		return d
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	d.LastError = resp.Status
+	if len(body) > 0 {
+		d.LastError += ": " + strings.ToValidUTF8(string(body), "")
+	}
+	return d
+}
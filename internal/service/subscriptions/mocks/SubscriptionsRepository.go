@@ -0,0 +1,539 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SubscriptionsRepository is an autogenerated mock type for the SubscriptionsRepository type
+type SubscriptionsRepository struct {
+	mock.Mock
+}
+
+type SubscriptionsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubscriptionsRepository) EXPECT() *SubscriptionsRepository_Expecter {
+	return &SubscriptionsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDeliveries provides a mock function with given fields: ctx, limit, lease
+func (_m *SubscriptionsRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.DueDelivery, error) {
+	ret := _m.Called(ctx, limit, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDeliveries")
+	}
+
+	var r0 []domain.DueDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) ([]domain.DueDelivery, error)); ok {
+		return rf(ctx, limit, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) []domain.DueDelivery); ok {
+		r0 = rf(ctx, limit, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DueDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = rf(ctx, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsRepository_ClaimDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDeliveries'
+type SubscriptionsRepository_ClaimDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - lease time.Duration
+func (_e *SubscriptionsRepository_Expecter) ClaimDeliveries(ctx interface{}, limit interface{}, lease interface{}) *SubscriptionsRepository_ClaimDeliveries_Call {
+	return &SubscriptionsRepository_ClaimDeliveries_Call{Call: _e.mock.On("ClaimDeliveries", ctx, limit, lease)}
+}
+
+func (_c *SubscriptionsRepository_ClaimDeliveries_Call) Run(run func(ctx context.Context, limit int, lease time.Duration)) *SubscriptionsRepository_ClaimDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsRepository_ClaimDeliveries_Call) Return(_a0 []domain.DueDelivery, _a1 error) *SubscriptionsRepository_ClaimDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsRepository_ClaimDeliveries_Call) RunAndReturn(run func(context.Context, int, time.Duration) ([]domain.DueDelivery, error)) *SubscriptionsRepository_ClaimDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDelivery provides a mock function with given fields: _a0, _a1
+func (_m *SubscriptionsRepository) CreateDelivery(_a0 context.Context, _a1 domain.Delivery) (domain.Delivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDelivery")
+	}
+
+	var r0 domain.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Delivery) (domain.Delivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Delivery) domain.Delivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Delivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Delivery) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsRepository_CreateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDelivery'
+type SubscriptionsRepository_CreateDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateDelivery is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Delivery
+func (_e *SubscriptionsRepository_Expecter) CreateDelivery(_a0 interface{}, _a1 interface{}) *SubscriptionsRepository_CreateDelivery_Call {
+	return &SubscriptionsRepository_CreateDelivery_Call{Call: _e.mock.On("CreateDelivery", _a0, _a1)}
+}
+
+func (_c *SubscriptionsRepository_CreateDelivery_Call) Run(run func(_a0 context.Context, _a1 domain.Delivery)) *SubscriptionsRepository_CreateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Delivery))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsRepository_CreateDelivery_Call) Return(_a0 domain.Delivery, _a1 error) *SubscriptionsRepository_CreateDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsRepository_CreateDelivery_Call) RunAndReturn(run func(context.Context, domain.Delivery) (domain.Delivery, error)) *SubscriptionsRepository_CreateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSubscription provides a mock function with given fields: _a0, _a1
+func (_m *SubscriptionsRepository) CreateSubscription(_a0 context.Context, _a1 domain.Subscription) (domain.Subscription, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubscription")
+	}
+
+	var r0 domain.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Subscription) (domain.Subscription, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Subscription) domain.Subscription); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Subscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Subscription) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsRepository_CreateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSubscription'
+type SubscriptionsRepository_CreateSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateSubscription is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Subscription
+func (_e *SubscriptionsRepository_Expecter) CreateSubscription(_a0 interface{}, _a1 interface{}) *SubscriptionsRepository_CreateSubscription_Call {
+	return &SubscriptionsRepository_CreateSubscription_Call{Call: _e.mock.On("CreateSubscription", _a0, _a1)}
+}
+
+func (_c *SubscriptionsRepository_CreateSubscription_Call) Run(run func(_a0 context.Context, _a1 domain.Subscription)) *SubscriptionsRepository_CreateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Subscription))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsRepository_CreateSubscription_Call) Return(_a0 domain.Subscription, _a1 error) *SubscriptionsRepository_CreateSubscription_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsRepository_CreateSubscription_Call) RunAndReturn(run func(context.Context, domain.Subscription) (domain.Subscription, error)) *SubscriptionsRepository_CreateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSubscription provides a mock function with given fields: _a0, _a1
+func (_m *SubscriptionsRepository) DeleteSubscription(_a0 context.Context, _a1 domain.SubscriptionId) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionId) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionsRepository_DeleteSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSubscription'
+type SubscriptionsRepository_DeleteSubscription_Call struct {
+	*mock.Call
+}
+
+// DeleteSubscription is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.SubscriptionId
+func (_e *SubscriptionsRepository_Expecter) DeleteSubscription(_a0 interface{}, _a1 interface{}) *SubscriptionsRepository_DeleteSubscription_Call {
+	return &SubscriptionsRepository_DeleteSubscription_Call{Call: _e.mock.On("DeleteSubscription", _a0, _a1)}
+}
+
+func (_c *SubscriptionsRepository_DeleteSubscription_Call) Run(run func(_a0 context.Context, _a1 domain.SubscriptionId)) *SubscriptionsRepository_DeleteSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SubscriptionId))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsRepository_DeleteSubscription_Call) Return(_a0 error) *SubscriptionsRepository_DeleteSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionsRepository_DeleteSubscription_Call) RunAndReturn(run func(context.Context, domain.SubscriptionId) error) *SubscriptionsRepository_DeleteSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelivery provides a mock function with given fields: _a0, _a1
+func (_m *SubscriptionsRepository) GetDelivery(_a0 context.Context, _a1 domain.DeliveryId) (domain.Delivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelivery")
+	}
+
+	var r0 domain.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeliveryId) (domain.Delivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeliveryId) domain.Delivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Delivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DeliveryId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsRepository_GetDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelivery'
+type SubscriptionsRepository_GetDelivery_Call struct {
+	*mock.Call
+}
+
+// GetDelivery is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.DeliveryId
+func (_e *SubscriptionsRepository_Expecter) GetDelivery(_a0 interface{}, _a1 interface{}) *SubscriptionsRepository_GetDelivery_Call {
+	return &SubscriptionsRepository_GetDelivery_Call{Call: _e.mock.On("GetDelivery", _a0, _a1)}
+}
+
+func (_c *SubscriptionsRepository_GetDelivery_Call) Run(run func(_a0 context.Context, _a1 domain.DeliveryId)) *SubscriptionsRepository_GetDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeliveryId))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsRepository_GetDelivery_Call) Return(_a0 domain.Delivery, _a1 error) *SubscriptionsRepository_GetDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsRepository_GetDelivery_Call) RunAndReturn(run func(context.Context, domain.DeliveryId) (domain.Delivery, error)) *SubscriptionsRepository_GetDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscription provides a mock function with given fields: _a0, _a1
+func (_m *SubscriptionsRepository) GetSubscription(_a0 context.Context, _a1 domain.SubscriptionId) (domain.Subscription, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscription")
+	}
+
+	var r0 domain.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionId) (domain.Subscription, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionId) domain.Subscription); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Subscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SubscriptionId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsRepository_GetSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscription'
+type SubscriptionsRepository_GetSubscription_Call struct {
+	*mock.Call
+}
+
+// GetSubscription is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.SubscriptionId
+func (_e *SubscriptionsRepository_Expecter) GetSubscription(_a0 interface{}, _a1 interface{}) *SubscriptionsRepository_GetSubscription_Call {
+	return &SubscriptionsRepository_GetSubscription_Call{Call: _e.mock.On("GetSubscription", _a0, _a1)}
+}
+
+func (_c *SubscriptionsRepository_GetSubscription_Call) Run(run func(_a0 context.Context, _a1 domain.SubscriptionId)) *SubscriptionsRepository_GetSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SubscriptionId))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsRepository_GetSubscription_Call) Return(_a0 domain.Subscription, _a1 error) *SubscriptionsRepository_GetSubscription_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsRepository_GetSubscription_Call) RunAndReturn(run func(context.Context, domain.SubscriptionId) (domain.Subscription, error)) *SubscriptionsRepository_GetSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveries provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SubscriptionsRepository) ListDeliveries(_a0 context.Context, _a1 domain.SubscriptionId, _a2 int) ([]domain.Delivery, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []domain.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionId, int) ([]domain.Delivery, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionId, int) []domain.Delivery); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SubscriptionId, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsRepository_ListDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveries'
+type SubscriptionsRepository_ListDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListDeliveries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.SubscriptionId
+//   - _a2 int
+func (_e *SubscriptionsRepository_Expecter) ListDeliveries(_a0 interface{}, _a1 interface{}, _a2 interface{}) *SubscriptionsRepository_ListDeliveries_Call {
+	return &SubscriptionsRepository_ListDeliveries_Call{Call: _e.mock.On("ListDeliveries", _a0, _a1, _a2)}
+}
+
+func (_c *SubscriptionsRepository_ListDeliveries_Call) Run(run func(_a0 context.Context, _a1 domain.SubscriptionId, _a2 int)) *SubscriptionsRepository_ListDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SubscriptionId), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsRepository_ListDeliveries_Call) Return(_a0 []domain.Delivery, _a1 error) *SubscriptionsRepository_ListDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsRepository_ListDeliveries_Call) RunAndReturn(run func(context.Context, domain.SubscriptionId, int) ([]domain.Delivery, error)) *SubscriptionsRepository_ListDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubscriptions provides a mock function with given fields: _a0
+func (_m *SubscriptionsRepository) ListSubscriptions(_a0 context.Context) ([]domain.Subscription, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubscriptions")
+	}
+
+	var r0 []domain.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Subscription, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Subscription); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsRepository_ListSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubscriptions'
+type SubscriptionsRepository_ListSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ListSubscriptions is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *SubscriptionsRepository_Expecter) ListSubscriptions(_a0 interface{}) *SubscriptionsRepository_ListSubscriptions_Call {
+	return &SubscriptionsRepository_ListSubscriptions_Call{Call: _e.mock.On("ListSubscriptions", _a0)}
+}
+
+func (_c *SubscriptionsRepository_ListSubscriptions_Call) Run(run func(_a0 context.Context)) *SubscriptionsRepository_ListSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsRepository_ListSubscriptions_Call) Return(_a0 []domain.Subscription, _a1 error) *SubscriptionsRepository_ListSubscriptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsRepository_ListSubscriptions_Call) RunAndReturn(run func(context.Context) ([]domain.Subscription, error)) *SubscriptionsRepository_ListSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDelivery provides a mock function with given fields: _a0, _a1
+func (_m *SubscriptionsRepository) UpdateDelivery(_a0 context.Context, _a1 domain.Delivery) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Delivery) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionsRepository_UpdateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDelivery'
+type SubscriptionsRepository_UpdateDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateDelivery is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Delivery
+func (_e *SubscriptionsRepository_Expecter) UpdateDelivery(_a0 interface{}, _a1 interface{}) *SubscriptionsRepository_UpdateDelivery_Call {
+	return &SubscriptionsRepository_UpdateDelivery_Call{Call: _e.mock.On("UpdateDelivery", _a0, _a1)}
+}
+
+func (_c *SubscriptionsRepository_UpdateDelivery_Call) Run(run func(_a0 context.Context, _a1 domain.Delivery)) *SubscriptionsRepository_UpdateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Delivery))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsRepository_UpdateDelivery_Call) Return(_a0 error) *SubscriptionsRepository_UpdateDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionsRepository_UpdateDelivery_Call) RunAndReturn(run func(context.Context, domain.Delivery) error) *SubscriptionsRepository_UpdateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubscriptionsRepository creates a new instance of SubscriptionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscriptionsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubscriptionsRepository {
+	mock := &SubscriptionsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
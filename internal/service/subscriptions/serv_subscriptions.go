@@ -0,0 +1,69 @@
+package servsubscriptions
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/pkg/netguard"
+	"go.uber.org/zap"
+)
+
+// leaseMargin is added to the request timeout to hold a claimed delivery while it is attempted.
+const leaseMargin = 30 * time.Second
+
+type SubscriptionsService struct {
+	repo  Repository
+	l     *zap.SugaredLogger
+	retry domain.DeliveryRetry
+
+	client       *http.Client
+	allowPrivate bool
+	pollInterval time.Duration
+	lease        time.Duration
+	wake         chan struct{}
+}
+
+func NewSubscriptionsService(cfg *configs.BussinesLogic, r Repository, l *zap.SugaredLogger) *SubscriptionsService {
+	return &SubscriptionsService{
+		repo: r,
+		l:    l,
+		retry: domain.DeliveryRetry{
+			MaxAttempts: cfg.SubscriptionMaxAttempts,
+			BaseDelay:   cfg.SubscriptionBackoff,
+		},
+		client:       newClient(cfg),
+		allowPrivate: cfg.SubscriptionAllowPrivate,
+		pollInterval: cfg.SubscriptionPollInterval,
+		lease:        cfg.SubscriptionTimeout + leaseMargin,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// newClient dials public addresses only unless private networks are allowed,
+// redirects are not followed: a 3xx is a failed attempt.
+func newClient(cfg *configs.BussinesLogic) *http.Client {
+	dialer := &net.Dialer{Timeout: cfg.SubscriptionTimeout}
+	if !cfg.SubscriptionAllowPrivate {
+		dialer.Control = netguard.Control
+	}
+
+	return &http.Client{
+		Timeout: cfg.SubscriptionTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: cfg.SubscriptionTimeout,
+			MaxIdleConnsPerHost: 2,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+type Repository interface {
+	SubscriptionsRepository
+}
@@ -0,0 +1,142 @@
+package servsubscriptions
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/pkg/netguard"
+	"github.com/go-faster/errors"
+	"github.com/google/uuid"
+)
+
+type SubscriptionsRepository interface {
+	CreateSubscription(context.Context, domain.Subscription) (domain.Subscription, error)
+	ListSubscriptions(context.Context) ([]domain.Subscription, error)
+	GetSubscription(context.Context, domain.SubscriptionId) (domain.Subscription, error)
+	DeleteSubscription(context.Context, domain.SubscriptionId) error
+	CreateDelivery(context.Context, domain.Delivery) (domain.Delivery, error)
+	UpdateDelivery(context.Context, domain.Delivery) error
+	GetDelivery(context.Context, domain.DeliveryId) (domain.Delivery, error)
+	ListDeliveries(context.Context, domain.SubscriptionId, int) ([]domain.Delivery, error)
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.DueDelivery, error)
+}
+
+const secretSize = 32
+
+// Subscribe registers the subscription, a secret is generated when none is given.
+// Unless private networks are allowed, the url host must resolve to public addresses only.
+func (ss *SubscriptionsService) Subscribe(ctx context.Context, sub domain.Subscription) (domain.Subscription, error) {
+	if !sub.Valid() {
+		return domain.Subscription{}, domain.ErrValidation
+	}
+	if !ss.allowPrivate {
+		if err := netguard.CheckURL(ctx, net.DefaultResolver, sub.Url); err != nil {
+			return domain.Subscription{}, fmt.Errorf("%w: %w", domain.ErrValidation, err)
+		}
+	}
+
+	if sub.Secret == "" {
+		secret := make([]byte, secretSize)
+		if _, err := rand.Read(secret); err != nil {
+			return domain.Subscription{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+		sub.Secret = hex.EncodeToString(secret)
+	}
+	sub.Id = domain.SubscriptionId(uuid.NewString())
+
+	created, err := ss.repo.CreateSubscription(ctx, sub)
+	if err != nil {
+		return domain.Subscription{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return created, nil
+}
+
+func (ss *SubscriptionsService) Subscriptions(ctx context.Context) ([]domain.Subscription, error) {
+	subs, err := ss.repo.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return subs, nil
+}
+
+// Unsubscribe removes the subscription together with its delivery log.
+func (ss *SubscriptionsService) Unsubscribe(ctx context.Context, id domain.SubscriptionId) error {
+	if err := ss.repo.DeleteSubscription(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return nil
+}
+
+// Deliveries returns the latest deliveries of the subscription, newest first.
+func (ss *SubscriptionsService) Deliveries(ctx context.Context, id domain.SubscriptionId, limit int) ([]domain.Delivery, error) {
+	deliveries, err := ss.repo.ListDeliveries(ctx, id, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return deliveries, nil
+}
+
+// Redeliver sends the payload of a logged delivery once more as a new delivery and waits for the outcome,
+// the original entry is kept as is.
+func (ss *SubscriptionsService) Redeliver(ctx context.Context, id domain.DeliveryId) (domain.Delivery, error) {
+	orig, err := ss.repo.GetDelivery(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Delivery{}, domain.ErrNotFound
+		}
+		return domain.Delivery{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	sub, err := ss.repo.GetSubscription(ctx, orig.SubscriptionId)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Delivery{}, domain.ErrNotFound
+		}
+		return domain.Delivery{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	// held for the lease, so that RunDeliveries does not send it meanwhile
+	d := newDelivery(sub.Id, orig.EventId, orig.EventType, orig.Payload)
+	d.NextAttemptAt = time.Now().Add(ss.lease)
+
+	d, err = ss.repo.CreateDelivery(ctx, d)
+	if err != nil {
+		return domain.Delivery{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	d = ss.attempt(ctx, sub, d)
+	if d.Status != domain.DeliverySucceeded {
+		d.Status = domain.DeliveryFailed
+	}
+	if err := ss.repo.UpdateDelivery(ctx, d); err != nil {
+		return domain.Delivery{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return d, nil
+}
+
+func newDelivery(sub domain.SubscriptionId, event domain.EventId, t domain.EventType, payload []byte) domain.Delivery {
+	return domain.Delivery{
+		Id:             domain.DeliveryId(uuid.NewString()),
+		SubscriptionId: sub,
+		EventId:        event,
+		EventType:      t,
+		Payload:        payload,
+		Status:         domain.DeliveryPending,
+	}
+}
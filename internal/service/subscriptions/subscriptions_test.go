@@ -0,0 +1,479 @@
+package servsubscriptions_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servsubscriptions "github.com/eragon-mdi/pr-reviewer-service/internal/service/subscriptions"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/subscriptions/mocks"
+	"github.com/eragon-mdi/pr-reviewer-service/pkg/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// testCfg allows private networks for the local receivers, guardedCfg does not.
+var (
+	testCfg = &configs.BussinesLogic{
+		SubscriptionMaxAttempts:  3,
+		SubscriptionBackoff:      time.Millisecond,
+		SubscriptionTimeout:      time.Second,
+		SubscriptionPollInterval: 10 * time.Millisecond,
+		SubscriptionAllowPrivate: true,
+	}
+	guardedCfg = &configs.BussinesLogic{
+		SubscriptionMaxAttempts:  1,
+		SubscriptionTimeout:      time.Second,
+		SubscriptionPollInterval: 10 * time.Millisecond,
+	}
+)
+
+var nopLogger = zap.NewNop().Sugar()
+
+// receiver is a local subscriber answering with the given codes in turn, the last one repeats.
+type receiver struct {
+	*httptest.Server
+	secret   string
+	codes    []int
+	requests chan *http.Request
+	bodies   chan []byte
+	calls    atomic.Int32
+}
+
+func newReceiver(t *testing.T, secret string, codes ...int) *receiver {
+	r := &receiver{
+		secret:   secret,
+		codes:    codes,
+		requests: make(chan *http.Request, 10),
+		bodies:   make(chan []byte, 10),
+	}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.requests <- req
+		r.bodies <- body
+
+		n := int(r.calls.Add(1))
+		w.WriteHeader(r.codes[min(n, len(r.codes))-1])
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) subscription(events ...domain.EventType) domain.Subscription {
+	return domain.Subscription{Id: "11111111-1111-1111-1111-111111111111", Url: r.URL, Secret: r.secret, Events: events}
+}
+
+// deliveryLog is the repository side of the worker: it hands the delivery out while it is pending
+// and keeps every recorded attempt.
+func deliveryLog(t *testing.T, mockRepo *mocks.SubscriptionsRepository, sub domain.Subscription, d domain.Delivery) <-chan domain.Delivery {
+	var mu sync.Mutex
+	updates := make(chan domain.Delivery, 10)
+
+	mockRepo.EXPECT().ClaimDeliveries(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(context.Context, int, time.Duration) ([]domain.DueDelivery, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if d.Status != domain.DeliveryPending {
+				return nil, nil
+			}
+			return []domain.DueDelivery{{Delivery: d, Subscription: sub}}, nil
+		})
+	mockRepo.EXPECT().UpdateDelivery(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, upd domain.Delivery) error {
+			mu.Lock()
+			defer mu.Unlock()
+			d = upd
+			updates <- upd
+			return nil
+		})
+
+	return updates
+}
+
+// runDeliveries runs the worker until the test ends and checks that it stops then.
+func runDeliveries(t *testing.T, service *servsubscriptions.SubscriptionsService) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		service.RunDeliveries(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case <-stopped:
+		case <-time.After(5 * time.Second):
+			t.Error("deliveries did not stop")
+		}
+	})
+}
+
+// attempts collects the recorded attempts until the delivery is no longer pending.
+func attempts(t *testing.T, updates <-chan domain.Delivery) []domain.Delivery {
+	t.Helper()
+	var res []domain.Delivery
+	for {
+		select {
+		case d := <-updates:
+			res = append(res, d)
+			if d.Status != domain.DeliveryPending {
+				return res
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("delivery was not finished in time")
+			return res
+		}
+	}
+}
+
+func TestSubscriptionsService_Subscribe(t *testing.T) {
+	t.Run("secret is generated", func(t *testing.T) {
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().CreateSubscription(mock.Anything, mock.MatchedBy(func(s domain.Subscription) bool {
+			return s.Id.IsValid() && len(s.Secret) == 64 && s.Url == "https://hooks.example.com"
+		})).RunAndReturn(func(_ context.Context, s domain.Subscription) (domain.Subscription, error) {
+			return s, nil
+		}).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		got, err := service.Subscribe(context.Background(), domain.Subscription{
+			Url:    "https://hooks.example.com",
+			Events: []domain.EventType{domain.EventPrCreated},
+		})
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, got.Secret)
+	})
+
+	t.Run("given secret is kept", func(t *testing.T) {
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().CreateSubscription(mock.Anything, mock.MatchedBy(func(s domain.Subscription) bool {
+			return s.Secret == "s3cret"
+		})).RunAndReturn(func(_ context.Context, s domain.Subscription) (domain.Subscription, error) {
+			return s, nil
+		}).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		_, err := service.Subscribe(context.Background(), domain.Subscription{
+			Url:    "https://hooks.example.com",
+			Secret: "s3cret",
+			Events: []domain.EventType{domain.EventPrMerged},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mocks.NewSubscriptionsRepository(t), nopLogger)
+		_, err := service.Subscribe(context.Background(), domain.Subscription{
+			Url:    "https://hooks.example.com",
+			Events: []domain.EventType{"pr.closed"},
+		})
+
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("public address", func(t *testing.T) {
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().CreateSubscription(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, s domain.Subscription) (domain.Subscription, error) {
+			return s, nil
+		}).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(guardedCfg, mockRepo, nopLogger)
+		_, err := service.Subscribe(context.Background(), domain.Subscription{
+			Url:    "https://93.184.215.14/hooks",
+			Events: []domain.EventType{domain.EventPrMerged},
+		})
+
+		require.NoError(t, err)
+	})
+
+	for _, url := range []string{
+		"http://127.0.0.1:8080/hooks",
+		"http://[::1]/hooks",
+		"http://10.0.0.5/hooks",
+		"http://169.254.169.254/latest/meta-data",
+		"http://0.0.0.0/hooks",
+		"http://[::ffff:192.168.1.1]/hooks",
+	} {
+		t.Run("internal address "+url, func(t *testing.T) {
+			service := servsubscriptions.NewSubscriptionsService(guardedCfg, mocks.NewSubscriptionsRepository(t), nopLogger)
+			_, err := service.Subscribe(context.Background(), domain.Subscription{
+				Url:    url,
+				Events: []domain.EventType{domain.EventPrMerged},
+			})
+
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().CreateSubscription(mock.Anything, mock.Anything).Return(domain.Subscription{}, errors.New("db down")).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		_, err := service.Subscribe(context.Background(), domain.Subscription{
+			Url:    "https://hooks.example.com",
+			Events: []domain.EventType{domain.EventPrMerged},
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInternal)
+	})
+}
+
+func TestSubscriptionsService_Unsubscribe(t *testing.T) {
+	mockRepo := mocks.NewSubscriptionsRepository(t)
+	mockRepo.EXPECT().DeleteSubscription(mock.Anything, domain.SubscriptionId("missing")).Return(domain.ErrNotFound).Once()
+
+	service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+
+	assert.ErrorIs(t, service.Unsubscribe(context.Background(), "missing"), domain.ErrNotFound)
+}
+
+func TestSubscriptionsService_Publish(t *testing.T) {
+	t.Run("pending delivery is logged for matching subscriptions", func(t *testing.T) {
+		rcv := newReceiver(t, "s3cret", http.StatusOK)
+		sub := rcv.subscription(domain.EventReviewerAssigned)
+		other := domain.Subscription{Id: "22222222-2222-2222-2222-222222222222", Url: rcv.URL, Events: []domain.EventType{domain.EventPrMerged}}
+		pr := domain.PullRequest{
+			Id:              "pr-1",
+			AuthorId:        "u1",
+			Team:            "backend",
+			AssignedReviews: domain.Members{{Id: "u2"}, {Id: "u3"}},
+		}
+		event := domain.NewReviewersAssignedEvents(pr)[0]
+
+		var logged domain.Delivery
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().ListSubscriptions(mock.Anything).Return([]domain.Subscription{sub, other}, nil).Once()
+		mockRepo.EXPECT().CreateDelivery(mock.Anything, mock.MatchedBy(func(d domain.Delivery) bool {
+			return d.SubscriptionId == sub.Id && d.EventId == event.Id && d.Status == domain.DeliveryPending && d.NextAttemptAt.IsZero()
+		})).RunAndReturn(func(_ context.Context, d domain.Delivery) (domain.Delivery, error) {
+			logged = d
+			return d, nil
+		}).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		service.Publish(context.Background(), event)
+
+		assert.Zero(t, rcv.calls.Load())
+
+		var payload struct {
+			Id   string `json:"id"`
+			Type string `json:"type"`
+			Data struct {
+				PullRequestID     string   `json:"pull_request_id"`
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(logged.Payload, &payload))
+		assert.Equal(t, string(event.Id), payload.Id)
+		assert.Equal(t, "reviewer.assigned", payload.Type)
+		assert.Equal(t, "pr-1", payload.Data.PullRequestID)
+		assert.Equal(t, []string{"u2", "u3"}, payload.Data.AssignedReviewers)
+	})
+
+	t.Run("errors are logged", func(t *testing.T) {
+		core, logs := observer.New(zap.ErrorLevel)
+
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().ListSubscriptions(mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, zap.New(core).Sugar())
+		service.Publish(context.Background(), domain.NewMemberDeactivatedEvent("u1"))
+
+		require.Equal(t, 1, logs.Len())
+		assert.Contains(t, logs.All()[0].Message, "failed to list subscriptions")
+	})
+
+	t.Run("no matching subscriptions", func(t *testing.T) {
+		rcv := newReceiver(t, "s3cret", http.StatusOK)
+
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().ListSubscriptions(mock.Anything).Return([]domain.Subscription{rcv.subscription(domain.EventPrCreated)}, nil).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		service.Publish(context.Background(), domain.NewPrMergedEvent(domain.PullRequest{Id: "pr-1"}))
+
+		assert.Zero(t, rcv.calls.Load())
+	})
+}
+
+func TestSubscriptionsService_RunDeliveries(t *testing.T) {
+	pending := func(sub domain.Subscription, payload []byte) domain.Delivery {
+		return domain.Delivery{
+			Id:             "44444444-4444-4444-4444-444444444444",
+			SubscriptionId: sub.Id,
+			EventId:        "event-1",
+			EventType:      domain.EventReviewerAssigned,
+			Payload:        payload,
+			Status:         domain.DeliveryPending,
+		}
+	}
+
+	t.Run("signed payload is retried until accepted", func(t *testing.T) {
+		rcv := newReceiver(t, "s3cret", http.StatusInternalServerError, http.StatusOK)
+		sub := rcv.subscription(domain.EventReviewerAssigned)
+		d := pending(sub, []byte(`{"id":"event-1"}`))
+
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		updates := deliveryLog(t, mockRepo, sub, d)
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		runDeliveries(t, service)
+		got := attempts(t, updates)
+
+		require.Len(t, got, 2)
+		assert.Equal(t, domain.DeliveryPending, got[0].Status)
+		assert.Equal(t, http.StatusInternalServerError, got[0].ResponseCode)
+		assert.False(t, got[0].NextAttemptAt.IsZero())
+		assert.Equal(t, domain.DeliverySucceeded, got[1].Status)
+		assert.Equal(t, 2, got[1].Attempts)
+		assert.Equal(t, http.StatusOK, got[1].ResponseCode)
+		assert.Empty(t, got[1].LastError)
+
+		req, body := <-rcv.requests, <-rcv.bodies
+		assert.Equal(t, `{"id":"event-1"}`, string(body))
+		assert.True(t, signature.VerifySHA256([]byte("s3cret"), body, req.Header.Get(servsubscriptions.HeaderSignature)))
+		assert.Equal(t, "reviewer.assigned", req.Header.Get(servsubscriptions.HeaderEventType))
+		assert.Equal(t, "event-1", req.Header.Get(servsubscriptions.HeaderEventId))
+		assert.Equal(t, string(d.Id), req.Header.Get(servsubscriptions.HeaderDeliveryId))
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	})
+
+	t.Run("delivery left pending by a previous run is resumed", func(t *testing.T) {
+		rcv := newReceiver(t, "s3cret", http.StatusOK)
+		sub := rcv.subscription(domain.EventReviewerAssigned)
+		d := pending(sub, []byte(`{}`))
+		d.Attempts = 2
+
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		updates := deliveryLog(t, mockRepo, sub, d)
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		runDeliveries(t, service)
+		got := attempts(t, updates)
+
+		require.Len(t, got, 1)
+		assert.Equal(t, domain.DeliverySucceeded, got[0].Status)
+		assert.Equal(t, 3, got[0].Attempts)
+	})
+
+	t.Run("attempts run out", func(t *testing.T) {
+		rcv := newReceiver(t, "s3cret", http.StatusServiceUnavailable)
+		sub := rcv.subscription(domain.EventReviewerAssigned)
+		core, logs := observer.New(zap.ErrorLevel)
+
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		updates := deliveryLog(t, mockRepo, sub, pending(sub, []byte(`{}`)))
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, zap.New(core).Sugar())
+		runDeliveries(t, service)
+		got := attempts(t, updates)
+
+		require.Len(t, got, 3)
+		assert.Equal(t, domain.DeliveryFailed, got[2].Status)
+		assert.Equal(t, http.StatusServiceUnavailable, got[2].ResponseCode)
+		assert.EqualValues(t, 3, rcv.calls.Load())
+		assert.Equal(t, 1, logs.FilterMessageSnippet("attempts ran out").Len())
+	})
+
+	t.Run("internal address is not dialed", func(t *testing.T) {
+		rcv := newReceiver(t, "s3cret", http.StatusOK)
+		sub := rcv.subscription(domain.EventReviewerAssigned)
+
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		updates := deliveryLog(t, mockRepo, sub, pending(sub, []byte(`{}`)))
+
+		service := servsubscriptions.NewSubscriptionsService(guardedCfg, mockRepo, nopLogger)
+		runDeliveries(t, service)
+		got := attempts(t, updates)
+
+		require.Len(t, got, 1)
+		assert.Equal(t, domain.DeliveryFailed, got[0].Status)
+		assert.Contains(t, got[0].LastError, "not public")
+		assert.Zero(t, rcv.calls.Load())
+	})
+}
+
+func TestSubscriptionsService_Redeliver(t *testing.T) {
+	t.Run("sent once as a new delivery", func(t *testing.T) {
+		rcv := newReceiver(t, "s3cret", http.StatusAccepted)
+		sub := rcv.subscription(domain.EventPrMerged)
+		orig := domain.Delivery{
+			Id:             "33333333-3333-3333-3333-333333333333",
+			SubscriptionId: sub.Id,
+			EventId:        "event-1",
+			EventType:      domain.EventPrMerged,
+			Payload:        []byte(`{"id":"event-1"}`),
+			Status:         domain.DeliveryFailed,
+			Attempts:       3,
+		}
+
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().GetDelivery(mock.Anything, orig.Id).Return(orig, nil).Once()
+		mockRepo.EXPECT().GetSubscription(mock.Anything, sub.Id).Return(sub, nil).Once()
+		mockRepo.EXPECT().CreateDelivery(mock.Anything, mock.MatchedBy(func(d domain.Delivery) bool {
+			return d.Id != orig.Id && d.EventId == orig.EventId && string(d.Payload) == string(orig.Payload) &&
+				d.NextAttemptAt.After(time.Now())
+		})).RunAndReturn(func(_ context.Context, d domain.Delivery) (domain.Delivery, error) {
+			return d, nil
+		}).Once()
+		mockRepo.EXPECT().UpdateDelivery(mock.Anything, mock.MatchedBy(func(d domain.Delivery) bool {
+			return d.Attempts == 1 && d.Status == domain.DeliverySucceeded
+		})).Return(nil).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		got, err := service.Redeliver(context.Background(), orig.Id)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.DeliverySucceeded, got.Status)
+		assert.Equal(t, http.StatusAccepted, got.ResponseCode)
+		assert.Equal(t, `{"id":"event-1"}`, string(<-rcv.bodies))
+	})
+
+	t.Run("failure is not retried", func(t *testing.T) {
+		rcv := newReceiver(t, "s3cret", http.StatusInternalServerError)
+		sub := rcv.subscription(domain.EventPrMerged)
+		orig := domain.Delivery{Id: "33333333-3333-3333-3333-333333333333", SubscriptionId: sub.Id, EventType: domain.EventPrMerged}
+
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().GetDelivery(mock.Anything, orig.Id).Return(orig, nil).Once()
+		mockRepo.EXPECT().GetSubscription(mock.Anything, sub.Id).Return(sub, nil).Once()
+		mockRepo.EXPECT().CreateDelivery(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, d domain.Delivery) (domain.Delivery, error) {
+			return d, nil
+		}).Once()
+		mockRepo.EXPECT().UpdateDelivery(mock.Anything, mock.Anything).Return(nil).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		got, err := service.Redeliver(context.Background(), orig.Id)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.DeliveryFailed, got.Status)
+		assert.Contains(t, got.LastError, "500")
+		assert.EqualValues(t, 1, rcv.calls.Load())
+	})
+
+	t.Run("unknown delivery", func(t *testing.T) {
+		mockRepo := mocks.NewSubscriptionsRepository(t)
+		mockRepo.EXPECT().GetDelivery(mock.Anything, domain.DeliveryId("missing")).Return(domain.Delivery{}, domain.ErrNotFound).Once()
+
+		service := servsubscriptions.NewSubscriptionsService(testCfg, mockRepo, nopLogger)
+		_, err := service.Redeliver(context.Background(), "missing")
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,122 @@
+package servteams
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/go-faster/errors"
+)
+
+type DeactivationTx interface {
+	DeactivateTeamMembers(context.Context, domain.TeamName) ([]domain.MemberId, error)
+	GetOpenAssignments(context.Context, []domain.MemberId) (domain.ReviewAssignments, error)
+	GetReplacementCandidates(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)
+	ReplaceReviewers(context.Context, []domain.Reassignment, domain.AssignmentAudit) error
+	Commit() error
+	Rollback() error
+}
+
+type ReviewerSelector interface {
+	Select(candidates domain.MembersHistories, n int) domain.MembersHistories
+}
+
+// DeactivateTeam deactivates the team members and moves their open reviews,
+// both are announced once the transaction is committed.
+func (ts *TeamsService) DeactivateTeam(ctx context.Context, tName domain.TeamName) (domain.DeactivationReport, error) {
+	report, err := ts.deactivateTeam(ctx, tName)
+	if err != nil {
+		return domain.DeactivationReport{}, err
+	}
+
+	ts.publish(ctx, report.Events()...)
+
+	return report, nil
+}
+
+func (ts *TeamsService) deactivateTeam(ctx context.Context, tName domain.TeamName) (_ domain.DeactivationReport, err error) {
+	tx, err := ts.repo.BeginDeactivationTx(ctx)
+	if err != nil {
+		return domain.DeactivationReport{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	defer func() {
+		if err == nil {
+			if errCommit := tx.Commit(); errCommit != nil {
+				err = fmt.Errorf("%w: %w", domain.ErrInternal, errCommit)
+			}
+			return
+		}
+		if errRollback := tx.Rollback(); errRollback != nil {
+			err = fmt.Errorf("%w: %w", err, errRollback)
+		}
+	}()
+
+	deactivated, err := tx.DeactivateTeamMembers(ctx, tName)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.DeactivationReport{}, domain.ErrNotFound
+		}
+		return domain.DeactivationReport{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	report := domain.DeactivationReport{
+		Team:        tName,
+		Deactivated: deactivated,
+		Reassigned:  []domain.Reassignment{},
+		Unfilled:    []domain.Reassignment{},
+	}
+	if len(deactivated) == 0 {
+		return report, nil
+	}
+
+	assignments, err := tx.GetOpenAssignments(ctx, deactivated)
+	if err != nil {
+		return domain.DeactivationReport{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	if assignments.Empty() {
+		return report, nil
+	}
+
+	report.Reassigned, report.Unfilled, err = ts.moveOpenReviews(ctx, tx, assignments, domain.SystemAudit(domain.AssignmentReasonTeamDeactivated))
+	if err != nil {
+		return domain.DeactivationReport{}, err
+	}
+
+	return report, nil
+}
+
+// reviewsMover is the part of a transaction that moves OPEN reviews to other members.
+type reviewsMover interface {
+	GetReplacementCandidates(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)
+	ReplaceReviewers(context.Context, []domain.Reassignment, domain.AssignmentAudit) error
+}
+
+// moveOpenReviews replaces the reviewers from the PR team, then from the fallback team.
+func (ts *TeamsService) moveOpenReviews(
+	ctx context.Context,
+	tx reviewsMover,
+	assignments domain.ReviewAssignments,
+	audit domain.AssignmentAudit,
+) (reassigned, unfilled []domain.Reassignment, err error) {
+	teams := assignments.Teams()
+	if ts.fallbackTeam != "" {
+		teams = append(teams, ts.fallbackTeam)
+	}
+
+	pools, err := tx.GetReplacementCandidates(ctx, teams)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	reassigned, unfilled = assignments.Plan(pools, ts.fallbackTeam, func(candidates domain.MembersHistories) domain.MembersHistories {
+		return ts.selector.Select(candidates, 1)
+	})
+
+	if len(reassigned) > 0 {
+		if err = tx.ReplaceReviewers(ctx, reassigned, audit); err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+	}
+
+	return reassigned, unfilled, nil
+}
@@ -0,0 +1,156 @@
+package servteams_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/teams/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func candidate(id domain.MemberId, load int, capacity domain.ReviewCapacity) domain.MemberHistory {
+	return domain.NewMemberHistory(id, domain.MemberStatusActive, domain.MemberRoleDefault, false).
+		WithLoad(load).
+		WithCapacity(capacity)
+}
+
+func firstCandidate(candidates domain.MembersHistories, n int) domain.MembersHistories {
+	if len(candidates) < n {
+		return candidates
+	}
+	return candidates[:n]
+}
+
+func TestTeamsService_DeactivateTeam(t *testing.T) {
+	ctx := context.Background()
+	team := domain.TeamName("backend")
+	deactivated := []domain.MemberId{"old-1", "old-2"}
+
+	tests := []struct {
+		name         string
+		fallback     string
+		txSetup      func(*mocks.DeactivationTx)
+		wantReassign []domain.Reassignment
+		wantUnfilled []domain.Reassignment
+		wantErr      error
+	}{
+		{
+			name: "reviewers replaced within PR team",
+			txSetup: func(tx *mocks.DeactivationTx) {
+				tx.EXPECT().DeactivateTeamMembers(ctx, team).Return(deactivated, nil)
+				tx.EXPECT().GetOpenAssignments(ctx, deactivated).Return(domain.ReviewAssignments{
+					{PrId: "pr-1", AuthorId: "author", Team: "platform", MemberId: "old-1", Participants: []domain.MemberId{"old-1", "old-2"}},
+					{PrId: "pr-1", AuthorId: "author", Team: "platform", MemberId: "old-2", Participants: []domain.MemberId{"old-1", "old-2"}},
+				}, nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{"platform"}).
+					Return(map[domain.TeamName]domain.MembersHistories{
+						"platform": {
+							candidate("author", 0, domain.UnlimitedReviewCapacity()),
+							candidate("new-1", 0, domain.NewReviewCapacity(1)),
+							candidate("new-2", 0, domain.NewReviewCapacity(1)),
+						},
+					}, nil)
+				tx.EXPECT().ReplaceReviewers(ctx, []domain.Reassignment{
+					{PrId: "pr-1", OldMemberId: "old-1", NewMemberId: "new-1"},
+					{PrId: "pr-1", OldMemberId: "old-2", NewMemberId: "new-2"},
+				}, domain.SystemAudit(domain.AssignmentReasonTeamDeactivated)).Return(nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{
+				{PrId: "pr-1", OldMemberId: "old-1", NewMemberId: "new-1"},
+				{PrId: "pr-1", OldMemberId: "old-2", NewMemberId: "new-2"},
+			},
+			wantUnfilled: []domain.Reassignment{},
+		},
+		{
+			name:     "fallback team used when PR team is exhausted",
+			fallback: "oncall",
+			txSetup: func(tx *mocks.DeactivationTx) {
+				tx.EXPECT().DeactivateTeamMembers(ctx, team).Return(deactivated, nil)
+				tx.EXPECT().GetOpenAssignments(ctx, deactivated).Return(domain.ReviewAssignments{
+					{PrId: "pr-1", AuthorId: "author", Team: team, MemberId: "old-1", Participants: []domain.MemberId{"old-1"}},
+					{PrId: "pr-2", AuthorId: "author", Team: team, MemberId: "old-2", Participants: []domain.MemberId{"old-2"}},
+				}, nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{team, "oncall"}).
+					Return(map[domain.TeamName]domain.MembersHistories{
+						"oncall": {candidate("oncall-1", 0, domain.NewReviewCapacity(1))},
+					}, nil)
+				tx.EXPECT().ReplaceReviewers(ctx, []domain.Reassignment{
+					{PrId: "pr-1", OldMemberId: "old-1", NewMemberId: "oncall-1"},
+				}, domain.SystemAudit(domain.AssignmentReasonTeamDeactivated)).Return(nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{
+				{PrId: "pr-1", OldMemberId: "old-1", NewMemberId: "oncall-1"},
+			},
+			wantUnfilled: []domain.Reassignment{
+				{PrId: "pr-2", OldMemberId: "old-2"},
+			},
+		},
+		{
+			name: "no open reviews",
+			txSetup: func(tx *mocks.DeactivationTx) {
+				tx.EXPECT().DeactivateTeamMembers(ctx, team).Return(deactivated, nil)
+				tx.EXPECT().GetOpenAssignments(ctx, deactivated).Return(domain.ReviewAssignments{}, nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{},
+			wantUnfilled: []domain.Reassignment{},
+		},
+		{
+			name: "team not found",
+			txSetup: func(tx *mocks.DeactivationTx) {
+				tx.EXPECT().DeactivateTeamMembers(ctx, team).Return(nil, domain.ErrNotFound)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "replace failure rolls back",
+			txSetup: func(tx *mocks.DeactivationTx) {
+				tx.EXPECT().DeactivateTeamMembers(ctx, team).Return(deactivated[:1], nil)
+				tx.EXPECT().GetOpenAssignments(ctx, deactivated[:1]).Return(domain.ReviewAssignments{
+					{PrId: "pr-1", AuthorId: "author", Team: team, MemberId: "old-1", Participants: []domain.MemberId{"old-1"}},
+				}, nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{team}).
+					Return(map[domain.TeamName]domain.MembersHistories{
+						team: {candidate("new-1", 0, domain.UnlimitedReviewCapacity())},
+					}, nil)
+				tx.EXPECT().ReplaceReviewers(ctx, mock.Anything, mock.Anything).Return(errors.New("database error"))
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			mockTx := mocks.NewDeactivationTx(t)
+			mockSelector := mocks.NewReviewerSelector(t)
+
+			mockRepo.EXPECT().BeginDeactivationTx(ctx).Return(mockTx, nil)
+			tt.txSetup(mockTx)
+			mockSelector.EXPECT().Select(mock.Anything, 1).RunAndReturn(firstCandidate).Maybe()
+
+			cfg := &configs.BussinesLogic{DeactivationFallbackTeam: tt.fallback}
+			service := servteams.NewTeamsService(cfg, mockRepo, mockSelector, nil)
+			got, err := service.DeactivateTeam(ctx, team)
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, team, got.Team)
+			assert.Equal(t, tt.wantReassign, got.Reassigned)
+			assert.Equal(t, tt.wantUnfilled, got.Unfilled)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package servteams_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/teams/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func eventOf(t domain.EventType, check func(domain.Event) bool) any {
+	return mock.MatchedBy(func(e domain.Event) bool {
+		return e.Type == t && check(e)
+	})
+}
+
+func TestTeamsService_DeactivateTeam_Events(t *testing.T) {
+	ctx := context.Background()
+	team := domain.TeamName("backend")
+
+	mockRepo := mocks.NewTeamsRepository(t)
+	mockTx := mocks.NewDeactivationTx(t)
+	mockSelector := mocks.NewReviewerSelector(t)
+	mockPub := mocks.NewEventPublisher(t)
+
+	mockRepo.EXPECT().BeginDeactivationTx(ctx).Return(mockTx, nil)
+	mockTx.EXPECT().DeactivateTeamMembers(ctx, team).Return([]domain.MemberId{"old-1", "old-2"}, nil)
+	mockTx.EXPECT().GetOpenAssignments(ctx, []domain.MemberId{"old-1", "old-2"}).Return(domain.ReviewAssignments{
+		{PrId: "pr-1", AuthorId: "author", Team: "platform", MemberId: "old-1", Participants: []domain.MemberId{"old-1"}},
+	}, nil)
+	mockTx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{"platform"}).
+		Return(map[domain.TeamName]domain.MembersHistories{"platform": {candidate("new-1", 0, domain.UnlimitedReviewCapacity())}}, nil)
+	mockSelector.EXPECT().Select(mock.Anything, 1).RunAndReturn(firstCandidate)
+	mockTx.EXPECT().ReplaceReviewers(ctx, mock.Anything, mock.Anything).Return(nil)
+	commit := mockTx.EXPECT().Commit().Return(nil).Call
+	mockPub.EXPECT().Publish(mock.Anything,
+		eventOf(domain.EventMemberDeactivated, func(e domain.Event) bool { return e.MemberId == "old-1" }),
+		eventOf(domain.EventMemberDeactivated, func(e domain.Event) bool { return e.MemberId == "old-2" }),
+		eventOf(domain.EventReviewerReassigned, func(e domain.Event) bool {
+			return e.PrId == "pr-1" && e.MemberId == "old-1" && len(e.Reviewers) == 1 && e.Reviewers[0] == "new-1"
+		}),
+	).Once().NotBefore(commit)
+
+	service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mockSelector, mockPub)
+	_, err := service.DeactivateTeam(ctx, team)
+
+	assert.NoError(t, err)
+}
+
+func TestTeamsService_DeactivateTeam_NoEventsWithoutChanges(t *testing.T) {
+	ctx := context.Background()
+	team := domain.TeamName("backend")
+
+	mockRepo := mocks.NewTeamsRepository(t)
+	mockTx := mocks.NewDeactivationTx(t)
+	mockPub := mocks.NewEventPublisher(t)
+
+	// every member of the team is already inactive
+	mockRepo.EXPECT().BeginDeactivationTx(ctx).Return(mockTx, nil)
+	mockTx.EXPECT().DeactivateTeamMembers(ctx, team).Return([]domain.MemberId{}, nil)
+	mockTx.EXPECT().Commit().Return(nil)
+
+	service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), mockPub)
+	report, err := service.DeactivateTeam(ctx, team)
+
+	assert.NoError(t, err)
+	assert.Empty(t, report.Deactivated)
+}
+
+func TestTeamsService_RemoveTeamMember_Events(t *testing.T) {
+	ctx := context.Background()
+	team := domain.TeamName("backend")
+	member := domain.MemberId("old-1")
+
+	tests := []struct {
+		name      string
+		policy    domain.OpenReviewsPolicy
+		announced bool
+	}{
+		{name: "reassigned reviews are announced", policy: domain.OpenReviewsReassign, announced: true},
+		{name: "kept reviews are not", policy: domain.OpenReviewsKeep},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			mockTx := mocks.NewMembershipTx(t)
+			mockSelector := mocks.NewReviewerSelector(t)
+			mockPub := mocks.NewEventPublisher(t)
+
+			mockRepo.EXPECT().BeginMembershipTx(ctx).Return(mockTx, nil)
+			mockTx.EXPECT().LockTeam(ctx, team).Return(nil)
+			mockTx.EXPECT().GetOpenTeamAssignments(ctx, team, []domain.MemberId{member}).Return(domain.ReviewAssignments{
+				{PrId: "pr-1", AuthorId: "author", Team: team, MemberId: member, Participants: []domain.MemberId{member}},
+			}, nil)
+			mockTx.EXPECT().RemoveTeamMembers(ctx, team, []domain.MemberId{member}).Return([]domain.MemberId{member}, nil)
+			mockTx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{team}).
+				Return(map[domain.TeamName]domain.MembersHistories{team: {candidate("rev-2", 0, domain.UnlimitedReviewCapacity())}}, nil).Maybe()
+			mockSelector.EXPECT().Select(mock.Anything, 1).RunAndReturn(firstCandidate).Maybe()
+			mockTx.EXPECT().ReplaceReviewers(ctx, mock.Anything, mock.Anything).Return(nil).Maybe()
+			mockTx.EXPECT().Commit().Return(nil)
+			if tt.announced {
+				mockPub.EXPECT().Publish(mock.Anything, eventOf(domain.EventReviewerReassigned, func(e domain.Event) bool {
+					return e.PrId == "pr-1" && e.Reviewers[0] == "rev-2"
+				})).Once()
+			}
+
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mockSelector, mockPub)
+			_, err := service.RemoveTeamMember(ctx, team, member, tt.policy, domain.AssignmentAudit{})
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTeamsService_ReconcileTeams_Events(t *testing.T) {
+	ctx := context.Background()
+
+	aliceId := domain.MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001")
+	bobId := domain.MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0002")
+	alice := domain.MemberBuilder(aliceId).Name("Alice").Status(domain.MemberStatusActive).Build()
+	bob := domain.MemberBuilder(bobId).Name("Bob").Status(domain.MemberStatusActive).Build()
+	inactiveBob := domain.MemberBuilder(bobId).Name("Bob").Status(domain.MemberStatusInactive).Build()
+
+	// Bob is deactivated and reviews PRs of backend and of a team outside the spec
+	spec := domain.OrgSpec{Teams: []domain.Team{domain.NewTeam("backend", alice, inactiveBob)}}
+	open := domain.ReviewAssignments{
+		{PrId: "pr-1", AuthorId: "author", Team: "backend", MemberId: bobId, Participants: []domain.MemberId{bobId}},
+		{PrId: "pr-2", AuthorId: "author", Team: "platform", MemberId: bobId, Participants: []domain.MemberId{bobId}},
+	}
+
+	tests := []struct {
+		name           string
+		apply          bool
+		setup          func(*mocks.ReconcileTx, *mocks.ReviewerSelector, *mocks.EventPublisher)
+		wantApplied    bool
+		wantReassigned []domain.Reassignment
+	}{
+		{
+			name:  "dry run reports the reviews only",
+			apply: false,
+			setup: func(tx *mocks.ReconcileTx, _ *mocks.ReviewerSelector, _ *mocks.EventPublisher) {
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantReassigned: []domain.Reassignment{},
+		},
+		{
+			name:  "apply moves the reviews and announces them",
+			apply: true,
+			setup: func(tx *mocks.ReconcileTx, selector *mocks.ReviewerSelector, pub *mocks.EventPublisher) {
+				tx.EXPECT().UpsertMembers(ctx, domain.Members{alice, inactiveBob}).Return(nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{"backend", "platform"}).
+					Return(map[domain.TeamName]domain.MembersHistories{
+						"backend":  {candidate(aliceId, 0, domain.UnlimitedReviewCapacity())},
+						"platform": {},
+					}, nil)
+				selector.EXPECT().Select(mock.Anything, 1).RunAndReturn(firstCandidate)
+				tx.EXPECT().ReplaceReviewers(ctx,
+					[]domain.Reassignment{{PrId: "pr-1", OldMemberId: bobId, NewMemberId: aliceId}},
+					domain.SystemAudit(domain.AssignmentReasonMemberDeactivated),
+				).Return(nil)
+				commit := tx.EXPECT().Commit().Return(nil).Call
+				pub.EXPECT().Publish(mock.Anything,
+					eventOf(domain.EventMemberDeactivated, func(e domain.Event) bool { return e.MemberId == bobId }),
+					eventOf(domain.EventReviewerReassigned, func(e domain.Event) bool {
+						return e.PrId == "pr-1" && e.MemberId == bobId && len(e.Reviewers) == 1 && e.Reviewers[0] == aliceId
+					}),
+				).Once().NotBefore(commit)
+			},
+			wantApplied:    true,
+			wantReassigned: []domain.Reassignment{{PrId: "pr-1", OldMemberId: bobId, NewMemberId: aliceId}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			mockTx := mocks.NewReconcileTx(t)
+			mockSelector := mocks.NewReviewerSelector(t)
+			mockPub := mocks.NewEventPublisher(t)
+
+			mockRepo.EXPECT().BeginReconcileTx(ctx).Return(mockTx, nil)
+			mockTx.EXPECT().LockTeams(ctx, []domain.TeamName{"backend"}).Return([]domain.Team{domain.NewTeam("backend", alice, bob)}, nil)
+			mockTx.EXPECT().GetMembers(ctx, []domain.MemberId{aliceId, bobId}).Return(domain.Members{alice, bob}, nil)
+			mockTx.EXPECT().GetOpenAssignments(ctx, []domain.MemberId{bobId}).Return(open, nil)
+			tt.setup(mockTx, mockSelector, mockPub)
+
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mockSelector, mockPub)
+			plan, err := service.ReconcileTeams(ctx, spec, tt.apply)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantApplied, plan.Applied)
+			assert.Equal(t, []domain.MemberId{bobId}, plan.Deactivate)
+			assert.Equal(t, open, plan.Affected)
+			assert.Equal(t, tt.wantReassigned, plan.Reassigned)
+		})
+	}
+}
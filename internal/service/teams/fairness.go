@@ -0,0 +1,31 @@
+package servteams
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/go-faster/errors"
+)
+
+// TeamFairness reports review load of active team members over the window, zero window means the configured one.
+func (ts *TeamsService) TeamFairness(ctx context.Context, tName domain.TeamName, window time.Duration) (domain.FairnessReport, error) {
+	if window < 0 {
+		return domain.FairnessReport{}, domain.ErrValidation
+	}
+	if window == 0 {
+		window = ts.fairnessWindow
+	}
+	since := time.Now().Add(-window)
+
+	loads, err := ts.repo.GetTeamReviewLoads(ctx, tName, since)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.FairnessReport{}, domain.ErrNotFound
+		}
+		return domain.FairnessReport{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return domain.NewFairnessReport(tName, since, loads, ts.fairnessTolerance), nil
+}
@@ -0,0 +1,99 @@
+package servteams_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/teams/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTeamsService_TeamFairness(t *testing.T) {
+	ctx := context.Background()
+	team := domain.TeamName("backend")
+	cfg := &configs.BussinesLogic{FairnessWindowDays: 30, FairnessTolerance: 0.5}
+
+	sinceWithin := func(window time.Duration) any {
+		return mock.MatchedBy(func(since time.Time) bool {
+			d := time.Since(since)
+			return d >= window && d < window+time.Minute
+		})
+	}
+
+	tests := []struct {
+		name      string
+		window    time.Duration
+		repoSetup func(*mocks.TeamsRepository)
+		wantFlags []domain.LoadFlag
+		wantErr   error
+	}{
+		{
+			name: "configured window",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().GetTeamReviewLoads(ctx, team, sinceWithin(30*24*time.Hour)).Return([]domain.MemberLoad{
+					{MemberId: "u1", Load: 1},
+					{MemberId: "u2", Load: 4},
+					{MemberId: "u3", Load: 7},
+				}, nil)
+			},
+			wantFlags: []domain.LoadFlag{domain.LoadFlagUnder, domain.LoadFlagNone, domain.LoadFlagOver},
+		},
+		{
+			name:   "requested window",
+			window: 7 * 24 * time.Hour,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().GetTeamReviewLoads(ctx, team, sinceWithin(7*24*time.Hour)).Return([]domain.MemberLoad{}, nil)
+			},
+			wantFlags: []domain.LoadFlag{},
+		},
+		{
+			name:      "negative window",
+			window:    -time.Hour,
+			repoSetup: func(*mocks.TeamsRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name: "team not found",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().GetTeamReviewLoads(ctx, team, mock.Anything).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "repository failure",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().GetTeamReviewLoads(ctx, team, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servteams.NewTeamsService(cfg, mockRepo, mocks.NewReviewerSelector(t), nil)
+			got, err := service.TeamFairness(ctx, team, tt.window)
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, team, got.Team)
+			flags := make([]domain.LoadFlag, 0, len(got.Members))
+			for _, m := range got.Members {
+				flags = append(flags, m.Flag)
+			}
+			assert.Equal(t, tt.wantFlags, flags)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package servteams
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/go-faster/errors"
+)
+
+type MembershipTx interface {
+	LockTeam(context.Context, domain.TeamName) error
+	GetTeamMemberIds(context.Context, domain.TeamName) ([]domain.MemberId, error)
+	GetOpenTeamAssignments(context.Context, domain.TeamName, []domain.MemberId) (domain.ReviewAssignments, error)
+	RemoveTeamMembers(context.Context, domain.TeamName, []domain.MemberId) ([]domain.MemberId, error)
+	DeleteTeam(context.Context, domain.TeamName) error
+	GetReplacementCandidates(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)
+	ReplaceReviewers(context.Context, []domain.Reassignment, domain.AssignmentAudit) error
+	Commit() error
+	Rollback() error
+}
+
+func (ts *TeamsService) AddTeamMembers(tName domain.TeamName, members domain.Members) (domain.Team, error) {
+	if members.Empty() {
+		return domain.Team{}, domain.ErrValidation
+	}
+
+	team, err := ts.repo.AddTeamMembers(tName, members)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Team{}, domain.ErrNotFound
+		}
+		return domain.Team{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return team, nil
+}
+
+func (ts *TeamsService) RenameTeam(tName, newName domain.TeamName) (domain.Team, error) {
+	if newName == "" {
+		return domain.Team{}, domain.ErrValidation
+	}
+
+	team, err := ts.repo.RenameTeam(tName, newName)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Team{}, domain.ErrNotFound
+		}
+		if errors.Is(err, domain.ErrDuplicate) {
+			return domain.Team{}, domain.ErrDuplicate
+		}
+		return domain.Team{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return team, nil
+}
+
+// RemoveTeamMember takes the member out of the team, the policy handles its OPEN reviews in PRs of the team.
+func (ts *TeamsService) RemoveTeamMember(
+	ctx context.Context, tName domain.TeamName, id domain.MemberId, policy domain.OpenReviewsPolicy, audit domain.AssignmentAudit,
+) (domain.TeamRemovalReport, error) {
+	return ts.removeMembers(ctx, tName, policy, audit.WithDefaults(domain.ActorAnonymous, domain.AssignmentReasonMemberRemoved),
+		func(tx MembershipTx) ([]domain.MemberId, error) {
+			return []domain.MemberId{id}, nil
+		},
+		func(tx MembershipTx) error {
+			removed, err := tx.RemoveTeamMembers(ctx, tName, []domain.MemberId{id})
+			if err != nil {
+				return err
+			}
+			if len(removed) == 0 {
+				return domain.ErrNotFound
+			}
+			return nil
+		},
+	)
+}
+
+// DeleteTeam removes the team with all memberships, PRs of the team fall back to the author's primary team.
+// Reassigned reviews are picked from the fallback team only, as the team itself is gone.
+func (ts *TeamsService) DeleteTeam(
+	ctx context.Context, tName domain.TeamName, policy domain.OpenReviewsPolicy, audit domain.AssignmentAudit,
+) (domain.TeamRemovalReport, error) {
+	return ts.removeMembers(ctx, tName, policy, audit.WithDefaults(domain.ActorAnonymous, domain.AssignmentReasonTeamDeleted),
+		func(tx MembershipTx) ([]domain.MemberId, error) {
+			return tx.GetTeamMemberIds(ctx, tName)
+		},
+		func(tx MembershipTx) error {
+			return tx.DeleteTeam(ctx, tName)
+		},
+	)
+}
+
+// removeMembers announces the reassigned reviews once the removal is committed.
+func (ts *TeamsService) removeMembers(
+	ctx context.Context,
+	tName domain.TeamName,
+	policy domain.OpenReviewsPolicy,
+	audit domain.AssignmentAudit,
+	leaving func(MembershipTx) ([]domain.MemberId, error),
+	remove func(MembershipTx) error,
+) (domain.TeamRemovalReport, error) {
+	report, err := ts.applyRemoval(ctx, tName, policy, audit, leaving, remove)
+	if err != nil {
+		return domain.TeamRemovalReport{}, err
+	}
+
+	ts.publish(ctx, domain.NewReviewerReassignedEvents(report.Reassigned)...)
+
+	return report, nil
+}
+
+func (ts *TeamsService) applyRemoval(
+	ctx context.Context,
+	tName domain.TeamName,
+	policy domain.OpenReviewsPolicy,
+	audit domain.AssignmentAudit,
+	leaving func(MembershipTx) ([]domain.MemberId, error),
+	remove func(MembershipTx) error,
+) (_ domain.TeamRemovalReport, err error) {
+	if !policy.Valid() {
+		return domain.TeamRemovalReport{}, domain.ErrValidation
+	}
+
+	tx, err := ts.repo.BeginMembershipTx(ctx)
+	if err != nil {
+		return domain.TeamRemovalReport{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	defer func() {
+		if err == nil {
+			if errCommit := tx.Commit(); errCommit != nil {
+				err = fmt.Errorf("%w: %w", domain.ErrInternal, errCommit)
+			}
+			return
+		}
+		if errRollback := tx.Rollback(); errRollback != nil {
+			err = fmt.Errorf("%w: %w", err, errRollback)
+		}
+	}()
+
+	if err = tx.LockTeam(ctx, tName); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.TeamRemovalReport{}, domain.ErrNotFound
+		}
+		return domain.TeamRemovalReport{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	members, err := leaving(tx)
+	if err != nil {
+		return domain.TeamRemovalReport{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	assignments := domain.ReviewAssignments{}
+	if len(members) > 0 {
+		assignments, err = tx.GetOpenTeamAssignments(ctx, tName, members)
+		if err != nil {
+			return domain.TeamRemovalReport{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+	}
+	if policy == domain.OpenReviewsReject && !assignments.Empty() {
+		return domain.TeamRemovalReport{}, domain.ErrOpenReviews
+	}
+
+	if err = remove(tx); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.TeamRemovalReport{}, domain.ErrNotFound
+		}
+		return domain.TeamRemovalReport{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	report := domain.TeamRemovalReport{
+		Team:       tName,
+		Removed:    members,
+		Reassigned: []domain.Reassignment{},
+		Unfilled:   assignments.Unfilled(),
+	}
+	if policy != domain.OpenReviewsReassign || assignments.Empty() {
+		return report, nil
+	}
+
+	report.Reassigned, report.Unfilled, err = ts.moveOpenReviews(ctx, tx, assignments, audit)
+	if err != nil {
+		return domain.TeamRemovalReport{}, err
+	}
+
+	return report, nil
+}
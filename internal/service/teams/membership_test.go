@@ -0,0 +1,390 @@
+package servteams_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/teams/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTeamsService_AddTeamMembers(t *testing.T) {
+	members := domain.Members{domain.MemberBuilder("user-1").Name("Alice").Status(domain.MemberStatusActive).Build()}
+
+	tests := []struct {
+		name      string
+		members   domain.Members
+		repoSetup func(*mocks.TeamsRepository)
+		wantErr   error
+	}{
+		{
+			name:    "members added",
+			members: members,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().AddTeamMembers(domain.TeamName("backend"), members).
+					Return(domain.NewTeam("backend", members...), nil)
+			},
+		},
+		{
+			name:      "no members",
+			members:   domain.Members{},
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:    "team not found",
+			members: members,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().AddTeamMembers(domain.TeamName("backend"), members).Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:    "internal error",
+			members: members,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().AddTeamMembers(domain.TeamName("backend"), members).Return(domain.Team{}, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), nil)
+			got, err := service.AddTeamMembers("backend", tt.members)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, members, got.Members)
+		})
+	}
+}
+
+func TestTeamsService_RenameTeam(t *testing.T) {
+	tests := []struct {
+		name      string
+		newName   domain.TeamName
+		repoSetup func(*mocks.TeamsRepository)
+		wantErr   error
+	}{
+		{
+			name:    "renamed",
+			newName: "platform",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().RenameTeam(domain.TeamName("backend"), domain.TeamName("platform")).
+					Return(domain.NewTeam("platform"), nil)
+			},
+		},
+		{
+			name:      "empty name",
+			newName:   "",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:    "name taken",
+			newName: "platform",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().RenameTeam(domain.TeamName("backend"), domain.TeamName("platform")).
+					Return(domain.Team{}, domain.ErrDuplicate)
+			},
+			wantErr: domain.ErrDuplicate,
+		},
+		{
+			name:    "team not found",
+			newName: "platform",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().RenameTeam(domain.TeamName("backend"), domain.TeamName("platform")).
+					Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), nil)
+			got, err := service.RenameTeam("backend", tt.newName)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.newName, got.Name)
+		})
+	}
+}
+
+func TestTeamsService_RemoveTeamMember(t *testing.T) {
+	ctx := context.Background()
+	team := domain.TeamName("backend")
+	member := domain.MemberId("old-1")
+	audit := domain.AssignmentAudit{Actor: domain.ActorAnonymous, Reason: domain.AssignmentReasonMemberRemoved}
+	assignments := domain.ReviewAssignments{
+		{PrId: "pr-1", AuthorId: "author", Team: team, MemberId: member, Participants: []domain.MemberId{member}},
+		{PrId: "pr-2", AuthorId: "author", Team: team, MemberId: member, Participants: []domain.MemberId{member, "rev-2"}},
+	}
+
+	tests := []struct {
+		name         string
+		policy       domain.OpenReviewsPolicy
+		fallback     string
+		txSetup      func(*mocks.MembershipTx)
+		wantReassign []domain.Reassignment
+		wantUnfilled []domain.Reassignment
+		wantErr      error
+	}{
+		{
+			name:   "keep leaves reviews assigned",
+			policy: domain.OpenReviewsKeep,
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetOpenTeamAssignments(ctx, team, []domain.MemberId{member}).Return(assignments, nil)
+				tx.EXPECT().RemoveTeamMembers(ctx, team, []domain.MemberId{member}).Return([]domain.MemberId{member}, nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{},
+			wantUnfilled: []domain.Reassignment{
+				{PrId: "pr-1", OldMemberId: member},
+				{PrId: "pr-2", OldMemberId: member},
+			},
+		},
+		{
+			name:     "reassign replaces from team then fallback",
+			policy:   domain.OpenReviewsReassign,
+			fallback: "oncall",
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetOpenTeamAssignments(ctx, team, []domain.MemberId{member}).Return(assignments, nil)
+				tx.EXPECT().RemoveTeamMembers(ctx, team, []domain.MemberId{member}).Return([]domain.MemberId{member}, nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{team, "oncall"}).
+					Return(map[domain.TeamName]domain.MembersHistories{
+						team:     {candidate("rev-2", 0, domain.NewReviewCapacity(1))},
+						"oncall": {candidate("oncall-1", 0, domain.UnlimitedReviewCapacity())},
+					}, nil)
+				tx.EXPECT().ReplaceReviewers(ctx, []domain.Reassignment{
+					{PrId: "pr-1", OldMemberId: member, NewMemberId: "rev-2"},
+					{PrId: "pr-2", OldMemberId: member, NewMemberId: "oncall-1"},
+				}, audit).Return(nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{
+				{PrId: "pr-1", OldMemberId: member, NewMemberId: "rev-2"},
+				{PrId: "pr-2", OldMemberId: member, NewMemberId: "oncall-1"},
+			},
+			wantUnfilled: []domain.Reassignment{},
+		},
+		{
+			name:   "reject with open reviews",
+			policy: domain.OpenReviewsReject,
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetOpenTeamAssignments(ctx, team, []domain.MemberId{member}).Return(assignments, nil)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrOpenReviews,
+		},
+		{
+			name:   "reject without open reviews",
+			policy: domain.OpenReviewsReject,
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetOpenTeamAssignments(ctx, team, []domain.MemberId{member}).Return(domain.ReviewAssignments{}, nil)
+				tx.EXPECT().RemoveTeamMembers(ctx, team, []domain.MemberId{member}).Return([]domain.MemberId{member}, nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{},
+			wantUnfilled: []domain.Reassignment{},
+		},
+		{
+			name:   "member not in team",
+			policy: domain.OpenReviewsKeep,
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetOpenTeamAssignments(ctx, team, []domain.MemberId{member}).Return(domain.ReviewAssignments{}, nil)
+				tx.EXPECT().RemoveTeamMembers(ctx, team, []domain.MemberId{member}).Return([]domain.MemberId{}, nil)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:   "team not found",
+			policy: domain.OpenReviewsKeep,
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(domain.ErrNotFound)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:   "replace failure rolls back",
+			policy: domain.OpenReviewsReassign,
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetOpenTeamAssignments(ctx, team, []domain.MemberId{member}).Return(assignments[:1], nil)
+				tx.EXPECT().RemoveTeamMembers(ctx, team, []domain.MemberId{member}).Return([]domain.MemberId{member}, nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{team}).
+					Return(map[domain.TeamName]domain.MembersHistories{
+						team: {candidate("rev-2", 0, domain.UnlimitedReviewCapacity())},
+					}, nil)
+				tx.EXPECT().ReplaceReviewers(ctx, mock.Anything, mock.Anything).Return(errors.New("database error"))
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			mockTx := mocks.NewMembershipTx(t)
+			mockSelector := mocks.NewReviewerSelector(t)
+
+			mockRepo.EXPECT().BeginMembershipTx(ctx).Return(mockTx, nil)
+			tt.txSetup(mockTx)
+			mockSelector.EXPECT().Select(mock.Anything, 1).RunAndReturn(firstCandidate).Maybe()
+
+			cfg := &configs.BussinesLogic{DeactivationFallbackTeam: tt.fallback}
+			service := servteams.NewTeamsService(cfg, mockRepo, mockSelector, nil)
+			got, err := service.RemoveTeamMember(ctx, team, member, tt.policy, domain.AssignmentAudit{})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.MemberId{member}, got.Removed)
+			assert.Equal(t, tt.wantReassign, got.Reassigned)
+			assert.Equal(t, tt.wantUnfilled, got.Unfilled)
+		})
+	}
+}
+
+func TestTeamsService_DeleteTeam(t *testing.T) {
+	ctx := context.Background()
+	team := domain.TeamName("backend")
+	members := []domain.MemberId{"old-1", "old-2"}
+	audit := domain.AssignmentAudit{Actor: "alice", Reason: domain.AssignmentReasonTeamDeleted}
+	assignments := domain.ReviewAssignments{
+		{PrId: "pr-1", AuthorId: "author", Team: team, MemberId: "old-1", Participants: []domain.MemberId{"old-1"}},
+	}
+
+	tests := []struct {
+		name         string
+		policy       domain.OpenReviewsPolicy
+		fallback     string
+		txSetup      func(*mocks.MembershipTx)
+		wantReassign []domain.Reassignment
+		wantUnfilled []domain.Reassignment
+		wantErr      error
+	}{
+		{
+			name:     "reassign picks from fallback team",
+			policy:   domain.OpenReviewsReassign,
+			fallback: "oncall",
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetTeamMemberIds(ctx, team).Return(members, nil)
+				tx.EXPECT().GetOpenTeamAssignments(ctx, team, members).Return(assignments, nil)
+				tx.EXPECT().DeleteTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetReplacementCandidates(ctx, []domain.TeamName{team, "oncall"}).
+					Return(map[domain.TeamName]domain.MembersHistories{
+						"oncall": {candidate("oncall-1", 0, domain.UnlimitedReviewCapacity())},
+					}, nil)
+				tx.EXPECT().ReplaceReviewers(ctx, []domain.Reassignment{
+					{PrId: "pr-1", OldMemberId: "old-1", NewMemberId: "oncall-1"},
+				}, audit).Return(nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{
+				{PrId: "pr-1", OldMemberId: "old-1", NewMemberId: "oncall-1"},
+			},
+			wantUnfilled: []domain.Reassignment{},
+		},
+		{
+			name:   "keep leaves reviews assigned",
+			policy: domain.OpenReviewsKeep,
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetTeamMemberIds(ctx, team).Return(members, nil)
+				tx.EXPECT().GetOpenTeamAssignments(ctx, team, members).Return(assignments, nil)
+				tx.EXPECT().DeleteTeam(ctx, team).Return(nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{},
+			wantUnfilled: []domain.Reassignment{{PrId: "pr-1", OldMemberId: "old-1"}},
+		},
+		{
+			name:   "reject with open reviews",
+			policy: domain.OpenReviewsReject,
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetTeamMemberIds(ctx, team).Return(members, nil)
+				tx.EXPECT().GetOpenTeamAssignments(ctx, team, members).Return(assignments, nil)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrOpenReviews,
+		},
+		{
+			name:   "empty team",
+			policy: domain.OpenReviewsReject,
+			txSetup: func(tx *mocks.MembershipTx) {
+				tx.EXPECT().LockTeam(ctx, team).Return(nil)
+				tx.EXPECT().GetTeamMemberIds(ctx, team).Return([]domain.MemberId{}, nil)
+				tx.EXPECT().DeleteTeam(ctx, team).Return(nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantReassign: []domain.Reassignment{},
+			wantUnfilled: []domain.Reassignment{},
+		},
+		{
+			name:    "unknown policy",
+			policy:  "drop",
+			txSetup: func(tx *mocks.MembershipTx) {},
+			wantErr: domain.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			mockTx := mocks.NewMembershipTx(t)
+			mockSelector := mocks.NewReviewerSelector(t)
+
+			mockRepo.EXPECT().BeginMembershipTx(ctx).Return(mockTx, nil).Maybe()
+			tt.txSetup(mockTx)
+			mockSelector.EXPECT().Select(mock.Anything, 1).RunAndReturn(firstCandidate).Maybe()
+
+			cfg := &configs.BussinesLogic{DeactivationFallbackTeam: tt.fallback}
+			service := servteams.NewTeamsService(cfg, mockRepo, mockSelector, nil)
+			got, err := service.DeleteTeam(ctx, team, tt.policy, domain.AssignmentAudit{Actor: "alice"})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, team, got.Team)
+			assert.Equal(t, tt.wantReassign, got.Reassigned)
+			assert.Equal(t, tt.wantUnfilled, got.Unfilled)
+		})
+	}
+}
@@ -0,0 +1,352 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DeactivationTx is an autogenerated mock type for the DeactivationTx type
+type DeactivationTx struct {
+	mock.Mock
+}
+
+type DeactivationTx_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeactivationTx) EXPECT() *DeactivationTx_Expecter {
+	return &DeactivationTx_Expecter{mock: &_m.Mock}
+}
+
+// Commit provides a mock function with no fields
+func (_m *DeactivationTx) Commit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeactivationTx_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type DeactivationTx_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+func (_e *DeactivationTx_Expecter) Commit() *DeactivationTx_Commit_Call {
+	return &DeactivationTx_Commit_Call{Call: _e.mock.On("Commit")}
+}
+
+func (_c *DeactivationTx_Commit_Call) Run(run func()) *DeactivationTx_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DeactivationTx_Commit_Call) Return(_a0 error) *DeactivationTx_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeactivationTx_Commit_Call) RunAndReturn(run func() error) *DeactivationTx_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateTeamMembers provides a mock function with given fields: _a0, _a1
+func (_m *DeactivationTx) DeactivateTeamMembers(_a0 context.Context, _a1 domain.TeamName) ([]domain.MemberId, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateTeamMembers")
+	}
+
+	var r0 []domain.MemberId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName) ([]domain.MemberId, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName) []domain.MemberId); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MemberId)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeactivationTx_DeactivateTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateTeamMembers'
+type DeactivationTx_DeactivateTeamMembers_Call struct {
+	*mock.Call
+}
+
+// DeactivateTeamMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+func (_e *DeactivationTx_Expecter) DeactivateTeamMembers(_a0 interface{}, _a1 interface{}) *DeactivationTx_DeactivateTeamMembers_Call {
+	return &DeactivationTx_DeactivateTeamMembers_Call{Call: _e.mock.On("DeactivateTeamMembers", _a0, _a1)}
+}
+
+func (_c *DeactivationTx_DeactivateTeamMembers_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName)) *DeactivationTx_DeactivateTeamMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *DeactivationTx_DeactivateTeamMembers_Call) Return(_a0 []domain.MemberId, _a1 error) *DeactivationTx_DeactivateTeamMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeactivationTx_DeactivateTeamMembers_Call) RunAndReturn(run func(context.Context, domain.TeamName) ([]domain.MemberId, error)) *DeactivationTx_DeactivateTeamMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenAssignments provides a mock function with given fields: _a0, _a1
+func (_m *DeactivationTx) GetOpenAssignments(_a0 context.Context, _a1 []domain.MemberId) (domain.ReviewAssignments, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenAssignments")
+	}
+
+	var r0 domain.ReviewAssignments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.MemberId) (domain.ReviewAssignments, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.MemberId) domain.ReviewAssignments); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.ReviewAssignments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeactivationTx_GetOpenAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenAssignments'
+type DeactivationTx_GetOpenAssignments_Call struct {
+	*mock.Call
+}
+
+// GetOpenAssignments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.MemberId
+func (_e *DeactivationTx_Expecter) GetOpenAssignments(_a0 interface{}, _a1 interface{}) *DeactivationTx_GetOpenAssignments_Call {
+	return &DeactivationTx_GetOpenAssignments_Call{Call: _e.mock.On("GetOpenAssignments", _a0, _a1)}
+}
+
+func (_c *DeactivationTx_GetOpenAssignments_Call) Run(run func(_a0 context.Context, _a1 []domain.MemberId)) *DeactivationTx_GetOpenAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *DeactivationTx_GetOpenAssignments_Call) Return(_a0 domain.ReviewAssignments, _a1 error) *DeactivationTx_GetOpenAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeactivationTx_GetOpenAssignments_Call) RunAndReturn(run func(context.Context, []domain.MemberId) (domain.ReviewAssignments, error)) *DeactivationTx_GetOpenAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplacementCandidates provides a mock function with given fields: _a0, _a1
+func (_m *DeactivationTx) GetReplacementCandidates(_a0 context.Context, _a1 []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplacementCandidates")
+	}
+
+	var r0 map[domain.TeamName]domain.MembersHistories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) map[domain.TeamName]domain.MembersHistories); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.TeamName]domain.MembersHistories)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.TeamName) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeactivationTx_GetReplacementCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplacementCandidates'
+type DeactivationTx_GetReplacementCandidates_Call struct {
+	*mock.Call
+}
+
+// GetReplacementCandidates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.TeamName
+func (_e *DeactivationTx_Expecter) GetReplacementCandidates(_a0 interface{}, _a1 interface{}) *DeactivationTx_GetReplacementCandidates_Call {
+	return &DeactivationTx_GetReplacementCandidates_Call{Call: _e.mock.On("GetReplacementCandidates", _a0, _a1)}
+}
+
+func (_c *DeactivationTx_GetReplacementCandidates_Call) Run(run func(_a0 context.Context, _a1 []domain.TeamName)) *DeactivationTx_GetReplacementCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *DeactivationTx_GetReplacementCandidates_Call) Return(_a0 map[domain.TeamName]domain.MembersHistories, _a1 error) *DeactivationTx_GetReplacementCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeactivationTx_GetReplacementCandidates_Call) RunAndReturn(run func(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)) *DeactivationTx_GetReplacementCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceReviewers provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DeactivationTx) ReplaceReviewers(_a0 context.Context, _a1 []domain.Reassignment, _a2 domain.AssignmentAudit) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Reassignment, domain.AssignmentAudit) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeactivationTx_ReplaceReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceReviewers'
+type DeactivationTx_ReplaceReviewers_Call struct {
+	*mock.Call
+}
+
+// ReplaceReviewers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.Reassignment
+//   - _a2 domain.AssignmentAudit
+func (_e *DeactivationTx_Expecter) ReplaceReviewers(_a0 interface{}, _a1 interface{}, _a2 interface{}) *DeactivationTx_ReplaceReviewers_Call {
+	return &DeactivationTx_ReplaceReviewers_Call{Call: _e.mock.On("ReplaceReviewers", _a0, _a1, _a2)}
+}
+
+func (_c *DeactivationTx_ReplaceReviewers_Call) Run(run func(_a0 context.Context, _a1 []domain.Reassignment, _a2 domain.AssignmentAudit)) *DeactivationTx_ReplaceReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.Reassignment), args[2].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *DeactivationTx_ReplaceReviewers_Call) Return(_a0 error) *DeactivationTx_ReplaceReviewers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeactivationTx_ReplaceReviewers_Call) RunAndReturn(run func(context.Context, []domain.Reassignment, domain.AssignmentAudit) error) *DeactivationTx_ReplaceReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with no fields
+func (_m *DeactivationTx) Rollback() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeactivationTx_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type DeactivationTx_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+func (_e *DeactivationTx_Expecter) Rollback() *DeactivationTx_Rollback_Call {
+	return &DeactivationTx_Rollback_Call{Call: _e.mock.On("Rollback")}
+}
+
+func (_c *DeactivationTx_Rollback_Call) Run(run func()) *DeactivationTx_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DeactivationTx_Rollback_Call) Return(_a0 error) *DeactivationTx_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeactivationTx_Rollback_Call) RunAndReturn(run func() error) *DeactivationTx_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeactivationTx creates a new instance of DeactivationTx. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeactivationTx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeactivationTx {
+	mock := &DeactivationTx{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventPublisher is an autogenerated mock type for the EventPublisher type
+type EventPublisher struct {
+	mock.Mock
+}
+
+type EventPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EventPublisher) EXPECT() *EventPublisher_Expecter {
+	return &EventPublisher_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: _a0, _a1
+func (_m *EventPublisher) Publish(_a0 context.Context, _a1 ...domain.Event) {
+	_va := make([]interface{}, len(_a1))
+	for _i := range _a1 {
+		_va[_i] = _a1[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// EventPublisher_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type EventPublisher_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 ...domain.Event
+func (_e *EventPublisher_Expecter) Publish(_a0 interface{}, _a1 ...interface{}) *EventPublisher_Publish_Call {
+	return &EventPublisher_Publish_Call{Call: _e.mock.On("Publish",
+		append([]interface{}{_a0}, _a1...)...)}
+}
+
+func (_c *EventPublisher_Publish_Call) Run(run func(_a0 context.Context, _a1 ...domain.Event)) *EventPublisher_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]domain.Event, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(domain.Event)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EventPublisher_Publish_Call) Return() *EventPublisher_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EventPublisher_Publish_Call) RunAndReturn(run func(context.Context, ...domain.Event)) *EventPublisher_Publish_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewEventPublisher creates a new instance of EventPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventPublisher {
+	mock := &EventPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
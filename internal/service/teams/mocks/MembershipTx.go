@@ -0,0 +1,507 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MembershipTx is an autogenerated mock type for the MembershipTx type
+type MembershipTx struct {
+	mock.Mock
+}
+
+type MembershipTx_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MembershipTx) EXPECT() *MembershipTx_Expecter {
+	return &MembershipTx_Expecter{mock: &_m.Mock}
+}
+
+// Commit provides a mock function with no fields
+func (_m *MembershipTx) Commit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MembershipTx_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MembershipTx_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+func (_e *MembershipTx_Expecter) Commit() *MembershipTx_Commit_Call {
+	return &MembershipTx_Commit_Call{Call: _e.mock.On("Commit")}
+}
+
+func (_c *MembershipTx_Commit_Call) Run(run func()) *MembershipTx_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MembershipTx_Commit_Call) Return(_a0 error) *MembershipTx_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MembershipTx_Commit_Call) RunAndReturn(run func() error) *MembershipTx_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeam provides a mock function with given fields: _a0, _a1
+func (_m *MembershipTx) DeleteTeam(_a0 context.Context, _a1 domain.TeamName) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MembershipTx_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type MembershipTx_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+func (_e *MembershipTx_Expecter) DeleteTeam(_a0 interface{}, _a1 interface{}) *MembershipTx_DeleteTeam_Call {
+	return &MembershipTx_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", _a0, _a1)}
+}
+
+func (_c *MembershipTx_DeleteTeam_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName)) *MembershipTx_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *MembershipTx_DeleteTeam_Call) Return(_a0 error) *MembershipTx_DeleteTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MembershipTx_DeleteTeam_Call) RunAndReturn(run func(context.Context, domain.TeamName) error) *MembershipTx_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenTeamAssignments provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MembershipTx) GetOpenTeamAssignments(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId) (domain.ReviewAssignments, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenTeamAssignments")
+	}
+
+	var r0 domain.ReviewAssignments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, []domain.MemberId) (domain.ReviewAssignments, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, []domain.MemberId) domain.ReviewAssignments); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.ReviewAssignments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName, []domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembershipTx_GetOpenTeamAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenTeamAssignments'
+type MembershipTx_GetOpenTeamAssignments_Call struct {
+	*mock.Call
+}
+
+// GetOpenTeamAssignments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+//   - _a2 []domain.MemberId
+func (_e *MembershipTx_Expecter) GetOpenTeamAssignments(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MembershipTx_GetOpenTeamAssignments_Call {
+	return &MembershipTx_GetOpenTeamAssignments_Call{Call: _e.mock.On("GetOpenTeamAssignments", _a0, _a1, _a2)}
+}
+
+func (_c *MembershipTx_GetOpenTeamAssignments_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId)) *MembershipTx_GetOpenTeamAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].([]domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *MembershipTx_GetOpenTeamAssignments_Call) Return(_a0 domain.ReviewAssignments, _a1 error) *MembershipTx_GetOpenTeamAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembershipTx_GetOpenTeamAssignments_Call) RunAndReturn(run func(context.Context, domain.TeamName, []domain.MemberId) (domain.ReviewAssignments, error)) *MembershipTx_GetOpenTeamAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplacementCandidates provides a mock function with given fields: _a0, _a1
+func (_m *MembershipTx) GetReplacementCandidates(_a0 context.Context, _a1 []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplacementCandidates")
+	}
+
+	var r0 map[domain.TeamName]domain.MembersHistories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) map[domain.TeamName]domain.MembersHistories); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.TeamName]domain.MembersHistories)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.TeamName) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembershipTx_GetReplacementCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplacementCandidates'
+type MembershipTx_GetReplacementCandidates_Call struct {
+	*mock.Call
+}
+
+// GetReplacementCandidates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.TeamName
+func (_e *MembershipTx_Expecter) GetReplacementCandidates(_a0 interface{}, _a1 interface{}) *MembershipTx_GetReplacementCandidates_Call {
+	return &MembershipTx_GetReplacementCandidates_Call{Call: _e.mock.On("GetReplacementCandidates", _a0, _a1)}
+}
+
+func (_c *MembershipTx_GetReplacementCandidates_Call) Run(run func(_a0 context.Context, _a1 []domain.TeamName)) *MembershipTx_GetReplacementCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *MembershipTx_GetReplacementCandidates_Call) Return(_a0 map[domain.TeamName]domain.MembersHistories, _a1 error) *MembershipTx_GetReplacementCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembershipTx_GetReplacementCandidates_Call) RunAndReturn(run func(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)) *MembershipTx_GetReplacementCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamMemberIds provides a mock function with given fields: _a0, _a1
+func (_m *MembershipTx) GetTeamMemberIds(_a0 context.Context, _a1 domain.TeamName) ([]domain.MemberId, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamMemberIds")
+	}
+
+	var r0 []domain.MemberId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName) ([]domain.MemberId, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName) []domain.MemberId); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MemberId)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembershipTx_GetTeamMemberIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMemberIds'
+type MembershipTx_GetTeamMemberIds_Call struct {
+	*mock.Call
+}
+
+// GetTeamMemberIds is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+func (_e *MembershipTx_Expecter) GetTeamMemberIds(_a0 interface{}, _a1 interface{}) *MembershipTx_GetTeamMemberIds_Call {
+	return &MembershipTx_GetTeamMemberIds_Call{Call: _e.mock.On("GetTeamMemberIds", _a0, _a1)}
+}
+
+func (_c *MembershipTx_GetTeamMemberIds_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName)) *MembershipTx_GetTeamMemberIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *MembershipTx_GetTeamMemberIds_Call) Return(_a0 []domain.MemberId, _a1 error) *MembershipTx_GetTeamMemberIds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembershipTx_GetTeamMemberIds_Call) RunAndReturn(run func(context.Context, domain.TeamName) ([]domain.MemberId, error)) *MembershipTx_GetTeamMemberIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockTeam provides a mock function with given fields: _a0, _a1
+func (_m *MembershipTx) LockTeam(_a0 context.Context, _a1 domain.TeamName) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MembershipTx_LockTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockTeam'
+type MembershipTx_LockTeam_Call struct {
+	*mock.Call
+}
+
+// LockTeam is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+func (_e *MembershipTx_Expecter) LockTeam(_a0 interface{}, _a1 interface{}) *MembershipTx_LockTeam_Call {
+	return &MembershipTx_LockTeam_Call{Call: _e.mock.On("LockTeam", _a0, _a1)}
+}
+
+func (_c *MembershipTx_LockTeam_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName)) *MembershipTx_LockTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *MembershipTx_LockTeam_Call) Return(_a0 error) *MembershipTx_LockTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MembershipTx_LockTeam_Call) RunAndReturn(run func(context.Context, domain.TeamName) error) *MembershipTx_LockTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMembers provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MembershipTx) RemoveTeamMembers(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId) ([]domain.MemberId, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMembers")
+	}
+
+	var r0 []domain.MemberId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, []domain.MemberId) ([]domain.MemberId, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, []domain.MemberId) []domain.MemberId); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MemberId)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName, []domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembershipTx_RemoveTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMembers'
+type MembershipTx_RemoveTeamMembers_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+//   - _a2 []domain.MemberId
+func (_e *MembershipTx_Expecter) RemoveTeamMembers(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MembershipTx_RemoveTeamMembers_Call {
+	return &MembershipTx_RemoveTeamMembers_Call{Call: _e.mock.On("RemoveTeamMembers", _a0, _a1, _a2)}
+}
+
+func (_c *MembershipTx_RemoveTeamMembers_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId)) *MembershipTx_RemoveTeamMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].([]domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *MembershipTx_RemoveTeamMembers_Call) Return(_a0 []domain.MemberId, _a1 error) *MembershipTx_RemoveTeamMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembershipTx_RemoveTeamMembers_Call) RunAndReturn(run func(context.Context, domain.TeamName, []domain.MemberId) ([]domain.MemberId, error)) *MembershipTx_RemoveTeamMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceReviewers provides a mock function with given fields: _a0, _a1, _a2
+func (_m *MembershipTx) ReplaceReviewers(_a0 context.Context, _a1 []domain.Reassignment, _a2 domain.AssignmentAudit) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Reassignment, domain.AssignmentAudit) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MembershipTx_ReplaceReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceReviewers'
+type MembershipTx_ReplaceReviewers_Call struct {
+	*mock.Call
+}
+
+// ReplaceReviewers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.Reassignment
+//   - _a2 domain.AssignmentAudit
+func (_e *MembershipTx_Expecter) ReplaceReviewers(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MembershipTx_ReplaceReviewers_Call {
+	return &MembershipTx_ReplaceReviewers_Call{Call: _e.mock.On("ReplaceReviewers", _a0, _a1, _a2)}
+}
+
+func (_c *MembershipTx_ReplaceReviewers_Call) Run(run func(_a0 context.Context, _a1 []domain.Reassignment, _a2 domain.AssignmentAudit)) *MembershipTx_ReplaceReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.Reassignment), args[2].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *MembershipTx_ReplaceReviewers_Call) Return(_a0 error) *MembershipTx_ReplaceReviewers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MembershipTx_ReplaceReviewers_Call) RunAndReturn(run func(context.Context, []domain.Reassignment, domain.AssignmentAudit) error) *MembershipTx_ReplaceReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with no fields
+func (_m *MembershipTx) Rollback() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MembershipTx_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type MembershipTx_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+func (_e *MembershipTx_Expecter) Rollback() *MembershipTx_Rollback_Call {
+	return &MembershipTx_Rollback_Call{Call: _e.mock.On("Rollback")}
+}
+
+func (_c *MembershipTx_Rollback_Call) Run(run func()) *MembershipTx_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MembershipTx_Rollback_Call) Return(_a0 error) *MembershipTx_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MembershipTx_Rollback_Call) RunAndReturn(run func() error) *MembershipTx_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMembershipTx creates a new instance of MembershipTx. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMembershipTx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MembershipTx {
+	mock := &MembershipTx{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,673 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReconcileTx is an autogenerated mock type for the ReconcileTx type
+type ReconcileTx struct {
+	mock.Mock
+}
+
+type ReconcileTx_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReconcileTx) EXPECT() *ReconcileTx_Expecter {
+	return &ReconcileTx_Expecter{mock: &_m.Mock}
+}
+
+// Commit provides a mock function with no fields
+func (_m *ReconcileTx) Commit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReconcileTx_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type ReconcileTx_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+func (_e *ReconcileTx_Expecter) Commit() *ReconcileTx_Commit_Call {
+	return &ReconcileTx_Commit_Call{Call: _e.mock.On("Commit")}
+}
+
+func (_c *ReconcileTx_Commit_Call) Run(run func()) *ReconcileTx_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_Commit_Call) Return(_a0 error) *ReconcileTx_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReconcileTx_Commit_Call) RunAndReturn(run func() error) *ReconcileTx_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTeams provides a mock function with given fields: _a0, _a1
+func (_m *ReconcileTx) CreateTeams(_a0 context.Context, _a1 []domain.TeamName) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReconcileTx_CreateTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeams'
+type ReconcileTx_CreateTeams_Call struct {
+	*mock.Call
+}
+
+// CreateTeams is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.TeamName
+func (_e *ReconcileTx_Expecter) CreateTeams(_a0 interface{}, _a1 interface{}) *ReconcileTx_CreateTeams_Call {
+	return &ReconcileTx_CreateTeams_Call{Call: _e.mock.On("CreateTeams", _a0, _a1)}
+}
+
+func (_c *ReconcileTx_CreateTeams_Call) Run(run func(_a0 context.Context, _a1 []domain.TeamName)) *ReconcileTx_CreateTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_CreateTeams_Call) Return(_a0 error) *ReconcileTx_CreateTeams_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReconcileTx_CreateTeams_Call) RunAndReturn(run func(context.Context, []domain.TeamName) error) *ReconcileTx_CreateTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMembers provides a mock function with given fields: _a0, _a1
+func (_m *ReconcileTx) GetMembers(_a0 context.Context, _a1 []domain.MemberId) (domain.Members, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembers")
+	}
+
+	var r0 domain.Members
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.MemberId) (domain.Members, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.MemberId) domain.Members); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.Members)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileTx_GetMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembers'
+type ReconcileTx_GetMembers_Call struct {
+	*mock.Call
+}
+
+// GetMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.MemberId
+func (_e *ReconcileTx_Expecter) GetMembers(_a0 interface{}, _a1 interface{}) *ReconcileTx_GetMembers_Call {
+	return &ReconcileTx_GetMembers_Call{Call: _e.mock.On("GetMembers", _a0, _a1)}
+}
+
+func (_c *ReconcileTx_GetMembers_Call) Run(run func(_a0 context.Context, _a1 []domain.MemberId)) *ReconcileTx_GetMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_GetMembers_Call) Return(_a0 domain.Members, _a1 error) *ReconcileTx_GetMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReconcileTx_GetMembers_Call) RunAndReturn(run func(context.Context, []domain.MemberId) (domain.Members, error)) *ReconcileTx_GetMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenAssignments provides a mock function with given fields: _a0, _a1
+func (_m *ReconcileTx) GetOpenAssignments(_a0 context.Context, _a1 []domain.MemberId) (domain.ReviewAssignments, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenAssignments")
+	}
+
+	var r0 domain.ReviewAssignments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.MemberId) (domain.ReviewAssignments, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.MemberId) domain.ReviewAssignments); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.ReviewAssignments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileTx_GetOpenAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenAssignments'
+type ReconcileTx_GetOpenAssignments_Call struct {
+	*mock.Call
+}
+
+// GetOpenAssignments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.MemberId
+func (_e *ReconcileTx_Expecter) GetOpenAssignments(_a0 interface{}, _a1 interface{}) *ReconcileTx_GetOpenAssignments_Call {
+	return &ReconcileTx_GetOpenAssignments_Call{Call: _e.mock.On("GetOpenAssignments", _a0, _a1)}
+}
+
+func (_c *ReconcileTx_GetOpenAssignments_Call) Run(run func(_a0 context.Context, _a1 []domain.MemberId)) *ReconcileTx_GetOpenAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_GetOpenAssignments_Call) Return(_a0 domain.ReviewAssignments, _a1 error) *ReconcileTx_GetOpenAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReconcileTx_GetOpenAssignments_Call) RunAndReturn(run func(context.Context, []domain.MemberId) (domain.ReviewAssignments, error)) *ReconcileTx_GetOpenAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenTeamAssignments provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReconcileTx) GetOpenTeamAssignments(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId) (domain.ReviewAssignments, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenTeamAssignments")
+	}
+
+	var r0 domain.ReviewAssignments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, []domain.MemberId) (domain.ReviewAssignments, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, []domain.MemberId) domain.ReviewAssignments); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.ReviewAssignments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName, []domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileTx_GetOpenTeamAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenTeamAssignments'
+type ReconcileTx_GetOpenTeamAssignments_Call struct {
+	*mock.Call
+}
+
+// GetOpenTeamAssignments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+//   - _a2 []domain.MemberId
+func (_e *ReconcileTx_Expecter) GetOpenTeamAssignments(_a0 interface{}, _a1 interface{}, _a2 interface{}) *ReconcileTx_GetOpenTeamAssignments_Call {
+	return &ReconcileTx_GetOpenTeamAssignments_Call{Call: _e.mock.On("GetOpenTeamAssignments", _a0, _a1, _a2)}
+}
+
+func (_c *ReconcileTx_GetOpenTeamAssignments_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId)) *ReconcileTx_GetOpenTeamAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].([]domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_GetOpenTeamAssignments_Call) Return(_a0 domain.ReviewAssignments, _a1 error) *ReconcileTx_GetOpenTeamAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReconcileTx_GetOpenTeamAssignments_Call) RunAndReturn(run func(context.Context, domain.TeamName, []domain.MemberId) (domain.ReviewAssignments, error)) *ReconcileTx_GetOpenTeamAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplacementCandidates provides a mock function with given fields: _a0, _a1
+func (_m *ReconcileTx) GetReplacementCandidates(_a0 context.Context, _a1 []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplacementCandidates")
+	}
+
+	var r0 map[domain.TeamName]domain.MembersHistories
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) map[domain.TeamName]domain.MembersHistories); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.TeamName]domain.MembersHistories)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.TeamName) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileTx_GetReplacementCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplacementCandidates'
+type ReconcileTx_GetReplacementCandidates_Call struct {
+	*mock.Call
+}
+
+// GetReplacementCandidates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.TeamName
+func (_e *ReconcileTx_Expecter) GetReplacementCandidates(_a0 interface{}, _a1 interface{}) *ReconcileTx_GetReplacementCandidates_Call {
+	return &ReconcileTx_GetReplacementCandidates_Call{Call: _e.mock.On("GetReplacementCandidates", _a0, _a1)}
+}
+
+func (_c *ReconcileTx_GetReplacementCandidates_Call) Run(run func(_a0 context.Context, _a1 []domain.TeamName)) *ReconcileTx_GetReplacementCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_GetReplacementCandidates_Call) Return(_a0 map[domain.TeamName]domain.MembersHistories, _a1 error) *ReconcileTx_GetReplacementCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReconcileTx_GetReplacementCandidates_Call) RunAndReturn(run func(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)) *ReconcileTx_GetReplacementCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkTeamMembers provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReconcileTx) LinkTeamMembers(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkTeamMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, []domain.MemberId) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReconcileTx_LinkTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkTeamMembers'
+type ReconcileTx_LinkTeamMembers_Call struct {
+	*mock.Call
+}
+
+// LinkTeamMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+//   - _a2 []domain.MemberId
+func (_e *ReconcileTx_Expecter) LinkTeamMembers(_a0 interface{}, _a1 interface{}, _a2 interface{}) *ReconcileTx_LinkTeamMembers_Call {
+	return &ReconcileTx_LinkTeamMembers_Call{Call: _e.mock.On("LinkTeamMembers", _a0, _a1, _a2)}
+}
+
+func (_c *ReconcileTx_LinkTeamMembers_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId)) *ReconcileTx_LinkTeamMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].([]domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_LinkTeamMembers_Call) Return(_a0 error) *ReconcileTx_LinkTeamMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReconcileTx_LinkTeamMembers_Call) RunAndReturn(run func(context.Context, domain.TeamName, []domain.MemberId) error) *ReconcileTx_LinkTeamMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockTeams provides a mock function with given fields: _a0, _a1
+func (_m *ReconcileTx) LockTeams(_a0 context.Context, _a1 []domain.TeamName) ([]domain.Team, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockTeams")
+	}
+
+	var r0 []domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) ([]domain.Team, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.TeamName) []domain.Team); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.TeamName) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileTx_LockTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockTeams'
+type ReconcileTx_LockTeams_Call struct {
+	*mock.Call
+}
+
+// LockTeams is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.TeamName
+func (_e *ReconcileTx_Expecter) LockTeams(_a0 interface{}, _a1 interface{}) *ReconcileTx_LockTeams_Call {
+	return &ReconcileTx_LockTeams_Call{Call: _e.mock.On("LockTeams", _a0, _a1)}
+}
+
+func (_c *ReconcileTx_LockTeams_Call) Run(run func(_a0 context.Context, _a1 []domain.TeamName)) *ReconcileTx_LockTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_LockTeams_Call) Return(_a0 []domain.Team, _a1 error) *ReconcileTx_LockTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReconcileTx_LockTeams_Call) RunAndReturn(run func(context.Context, []domain.TeamName) ([]domain.Team, error)) *ReconcileTx_LockTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMembers provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReconcileTx) RemoveTeamMembers(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId) ([]domain.MemberId, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMembers")
+	}
+
+	var r0 []domain.MemberId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, []domain.MemberId) ([]domain.MemberId, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, []domain.MemberId) []domain.MemberId); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MemberId)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName, []domain.MemberId) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileTx_RemoveTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMembers'
+type ReconcileTx_RemoveTeamMembers_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+//   - _a2 []domain.MemberId
+func (_e *ReconcileTx_Expecter) RemoveTeamMembers(_a0 interface{}, _a1 interface{}, _a2 interface{}) *ReconcileTx_RemoveTeamMembers_Call {
+	return &ReconcileTx_RemoveTeamMembers_Call{Call: _e.mock.On("RemoveTeamMembers", _a0, _a1, _a2)}
+}
+
+func (_c *ReconcileTx_RemoveTeamMembers_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName, _a2 []domain.MemberId)) *ReconcileTx_RemoveTeamMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].([]domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_RemoveTeamMembers_Call) Return(_a0 []domain.MemberId, _a1 error) *ReconcileTx_RemoveTeamMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReconcileTx_RemoveTeamMembers_Call) RunAndReturn(run func(context.Context, domain.TeamName, []domain.MemberId) ([]domain.MemberId, error)) *ReconcileTx_RemoveTeamMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceReviewers provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReconcileTx) ReplaceReviewers(_a0 context.Context, _a1 []domain.Reassignment, _a2 domain.AssignmentAudit) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Reassignment, domain.AssignmentAudit) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReconcileTx_ReplaceReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceReviewers'
+type ReconcileTx_ReplaceReviewers_Call struct {
+	*mock.Call
+}
+
+// ReplaceReviewers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 []domain.Reassignment
+//   - _a2 domain.AssignmentAudit
+func (_e *ReconcileTx_Expecter) ReplaceReviewers(_a0 interface{}, _a1 interface{}, _a2 interface{}) *ReconcileTx_ReplaceReviewers_Call {
+	return &ReconcileTx_ReplaceReviewers_Call{Call: _e.mock.On("ReplaceReviewers", _a0, _a1, _a2)}
+}
+
+func (_c *ReconcileTx_ReplaceReviewers_Call) Run(run func(_a0 context.Context, _a1 []domain.Reassignment, _a2 domain.AssignmentAudit)) *ReconcileTx_ReplaceReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.Reassignment), args[2].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_ReplaceReviewers_Call) Return(_a0 error) *ReconcileTx_ReplaceReviewers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReconcileTx_ReplaceReviewers_Call) RunAndReturn(run func(context.Context, []domain.Reassignment, domain.AssignmentAudit) error) *ReconcileTx_ReplaceReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with no fields
+func (_m *ReconcileTx) Rollback() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReconcileTx_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type ReconcileTx_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+func (_e *ReconcileTx_Expecter) Rollback() *ReconcileTx_Rollback_Call {
+	return &ReconcileTx_Rollback_Call{Call: _e.mock.On("Rollback")}
+}
+
+func (_c *ReconcileTx_Rollback_Call) Run(run func()) *ReconcileTx_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_Rollback_Call) Return(_a0 error) *ReconcileTx_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReconcileTx_Rollback_Call) RunAndReturn(run func() error) *ReconcileTx_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertMembers provides a mock function with given fields: _a0, _a1
+func (_m *ReconcileTx) UpsertMembers(_a0 context.Context, _a1 domain.Members) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Members) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReconcileTx_UpsertMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertMembers'
+type ReconcileTx_UpsertMembers_Call struct {
+	*mock.Call
+}
+
+// UpsertMembers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Members
+func (_e *ReconcileTx_Expecter) UpsertMembers(_a0 interface{}, _a1 interface{}) *ReconcileTx_UpsertMembers_Call {
+	return &ReconcileTx_UpsertMembers_Call{Call: _e.mock.On("UpsertMembers", _a0, _a1)}
+}
+
+func (_c *ReconcileTx_UpsertMembers_Call) Run(run func(_a0 context.Context, _a1 domain.Members)) *ReconcileTx_UpsertMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Members))
+	})
+	return _c
+}
+
+func (_c *ReconcileTx_UpsertMembers_Call) Return(_a0 error) *ReconcileTx_UpsertMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReconcileTx_UpsertMembers_Call) RunAndReturn(run func(context.Context, domain.Members) error) *ReconcileTx_UpsertMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReconcileTx creates a new instance of ReconcileTx. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReconcileTx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReconcileTx {
+	mock := &ReconcileTx{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
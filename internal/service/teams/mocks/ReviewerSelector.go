@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewerSelector is an autogenerated mock type for the ReviewerSelector type
+type ReviewerSelector struct {
+	mock.Mock
+}
+
+type ReviewerSelector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReviewerSelector) EXPECT() *ReviewerSelector_Expecter {
+	return &ReviewerSelector_Expecter{mock: &_m.Mock}
+}
+
+// Select provides a mock function with given fields: candidates, n
+func (_m *ReviewerSelector) Select(candidates domain.MembersHistories, n int) domain.MembersHistories {
+	ret := _m.Called(candidates, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Select")
+	}
+
+	var r0 domain.MembersHistories
+	if rf, ok := ret.Get(0).(func(domain.MembersHistories, int) domain.MembersHistories); ok {
+		r0 = rf(candidates, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.MembersHistories)
+		}
+	}
+
+	return r0
+}
+
+// ReviewerSelector_Select_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Select'
+type ReviewerSelector_Select_Call struct {
+	*mock.Call
+}
+
+// Select is a helper method to define mock.On call
+//   - candidates domain.MembersHistories
+//   - n int
+func (_e *ReviewerSelector_Expecter) Select(candidates interface{}, n interface{}) *ReviewerSelector_Select_Call {
+	return &ReviewerSelector_Select_Call{Call: _e.mock.On("Select", candidates, n)}
+}
+
+func (_c *ReviewerSelector_Select_Call) Run(run func(candidates domain.MembersHistories, n int)) *ReviewerSelector_Select_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MembersHistories), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ReviewerSelector_Select_Call) Return(_a0 domain.MembersHistories) *ReviewerSelector_Select_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReviewerSelector_Select_Call) RunAndReturn(run func(domain.MembersHistories, int) domain.MembersHistories) *ReviewerSelector_Select_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReviewerSelector creates a new instance of ReviewerSelector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewerSelector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewerSelector {
+	mock := &ReviewerSelector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
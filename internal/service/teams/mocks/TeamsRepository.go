@@ -3,8 +3,14 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
+
+	time "time"
 )
 
 // TeamsRepository is an autogenerated mock type for the TeamsRepository type
@@ -20,6 +26,237 @@ func (_m *TeamsRepository) EXPECT() *TeamsRepository_Expecter {
 	return &TeamsRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddTeamMembers provides a mock function with given fields: _a0, _a1
+func (_m *TeamsRepository) AddTeamMembers(_a0 domain.TeamName, _a1 domain.Members) (domain.Team, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTeamMembers")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.Members) (domain.Team, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.Members) domain.Team); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, domain.Members) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_AddTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMembers'
+type TeamsRepository_AddTeamMembers_Call struct {
+	*mock.Call
+}
+
+// AddTeamMembers is a helper method to define mock.On call
+//   - _a0 domain.TeamName
+//   - _a1 domain.Members
+func (_e *TeamsRepository_Expecter) AddTeamMembers(_a0 interface{}, _a1 interface{}) *TeamsRepository_AddTeamMembers_Call {
+	return &TeamsRepository_AddTeamMembers_Call{Call: _e.mock.On("AddTeamMembers", _a0, _a1)}
+}
+
+func (_c *TeamsRepository_AddTeamMembers_Call) Run(run func(_a0 domain.TeamName, _a1 domain.Members)) *TeamsRepository_AddTeamMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(domain.Members))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_AddTeamMembers_Call) Return(_a0 domain.Team, _a1 error) *TeamsRepository_AddTeamMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_AddTeamMembers_Call) RunAndReturn(run func(domain.TeamName, domain.Members) (domain.Team, error)) *TeamsRepository_AddTeamMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginDeactivationTx provides a mock function with given fields: _a0
+func (_m *TeamsRepository) BeginDeactivationTx(_a0 context.Context) (servteams.DeactivationTx, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginDeactivationTx")
+	}
+
+	var r0 servteams.DeactivationTx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (servteams.DeactivationTx, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) servteams.DeactivationTx); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(servteams.DeactivationTx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_BeginDeactivationTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginDeactivationTx'
+type TeamsRepository_BeginDeactivationTx_Call struct {
+	*mock.Call
+}
+
+// BeginDeactivationTx is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *TeamsRepository_Expecter) BeginDeactivationTx(_a0 interface{}) *TeamsRepository_BeginDeactivationTx_Call {
+	return &TeamsRepository_BeginDeactivationTx_Call{Call: _e.mock.On("BeginDeactivationTx", _a0)}
+}
+
+func (_c *TeamsRepository_BeginDeactivationTx_Call) Run(run func(_a0 context.Context)) *TeamsRepository_BeginDeactivationTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_BeginDeactivationTx_Call) Return(_a0 servteams.DeactivationTx, _a1 error) *TeamsRepository_BeginDeactivationTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_BeginDeactivationTx_Call) RunAndReturn(run func(context.Context) (servteams.DeactivationTx, error)) *TeamsRepository_BeginDeactivationTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginMembershipTx provides a mock function with given fields: _a0
+func (_m *TeamsRepository) BeginMembershipTx(_a0 context.Context) (servteams.MembershipTx, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginMembershipTx")
+	}
+
+	var r0 servteams.MembershipTx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (servteams.MembershipTx, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) servteams.MembershipTx); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(servteams.MembershipTx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_BeginMembershipTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginMembershipTx'
+type TeamsRepository_BeginMembershipTx_Call struct {
+	*mock.Call
+}
+
+// BeginMembershipTx is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *TeamsRepository_Expecter) BeginMembershipTx(_a0 interface{}) *TeamsRepository_BeginMembershipTx_Call {
+	return &TeamsRepository_BeginMembershipTx_Call{Call: _e.mock.On("BeginMembershipTx", _a0)}
+}
+
+func (_c *TeamsRepository_BeginMembershipTx_Call) Run(run func(_a0 context.Context)) *TeamsRepository_BeginMembershipTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_BeginMembershipTx_Call) Return(_a0 servteams.MembershipTx, _a1 error) *TeamsRepository_BeginMembershipTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_BeginMembershipTx_Call) RunAndReturn(run func(context.Context) (servteams.MembershipTx, error)) *TeamsRepository_BeginMembershipTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginReconcileTx provides a mock function with given fields: _a0
+func (_m *TeamsRepository) BeginReconcileTx(_a0 context.Context) (servteams.ReconcileTx, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginReconcileTx")
+	}
+
+	var r0 servteams.ReconcileTx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (servteams.ReconcileTx, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) servteams.ReconcileTx); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(servteams.ReconcileTx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_BeginReconcileTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginReconcileTx'
+type TeamsRepository_BeginReconcileTx_Call struct {
+	*mock.Call
+}
+
+// BeginReconcileTx is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *TeamsRepository_Expecter) BeginReconcileTx(_a0 interface{}) *TeamsRepository_BeginReconcileTx_Call {
+	return &TeamsRepository_BeginReconcileTx_Call{Call: _e.mock.On("BeginReconcileTx", _a0)}
+}
+
+func (_c *TeamsRepository_BeginReconcileTx_Call) Run(run func(_a0 context.Context)) *TeamsRepository_BeginReconcileTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_BeginReconcileTx_Call) Return(_a0 servteams.ReconcileTx, _a1 error) *TeamsRepository_BeginReconcileTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_BeginReconcileTx_Call) RunAndReturn(run func(context.Context) (servteams.ReconcileTx, error)) *TeamsRepository_BeginReconcileTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTeamWithMembers provides a mock function with given fields: _a0, _a1
 func (_m *TeamsRepository) CreateTeamWithMembers(_a0 domain.TeamName, _a1 domain.Members) (domain.Team, error) {
 	ret := _m.Called(_a0, _a1)
@@ -135,6 +372,294 @@ func (_c *TeamsRepository_GetMembersByTeamName_Call) RunAndReturn(run func(domai
 	return _c
 }
 
+// GetTeamReviewLoads provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TeamsRepository) GetTeamReviewLoads(_a0 context.Context, _a1 domain.TeamName, _a2 time.Time) ([]domain.MemberLoad, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamReviewLoads")
+	}
+
+	var r0 []domain.MemberLoad
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, time.Time) ([]domain.MemberLoad, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, time.Time) []domain.MemberLoad); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MemberLoad)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_GetTeamReviewLoads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamReviewLoads'
+type TeamsRepository_GetTeamReviewLoads_Call struct {
+	*mock.Call
+}
+
+// GetTeamReviewLoads is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.TeamName
+//   - _a2 time.Time
+func (_e *TeamsRepository_Expecter) GetTeamReviewLoads(_a0 interface{}, _a1 interface{}, _a2 interface{}) *TeamsRepository_GetTeamReviewLoads_Call {
+	return &TeamsRepository_GetTeamReviewLoads_Call{Call: _e.mock.On("GetTeamReviewLoads", _a0, _a1, _a2)}
+}
+
+func (_c *TeamsRepository_GetTeamReviewLoads_Call) Run(run func(_a0 context.Context, _a1 domain.TeamName, _a2 time.Time)) *TeamsRepository_GetTeamReviewLoads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_GetTeamReviewLoads_Call) Return(_a0 []domain.MemberLoad, _a1 error) *TeamsRepository_GetTeamReviewLoads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_GetTeamReviewLoads_Call) RunAndReturn(run func(context.Context, domain.TeamName, time.Time) ([]domain.MemberLoad, error)) *TeamsRepository_GetTeamReviewLoads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTeam provides a mock function with given fields: tName, newName
+func (_m *TeamsRepository) RenameTeam(tName domain.TeamName, newName domain.TeamName) (domain.Team, error) {
+	ret := _m.Called(tName, newName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTeam")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.TeamName) (domain.Team, error)); ok {
+		return rf(tName, newName)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.TeamName) domain.Team); ok {
+		r0 = rf(tName, newName)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, domain.TeamName) error); ok {
+		r1 = rf(tName, newName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_RenameTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTeam'
+type TeamsRepository_RenameTeam_Call struct {
+	*mock.Call
+}
+
+// RenameTeam is a helper method to define mock.On call
+//   - tName domain.TeamName
+//   - newName domain.TeamName
+func (_e *TeamsRepository_Expecter) RenameTeam(tName interface{}, newName interface{}) *TeamsRepository_RenameTeam_Call {
+	return &TeamsRepository_RenameTeam_Call{Call: _e.mock.On("RenameTeam", tName, newName)}
+}
+
+func (_c *TeamsRepository_RenameTeam_Call) Run(run func(tName domain.TeamName, newName domain.TeamName)) *TeamsRepository_RenameTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_RenameTeam_Call) Return(_a0 domain.Team, _a1 error) *TeamsRepository_RenameTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_RenameTeam_Call) RunAndReturn(run func(domain.TeamName, domain.TeamName) (domain.Team, error)) *TeamsRepository_RenameTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTeamMergePolicy provides a mock function with given fields: _a0, _a1
+func (_m *TeamsRepository) UpdateTeamMergePolicy(_a0 domain.TeamName, _a1 domain.MergePolicyOverride) (domain.Team, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTeamMergePolicy")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.MergePolicyOverride) (domain.Team, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.MergePolicyOverride) domain.Team); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, domain.MergePolicyOverride) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_UpdateTeamMergePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeamMergePolicy'
+type TeamsRepository_UpdateTeamMergePolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateTeamMergePolicy is a helper method to define mock.On call
+//   - _a0 domain.TeamName
+//   - _a1 domain.MergePolicyOverride
+func (_e *TeamsRepository_Expecter) UpdateTeamMergePolicy(_a0 interface{}, _a1 interface{}) *TeamsRepository_UpdateTeamMergePolicy_Call {
+	return &TeamsRepository_UpdateTeamMergePolicy_Call{Call: _e.mock.On("UpdateTeamMergePolicy", _a0, _a1)}
+}
+
+func (_c *TeamsRepository_UpdateTeamMergePolicy_Call) Run(run func(_a0 domain.TeamName, _a1 domain.MergePolicyOverride)) *TeamsRepository_UpdateTeamMergePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(domain.MergePolicyOverride))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_UpdateTeamMergePolicy_Call) Return(_a0 domain.Team, _a1 error) *TeamsRepository_UpdateTeamMergePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_UpdateTeamMergePolicy_Call) RunAndReturn(run func(domain.TeamName, domain.MergePolicyOverride) (domain.Team, error)) *TeamsRepository_UpdateTeamMergePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTeamRequiredReviewers provides a mock function with given fields: _a0, _a1
+func (_m *TeamsRepository) UpdateTeamRequiredReviewers(_a0 domain.TeamName, _a1 int) (domain.Team, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTeamRequiredReviewers")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, int) (domain.Team, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, int) domain.Team); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_UpdateTeamRequiredReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeamRequiredReviewers'
+type TeamsRepository_UpdateTeamRequiredReviewers_Call struct {
+	*mock.Call
+}
+
+// UpdateTeamRequiredReviewers is a helper method to define mock.On call
+//   - _a0 domain.TeamName
+//   - _a1 int
+func (_e *TeamsRepository_Expecter) UpdateTeamRequiredReviewers(_a0 interface{}, _a1 interface{}) *TeamsRepository_UpdateTeamRequiredReviewers_Call {
+	return &TeamsRepository_UpdateTeamRequiredReviewers_Call{Call: _e.mock.On("UpdateTeamRequiredReviewers", _a0, _a1)}
+}
+
+func (_c *TeamsRepository_UpdateTeamRequiredReviewers_Call) Run(run func(_a0 domain.TeamName, _a1 int)) *TeamsRepository_UpdateTeamRequiredReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_UpdateTeamRequiredReviewers_Call) Return(_a0 domain.Team, _a1 error) *TeamsRepository_UpdateTeamRequiredReviewers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_UpdateTeamRequiredReviewers_Call) RunAndReturn(run func(domain.TeamName, int) (domain.Team, error)) *TeamsRepository_UpdateTeamRequiredReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTeamReviewCapacity provides a mock function with given fields: _a0, _a1
+func (_m *TeamsRepository) UpdateTeamReviewCapacity(_a0 domain.TeamName, _a1 domain.ReviewCapacity) (domain.Team, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTeamReviewCapacity")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.ReviewCapacity) (domain.Team, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.ReviewCapacity) domain.Team); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, domain.ReviewCapacity) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsRepository_UpdateTeamReviewCapacity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeamReviewCapacity'
+type TeamsRepository_UpdateTeamReviewCapacity_Call struct {
+	*mock.Call
+}
+
+// UpdateTeamReviewCapacity is a helper method to define mock.On call
+//   - _a0 domain.TeamName
+//   - _a1 domain.ReviewCapacity
+func (_e *TeamsRepository_Expecter) UpdateTeamReviewCapacity(_a0 interface{}, _a1 interface{}) *TeamsRepository_UpdateTeamReviewCapacity_Call {
+	return &TeamsRepository_UpdateTeamReviewCapacity_Call{Call: _e.mock.On("UpdateTeamReviewCapacity", _a0, _a1)}
+}
+
+func (_c *TeamsRepository_UpdateTeamReviewCapacity_Call) Run(run func(_a0 domain.TeamName, _a1 domain.ReviewCapacity)) *TeamsRepository_UpdateTeamReviewCapacity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(domain.ReviewCapacity))
+	})
+	return _c
+}
+
+func (_c *TeamsRepository_UpdateTeamReviewCapacity_Call) Return(_a0 domain.Team, _a1 error) *TeamsRepository_UpdateTeamReviewCapacity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsRepository_UpdateTeamReviewCapacity_Call) RunAndReturn(run func(domain.TeamName, domain.ReviewCapacity) (domain.Team, error)) *TeamsRepository_UpdateTeamReviewCapacity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTeamsRepository creates a new instance of TeamsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTeamsRepository(t interface {
@@ -0,0 +1,131 @@
+package servteams
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
+
+type ReconcileTx interface {
+	LockTeams(context.Context, []domain.TeamName) ([]domain.Team, error)
+	GetMembers(context.Context, []domain.MemberId) (domain.Members, error)
+	CreateTeams(context.Context, []domain.TeamName) error
+	UpsertMembers(context.Context, domain.Members) error
+	LinkTeamMembers(context.Context, domain.TeamName, []domain.MemberId) error
+	GetOpenTeamAssignments(context.Context, domain.TeamName, []domain.MemberId) (domain.ReviewAssignments, error)
+	GetOpenAssignments(context.Context, []domain.MemberId) (domain.ReviewAssignments, error)
+	GetReplacementCandidates(context.Context, []domain.TeamName) (map[domain.TeamName]domain.MembersHistories, error)
+	ReplaceReviewers(context.Context, []domain.Reassignment, domain.AssignmentAudit) error
+	RemoveTeamMembers(context.Context, domain.TeamName, []domain.MemberId) ([]domain.MemberId, error)
+	Commit() error
+	Rollback() error
+}
+
+// ReconcileTeams diffs the spec against the database under the team locks and applies the plan
+// in the same transaction when apply is set, otherwise the transaction is rolled back.
+// Open reviews of removed members are reported and kept, as with the keep removal policy,
+// open reviews of deactivated members are moved as by DeactivateTeam and announced once committed.
+func (ts *TeamsService) ReconcileTeams(ctx context.Context, spec domain.OrgSpec, apply bool) (domain.ReconcilePlan, error) {
+	plan, err := ts.reconcileTeams(ctx, spec, apply)
+	if err != nil {
+		return domain.ReconcilePlan{}, err
+	}
+
+	ts.publish(ctx, plan.Events()...)
+
+	return plan, nil
+}
+
+func (ts *TeamsService) reconcileTeams(ctx context.Context, spec domain.OrgSpec, apply bool) (_ domain.ReconcilePlan, err error) {
+	if !spec.Valid() {
+		return domain.ReconcilePlan{}, domain.ErrValidation
+	}
+
+	tx, err := ts.repo.BeginReconcileTx(ctx)
+	if err != nil {
+		return domain.ReconcilePlan{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+	defer func() {
+		if err == nil && apply {
+			if errCommit := tx.Commit(); errCommit != nil {
+				err = fmt.Errorf("%w: %w", domain.ErrInternal, errCommit)
+			}
+			return
+		}
+		if errRollback := tx.Rollback(); errRollback != nil {
+			if err == nil {
+				err = fmt.Errorf("%w: %w", domain.ErrInternal, errRollback)
+				return
+			}
+			err = fmt.Errorf("%w: %w", err, errRollback)
+		}
+	}()
+
+	current, err := tx.LockTeams(ctx, spec.TeamNames())
+	if err != nil {
+		return domain.ReconcilePlan{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	known, err := tx.GetMembers(ctx, spec.Members().Ids())
+	if err != nil {
+		return domain.ReconcilePlan{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	plan := domain.NewReconcilePlan(spec, current, known)
+
+	removedTeams, removed := plan.RemoveMembers.ByTeam()
+	for _, team := range removedTeams {
+		affected, err := tx.GetOpenTeamAssignments(ctx, team, removed[team])
+		if err != nil {
+			return domain.ReconcilePlan{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+		plan.Affected = append(plan.Affected, affected...)
+	}
+
+	deactivated := domain.ReviewAssignments{}
+	if len(plan.Deactivate) > 0 {
+		deactivated, err = tx.GetOpenAssignments(ctx, plan.Deactivate)
+		if err != nil {
+			return domain.ReconcilePlan{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+		plan.Affected = plan.Affected.With(deactivated)
+	}
+
+	if !apply || plan.Empty() {
+		return plan, nil
+	}
+
+	if len(plan.CreateTeams) > 0 {
+		if err = tx.CreateTeams(ctx, plan.CreateTeams); err != nil {
+			return domain.ReconcilePlan{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+	}
+
+	if err = tx.UpsertMembers(ctx, spec.Members()); err != nil {
+		return domain.ReconcilePlan{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	addedTeams, added := plan.AddMembers.ByTeam()
+	for _, team := range addedTeams {
+		if err = tx.LinkTeamMembers(ctx, team, added[team]); err != nil {
+			return domain.ReconcilePlan{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+	}
+
+	for _, team := range removedTeams {
+		if _, err = tx.RemoveTeamMembers(ctx, team, removed[team]); err != nil {
+			return domain.ReconcilePlan{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+		}
+	}
+
+	if !deactivated.Empty() {
+		plan.Reassigned, plan.Unfilled, err = ts.moveOpenReviews(ctx, tx, deactivated, domain.SystemAudit(domain.AssignmentReasonMemberDeactivated))
+		if err != nil {
+			return domain.ReconcilePlan{}, err
+		}
+	}
+
+	plan.Applied = true
+	return plan, nil
+}
@@ -0,0 +1,136 @@
+package servteams_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/teams/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamsService_ReconcileTeams(t *testing.T) {
+	ctx := context.Background()
+
+	aliceId := domain.MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001")
+	bobId := domain.MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0002")
+	alice := domain.MemberBuilder(aliceId).Name("Alice").Status(domain.MemberStatusActive).Build()
+	bob := domain.MemberBuilder(bobId).Name("Bob").Status(domain.MemberStatusActive).Build()
+
+	// backend drops Bob, platform is new
+	spec := domain.OrgSpec{Teams: []domain.Team{
+		domain.NewTeam("backend", alice),
+		domain.NewTeam("platform", alice),
+	}}
+	teamNames := []domain.TeamName{"backend", "platform"}
+	current := []domain.Team{domain.NewTeam("backend", alice, bob)}
+	affected := domain.ReviewAssignments{{PrId: "pr-1", Team: "backend", MemberId: bobId}}
+
+	planned := func(tx *mocks.ReconcileTx) {
+		tx.EXPECT().LockTeams(ctx, teamNames).Return(current, nil)
+		tx.EXPECT().GetMembers(ctx, []domain.MemberId{aliceId}).Return(domain.Members{alice}, nil)
+		tx.EXPECT().GetOpenTeamAssignments(ctx, domain.TeamName("backend"), []domain.MemberId{bobId}).Return(affected, nil)
+	}
+
+	tests := []struct {
+		name        string
+		spec        domain.OrgSpec
+		apply       bool
+		repoSetup   func(*mocks.TeamsRepository)
+		wantApplied bool
+		wantErr     error
+	}{
+		{
+			name:  "dry run rolls back",
+			spec:  spec,
+			apply: false,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				tx := mocks.NewReconcileTx(t)
+				mockRepo.EXPECT().BeginReconcileTx(ctx).Return(tx, nil)
+				planned(tx)
+				tx.EXPECT().Rollback().Return(nil)
+			},
+		},
+		{
+			name:  "apply commits the plan",
+			spec:  spec,
+			apply: true,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				tx := mocks.NewReconcileTx(t)
+				mockRepo.EXPECT().BeginReconcileTx(ctx).Return(tx, nil)
+				planned(tx)
+				tx.EXPECT().CreateTeams(ctx, []domain.TeamName{"platform"}).Return(nil)
+				tx.EXPECT().UpsertMembers(ctx, domain.Members{alice}).Return(nil)
+				tx.EXPECT().LinkTeamMembers(ctx, domain.TeamName("platform"), []domain.MemberId{aliceId}).Return(nil)
+				tx.EXPECT().RemoveTeamMembers(ctx, domain.TeamName("backend"), []domain.MemberId{bobId}).Return([]domain.MemberId{bobId}, nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			wantApplied: true,
+		},
+		{
+			name:  "apply fails midway",
+			spec:  spec,
+			apply: true,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				tx := mocks.NewReconcileTx(t)
+				mockRepo.EXPECT().BeginReconcileTx(ctx).Return(tx, nil)
+				planned(tx)
+				tx.EXPECT().CreateTeams(ctx, []domain.TeamName{"platform"}).Return(errors.New("database error"))
+				tx.EXPECT().Rollback().Return(nil)
+			},
+			wantErr: domain.ErrInternal,
+		},
+		{
+			name: "invalid spec",
+			spec: domain.OrgSpec{Teams: []domain.Team{
+				domain.NewTeam("backend", alice),
+				domain.NewTeam("backend"),
+			}},
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), nil)
+			plan, err := service.ReconcileTeams(ctx, tt.spec, tt.apply)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantApplied, plan.Applied)
+			assert.Equal(t, []domain.TeamName{"platform"}, plan.CreateTeams)
+			assert.Equal(t, domain.MembershipChanges{{Team: "backend", MemberId: bobId}}, plan.RemoveMembers)
+			assert.Equal(t, affected, plan.Affected)
+		})
+	}
+}
+
+func TestTeamsService_ReconcileTeams_InSync(t *testing.T) {
+	ctx := context.Background()
+	alice := domain.MemberBuilder("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001").Name("Alice").Build()
+	spec := domain.OrgSpec{Teams: []domain.Team{domain.NewTeam("backend", alice)}}
+
+	mockRepo := mocks.NewTeamsRepository(t)
+	tx := mocks.NewReconcileTx(t)
+	mockRepo.EXPECT().BeginReconcileTx(ctx).Return(tx, nil)
+	tx.EXPECT().LockTeams(ctx, []domain.TeamName{"backend"}).Return(spec.Teams, nil)
+	tx.EXPECT().GetMembers(ctx, []domain.MemberId{alice.Id}).Return(domain.Members{alice}, nil)
+	tx.EXPECT().Commit().Return(nil)
+
+	service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), nil)
+	plan, err := service.ReconcileTeams(ctx, spec, true)
+
+	assert.NoError(t, err)
+	assert.True(t, plan.Empty())
+	assert.False(t, plan.Applied)
+}
@@ -1,15 +1,49 @@
 package servteams
 
+import (
+	"context"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
+
 type TeamsService struct {
-	repo Repository
+	repo         Repository
+	selector     ReviewerSelector
+	fallbackTeam domain.TeamName
+
+	fairnessWindow    time.Duration
+	fairnessTolerance float64
+
+	events EventPublisher
 }
 
-func NewTeamsService(r Repository) *TeamsService {
+// NewTeamsService pub announces the committed changes, nil announces nothing.
+func NewTeamsService(cfg *configs.BussinesLogic, r Repository, sel ReviewerSelector, pub EventPublisher) *TeamsService {
 	return &TeamsService{
-		repo: r,
+		repo:         r,
+		selector:     sel,
+		fallbackTeam: domain.TeamName(cfg.DeactivationFallbackTeam),
+
+		fairnessWindow:    time.Duration(cfg.FairnessWindowDays) * 24 * time.Hour,
+		fairnessTolerance: cfg.FairnessTolerance,
+
+		events: pub,
 	}
 }
 
 type Repository interface {
 	TeamsRepository
 }
+
+// EventPublisher announces committed changes to the webhook subscriptions.
+type EventPublisher interface {
+	Publish(context.Context, ...domain.Event)
+}
+
+func (ts *TeamsService) publish(ctx context.Context, events ...domain.Event) {
+	if ts.events != nil && len(events) > 0 {
+		ts.events.Publish(ctx, events...)
+	}
+}
@@ -1,7 +1,9 @@
 package servteams
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	"github.com/go-faster/errors"
@@ -10,6 +12,15 @@ import (
 type TeamsRepository interface {
 	CreateTeamWithMembers(domain.TeamName, domain.Members) (domain.Team, error)
 	GetMembersByTeamName(domain.TeamName) (domain.Members, error)
+	UpdateTeamReviewCapacity(domain.TeamName, domain.ReviewCapacity) (domain.Team, error)
+	UpdateTeamRequiredReviewers(domain.TeamName, int) (domain.Team, error)
+	UpdateTeamMergePolicy(domain.TeamName, domain.MergePolicyOverride) (domain.Team, error)
+	BeginDeactivationTx(context.Context) (DeactivationTx, error)
+	AddTeamMembers(domain.TeamName, domain.Members) (domain.Team, error)
+	RenameTeam(tName, newName domain.TeamName) (domain.Team, error)
+	BeginMembershipTx(context.Context) (MembershipTx, error)
+	BeginReconcileTx(context.Context) (ReconcileTx, error)
+	GetTeamReviewLoads(context.Context, domain.TeamName, time.Time) ([]domain.MemberLoad, error)
 }
 
 func (ts *TeamsService) NewTeam(team domain.Team) (domain.Team, error) {
@@ -41,3 +52,48 @@ func (ts *TeamsService) TeamWithMembers(tName domain.TeamName) (domain.Team, err
 
 	return domain.NewTeam(tName, members...), nil
 }
+
+func (ts *TeamsService) SetTeamReviewCapacity(tName domain.TeamName, c domain.ReviewCapacity) (domain.Team, error) {
+
+	team, err := ts.repo.UpdateTeamReviewCapacity(tName, c)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Team{}, domain.ErrNotFound
+		}
+		return domain.Team{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return team, nil
+}
+
+func (ts *TeamsService) SetTeamRequiredReviewers(tName domain.TeamName, required int) (domain.Team, error) {
+	if required < 0 {
+		return domain.Team{}, domain.ErrValidation
+	}
+
+	team, err := ts.repo.UpdateTeamRequiredReviewers(tName, required)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Team{}, domain.ErrNotFound
+		}
+		return domain.Team{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return team, nil
+}
+
+func (ts *TeamsService) SetTeamMergePolicy(tName domain.TeamName, p domain.MergePolicyOverride) (domain.Team, error) {
+	if p.MinApprovals != nil && *p.MinApprovals < 0 {
+		return domain.Team{}, domain.ErrValidation
+	}
+
+	team, err := ts.repo.UpdateTeamMergePolicy(tName, p)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.Team{}, domain.ErrNotFound
+		}
+		return domain.Team{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	return team, nil
+}
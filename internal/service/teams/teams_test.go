@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	servteams "github.com/eragon-mdi/pr-reviewer-service/internal/service/teams"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/service/teams/mocks"
@@ -78,7 +79,7 @@ func TestTeamsService_NewTeam(t *testing.T) {
 			mockRepo := mocks.NewTeamsRepository(t)
 			tt.repoSetup(mockRepo, tt.team)
 
-			service := servteams.NewTeamsService(mockRepo)
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), nil)
 			got, err := service.NewTeam(tt.team)
 
 			if tt.wantErr != nil {
@@ -158,7 +159,7 @@ func TestTeamsService_TeamWithMembers(t *testing.T) {
 			mockRepo := mocks.NewTeamsRepository(t)
 			tt.repoSetup(mockRepo, tt.teamName)
 
-			service := servteams.NewTeamsService(mockRepo)
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), nil)
 			got, err := service.TeamWithMembers(tt.teamName)
 
 			if tt.wantErr != nil {
@@ -171,3 +172,186 @@ func TestTeamsService_TeamWithMembers(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamsService_SetTeamReviewCapacity(t *testing.T) {
+	tests := []struct {
+		name      string
+		repoSetup func(*mocks.TeamsRepository)
+		wantErr   error
+	}{
+		{
+			name: "successful update",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				team := domain.NewTeam("backend")
+				team.Settings.DefaultReviewCapacity = domain.NewReviewCapacity(2)
+				mockRepo.EXPECT().UpdateTeamReviewCapacity(domain.TeamName("backend"), domain.NewReviewCapacity(2)).
+					Return(team, nil)
+			},
+		},
+		{
+			name: "team not found",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().UpdateTeamReviewCapacity(domain.TeamName("backend"), domain.NewReviewCapacity(2)).
+					Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "internal error",
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().UpdateTeamReviewCapacity(domain.TeamName("backend"), domain.NewReviewCapacity(2)).
+					Return(domain.Team{}, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), nil)
+			got, err := service.SetTeamReviewCapacity("backend", domain.NewReviewCapacity(2))
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, domain.NewReviewCapacity(2), got.Settings.DefaultReviewCapacity)
+		})
+	}
+}
+
+func TestTeamsService_SetTeamRequiredReviewers(t *testing.T) {
+	tests := []struct {
+		name      string
+		required  int
+		repoSetup func(*mocks.TeamsRepository)
+		wantErr   error
+	}{
+		{
+			name:     "successful update",
+			required: 1,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				team := domain.NewTeam("backend")
+				team.Settings.RequiredReviewers = 1
+				mockRepo.EXPECT().UpdateTeamRequiredReviewers(domain.TeamName("backend"), 1).Return(team, nil)
+			},
+		},
+		{
+			name:      "negative count",
+			required:  -1,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:     "team not found",
+			required: 1,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().UpdateTeamRequiredReviewers(domain.TeamName("backend"), 1).
+					Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:     "internal error",
+			required: 1,
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().UpdateTeamRequiredReviewers(domain.TeamName("backend"), 1).
+					Return(domain.Team{}, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), nil)
+			got, err := service.SetTeamRequiredReviewers("backend", tt.required)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.required, got.Settings.RequiredReviewers)
+		})
+	}
+}
+
+func TestTeamsService_SetTeamMergePolicy(t *testing.T) {
+	one := 1
+	negative := -1
+	yes := true
+
+	tests := []struct {
+		name      string
+		policy    domain.MergePolicyOverride
+		repoSetup func(*mocks.TeamsRepository)
+		wantErr   error
+	}{
+		{
+			name:   "successful update",
+			policy: domain.MergePolicyOverride{MinApprovals: &one, RequireActiveReviewers: &yes},
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				team := domain.NewTeam("backend")
+				team.Settings.MergePolicy = domain.MergePolicyOverride{MinApprovals: &one, RequireActiveReviewers: &yes}
+				mockRepo.EXPECT().UpdateTeamMergePolicy(domain.TeamName("backend"), team.Settings.MergePolicy).Return(team, nil)
+			},
+		},
+		{
+			name:   "reset to global policy",
+			policy: domain.MergePolicyOverride{},
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().UpdateTeamMergePolicy(domain.TeamName("backend"), domain.MergePolicyOverride{}).
+					Return(domain.NewTeam("backend"), nil)
+			},
+		},
+		{
+			name:      "negative approvals",
+			policy:    domain.MergePolicyOverride{MinApprovals: &negative},
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {},
+			wantErr:   domain.ErrValidation,
+		},
+		{
+			name:   "team not found",
+			policy: domain.MergePolicyOverride{},
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().UpdateTeamMergePolicy(domain.TeamName("backend"), domain.MergePolicyOverride{}).
+					Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:   "internal error",
+			policy: domain.MergePolicyOverride{},
+			repoSetup: func(mockRepo *mocks.TeamsRepository) {
+				mockRepo.EXPECT().UpdateTeamMergePolicy(domain.TeamName("backend"), domain.MergePolicyOverride{}).
+					Return(domain.Team{}, errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewTeamsRepository(t)
+			tt.repoSetup(mockRepo)
+
+			service := servteams.NewTeamsService(&configs.BussinesLogic{}, mockRepo, mocks.NewReviewerSelector(t), nil)
+			got, err := service.SetTeamMergePolicy("backend", tt.policy)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.policy, got.Settings.MergePolicy)
+		})
+	}
+}
@@ -0,0 +1,386 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PullRequestService is an autogenerated mock type for the PullRequestService type
+type PullRequestService struct {
+	mock.Mock
+}
+
+type PullRequestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PullRequestService) EXPECT() *PullRequestService_Expecter {
+	return &PullRequestService_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields: ctx, id, version, audit
+func (_m *PullRequestService) Close(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id, version, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)); ok {
+		return rf(ctx, id, version, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) domain.PullRequest); ok {
+		r0 = rf(ctx, id, version, audit)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) error); ok {
+		r1 = rf(ctx, id, version, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type PullRequestService_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+//   - version int
+//   - audit domain.AssignmentAudit
+func (_e *PullRequestService_Expecter) Close(ctx interface{}, id interface{}, version interface{}, audit interface{}) *PullRequestService_Close_Call {
+	return &PullRequestService_Close_Call{Call: _e.mock.On("Close", ctx, id, version, audit)}
+}
+
+func (_c *PullRequestService_Close_Call) Run(run func(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit)) *PullRequestService_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(int), args[3].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_Close_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_Close_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_Close_Call) RunAndReturn(run func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)) *PullRequestService_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *PullRequestService) Get(ctx context.Context, id domain.PrId) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) (domain.PullRequest, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) domain.PullRequest); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type PullRequestService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+func (_e *PullRequestService_Expecter) Get(ctx interface{}, id interface{}) *PullRequestService_Get_Call {
+	return &PullRequestService_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *PullRequestService_Get_Call) Run(run func(ctx context.Context, id domain.PrId)) *PullRequestService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_Get_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_Get_Call) RunAndReturn(run func(context.Context, domain.PrId) (domain.PullRequest, error)) *PullRequestService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPullRequest provides a mock function with given fields: ctx, basePR
+func (_m *PullRequestService) NewPullRequest(ctx context.Context, basePR domain.PullRequestShort) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, basePR)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewPullRequest")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PullRequestShort) (domain.PullRequest, error)); ok {
+		return rf(ctx, basePR)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PullRequestShort) domain.PullRequest); ok {
+		r0 = rf(ctx, basePR)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PullRequestShort) error); ok {
+		r1 = rf(ctx, basePR)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_NewPullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewPullRequest'
+type PullRequestService_NewPullRequest_Call struct {
+	*mock.Call
+}
+
+// NewPullRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - basePR domain.PullRequestShort
+func (_e *PullRequestService_Expecter) NewPullRequest(ctx interface{}, basePR interface{}) *PullRequestService_NewPullRequest_Call {
+	return &PullRequestService_NewPullRequest_Call{Call: _e.mock.On("NewPullRequest", ctx, basePR)}
+}
+
+func (_c *PullRequestService_NewPullRequest_Call) Run(run func(ctx context.Context, basePR domain.PullRequestShort)) *PullRequestService_NewPullRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PullRequestShort))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_NewPullRequest_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_NewPullRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_NewPullRequest_Call) RunAndReturn(run func(context.Context, domain.PullRequestShort) (domain.PullRequest, error)) *PullRequestService_NewPullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ready provides a mock function with given fields: ctx, id, version, audit
+func (_m *PullRequestService) Ready(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id, version, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ready")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)); ok {
+		return rf(ctx, id, version, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) domain.PullRequest); ok {
+		r0 = rf(ctx, id, version, audit)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) error); ok {
+		r1 = rf(ctx, id, version, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_Ready_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ready'
+type PullRequestService_Ready_Call struct {
+	*mock.Call
+}
+
+// Ready is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+//   - version int
+//   - audit domain.AssignmentAudit
+func (_e *PullRequestService_Expecter) Ready(ctx interface{}, id interface{}, version interface{}, audit interface{}) *PullRequestService_Ready_Call {
+	return &PullRequestService_Ready_Call{Call: _e.mock.On("Ready", ctx, id, version, audit)}
+}
+
+func (_c *PullRequestService_Ready_Call) Run(run func(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit)) *PullRequestService_Ready_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(int), args[3].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_Ready_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_Ready_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_Ready_Call) RunAndReturn(run func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)) *PullRequestService_Ready_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordMerge provides a mock function with given fields: ctx, id, version
+func (_m *PullRequestService) RecordMerge(ctx context.Context, id domain.PrId, version int) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordMerge")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int) (domain.PullRequest, error)); ok {
+		return rf(ctx, id, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int) domain.PullRequest); ok {
+		r0 = rf(ctx, id, version)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, int) error); ok {
+		r1 = rf(ctx, id, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_RecordMerge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordMerge'
+type PullRequestService_RecordMerge_Call struct {
+	*mock.Call
+}
+
+// RecordMerge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+//   - version int
+func (_e *PullRequestService_Expecter) RecordMerge(ctx interface{}, id interface{}, version interface{}) *PullRequestService_RecordMerge_Call {
+	return &PullRequestService_RecordMerge_Call{Call: _e.mock.On("RecordMerge", ctx, id, version)}
+}
+
+func (_c *PullRequestService_RecordMerge_Call) Run(run func(ctx context.Context, id domain.PrId, version int)) *PullRequestService_RecordMerge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_RecordMerge_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_RecordMerge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_RecordMerge_Call) RunAndReturn(run func(context.Context, domain.PrId, int) (domain.PullRequest, error)) *PullRequestService_RecordMerge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reopen provides a mock function with given fields: ctx, id, version, audit
+func (_m *PullRequestService) Reopen(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id, version, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reopen")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)); ok {
+		return rf(ctx, id, version, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) domain.PullRequest); ok {
+		r0 = rf(ctx, id, version, audit)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) error); ok {
+		r1 = rf(ctx, id, version, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_Reopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reopen'
+type PullRequestService_Reopen_Call struct {
+	*mock.Call
+}
+
+// Reopen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+//   - version int
+//   - audit domain.AssignmentAudit
+func (_e *PullRequestService_Expecter) Reopen(ctx interface{}, id interface{}, version interface{}, audit interface{}) *PullRequestService_Reopen_Call {
+	return &PullRequestService_Reopen_Call{Call: _e.mock.On("Reopen", ctx, id, version, audit)}
+}
+
+func (_c *PullRequestService_Reopen_Call) Run(run func(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit)) *PullRequestService_Reopen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(int), args[3].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_Reopen_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_Reopen_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_Reopen_Call) RunAndReturn(run func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)) *PullRequestService_Reopen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPullRequestService creates a new instance of PullRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPullRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PullRequestService {
+	mock := &PullRequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhooksRepository is an autogenerated mock type for the WebhooksRepository type
+type WebhooksRepository struct {
+	mock.Mock
+}
+
+type WebhooksRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhooksRepository) EXPECT() *WebhooksRepository_Expecter {
+	return &WebhooksRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMemberIdByIdentity provides a mock function with given fields: _a0, _a1
+func (_m *WebhooksRepository) GetMemberIdByIdentity(_a0 context.Context, _a1 domain.Identity) (domain.MemberId, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberIdByIdentity")
+	}
+
+	var r0 domain.MemberId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Identity) (domain.MemberId, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Identity) domain.MemberId); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.MemberId)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Identity) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhooksRepository_GetMemberIdByIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberIdByIdentity'
+type WebhooksRepository_GetMemberIdByIdentity_Call struct {
+	*mock.Call
+}
+
+// GetMemberIdByIdentity is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Identity
+func (_e *WebhooksRepository_Expecter) GetMemberIdByIdentity(_a0 interface{}, _a1 interface{}) *WebhooksRepository_GetMemberIdByIdentity_Call {
+	return &WebhooksRepository_GetMemberIdByIdentity_Call{Call: _e.mock.On("GetMemberIdByIdentity", _a0, _a1)}
+}
+
+func (_c *WebhooksRepository_GetMemberIdByIdentity_Call) Run(run func(_a0 context.Context, _a1 domain.Identity)) *WebhooksRepository_GetMemberIdByIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Identity))
+	})
+	return _c
+}
+
+func (_c *WebhooksRepository_GetMemberIdByIdentity_Call) Return(_a0 domain.MemberId, _a1 error) *WebhooksRepository_GetMemberIdByIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhooksRepository_GetMemberIdByIdentity_Call) RunAndReturn(run func(context.Context, domain.Identity) (domain.MemberId, error)) *WebhooksRepository_GetMemberIdByIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhooksRepository creates a new instance of WebhooksRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhooksRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhooksRepository {
+	mock := &WebhooksRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
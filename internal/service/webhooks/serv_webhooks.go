@@ -0,0 +1,25 @@
+package servwebhooks
+
+import (
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+)
+
+type WebhooksService struct {
+	repo         Repository
+	prs          PullRequestService
+	githubSecret []byte
+	gitlabToken  string
+}
+
+func NewWebhooksService(cfg *configs.BussinesLogic, r Repository, prs PullRequestService) *WebhooksService {
+	return &WebhooksService{
+		repo:         r,
+		prs:          prs,
+		githubSecret: []byte(cfg.GithubWebhookSecret),
+		gitlabToken:  cfg.GitlabWebhookToken,
+	}
+}
+
+type Repository interface {
+	WebhooksRepository
+}
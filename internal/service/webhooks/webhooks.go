@@ -0,0 +1,86 @@
+package servwebhooks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/pkg/signature"
+	"github.com/go-faster/errors"
+)
+
+type WebhooksRepository interface {
+	GetMemberIdByIdentity(context.Context, domain.Identity) (domain.MemberId, error)
+}
+
+// PullRequestService is the PR lifecycle the VCS events are translated into.
+type PullRequestService interface {
+	NewPullRequest(ctx context.Context, basePR domain.PullRequestShort) (domain.PullRequest, error)
+	Get(ctx context.Context, id domain.PrId) (domain.PullRequest, error)
+	RecordMerge(ctx context.Context, id domain.PrId, version int) (domain.PullRequest, error)
+	Ready(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error)
+	Close(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error)
+	Reopen(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error)
+}
+
+// VerifyGithubSignature checks X-Hub-Signature-256 against the raw body,
+// without a configured secret every delivery is rejected.
+func (ws *WebhooksService) VerifyGithubSignature(body []byte, header string) error {
+	if !signature.VerifySHA256(ws.githubSecret, body, header) {
+		return domain.ErrBadSignature
+	}
+	return nil
+}
+
+// VerifyGitlabToken checks the X-Gitlab-Token secret, without a configured token every delivery is rejected.
+func (ws *WebhooksService) VerifyGitlabToken(token string) error {
+	if !signature.EqualToken(ws.gitlabToken, token) {
+		return domain.ErrBadSignature
+	}
+	return nil
+}
+
+// HandlePullRequestEvent applies a VCS event to the PR it is about. Deliveries may repeat,
+// so an already created PR is returned as is and the transitions are applied regardless of version.
+// A merge is recorded without the merge policy, it has already happened in the VCS.
+func (ws *WebhooksService) HandlePullRequestEvent(ctx context.Context, e domain.PullRequestEvent) (domain.PullRequest, error) {
+	if !e.Valid() {
+		return domain.PullRequest{}, domain.ErrValidation
+	}
+
+	switch e.Action {
+	case domain.PrEventOpened:
+		return ws.open(ctx, e)
+	case domain.PrEventReady:
+		return ws.prs.Ready(ctx, e.PrId(), domain.AnyVersion, e.Audit())
+	case domain.PrEventClosed:
+		return ws.prs.Close(ctx, e.PrId(), domain.AnyVersion, e.Audit())
+	case domain.PrEventReopened:
+		return ws.prs.Reopen(ctx, e.PrId(), domain.AnyVersion, e.Audit())
+	case domain.PrEventMerged:
+		return ws.prs.RecordMerge(ctx, e.PrId(), domain.AnyVersion)
+	}
+	return domain.PullRequest{}, domain.ErrValidation
+}
+
+func (ws *WebhooksService) open(ctx context.Context, e domain.PullRequestEvent) (domain.PullRequest, error) {
+	author, err := ws.repo.GetMemberIdByIdentity(ctx, e.AuthorIdentity())
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.PullRequest{}, fmt.Errorf("%w: %s", domain.ErrUnknownIdentity, e.AuthorIdentity())
+		}
+		return domain.PullRequest{}, fmt.Errorf("%w: %w", domain.ErrInternal, err)
+	}
+
+	pr, err := ws.prs.NewPullRequest(ctx, domain.PullRequestShort{
+		Id:       e.PrId(),
+		External: e.ExternalKey(),
+		Name:     e.Title,
+		AuthorId: author,
+		Draft:    e.Draft,
+	})
+	if errors.Is(err, domain.ErrDuplicate) {
+		return ws.prs.Get(ctx, e.PrId())
+	}
+	return pr, err
+}
@@ -0,0 +1,206 @@
+package servwebhooks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/common/configs"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	servwebhooks "github.com/eragon-mdi/pr-reviewer-service/internal/service/webhooks"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/service/webhooks/mocks"
+	"github.com/eragon-mdi/pr-reviewer-service/pkg/signature"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooksService_VerifyGithubSignature(t *testing.T) {
+	body := []byte(`{"action":"opened"}`)
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		wantErr error
+	}{
+		{name: "valid signature", secret: "s3cret", header: signature.SignSHA256([]byte("s3cret"), body)},
+		{name: "other secret", secret: "s3cret", header: signature.SignSHA256([]byte("other"), body), wantErr: domain.ErrBadSignature},
+		{name: "missing header", secret: "s3cret", header: "", wantErr: domain.ErrBadSignature},
+		{name: "sha1 header", secret: "s3cret", header: "sha1=0123abcd", wantErr: domain.ErrBadSignature},
+		{name: "not hex", secret: "s3cret", header: "sha256=zz", wantErr: domain.ErrBadSignature},
+		{name: "no secret configured", secret: "", header: signature.SignSHA256(nil, body), wantErr: domain.ErrBadSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &configs.BussinesLogic{GithubWebhookSecret: tt.secret}
+			service := servwebhooks.NewWebhooksService(cfg, mocks.NewWebhooksRepository(t), mocks.NewPullRequestService(t))
+
+			err := service.VerifyGithubSignature(body, tt.header)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhooksService_VerifyGitlabToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		token   string
+		wantErr error
+	}{
+		{name: "valid token", secret: "s3cret", token: "s3cret"},
+		{name: "wrong token", secret: "s3cret", token: "s3cre", wantErr: domain.ErrBadSignature},
+		{name: "missing token", secret: "s3cret", token: "", wantErr: domain.ErrBadSignature},
+		{name: "no token configured", secret: "", token: "", wantErr: domain.ErrBadSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &configs.BussinesLogic{GitlabWebhookToken: tt.secret}
+			service := servwebhooks.NewWebhooksService(cfg, mocks.NewWebhooksRepository(t), mocks.NewPullRequestService(t))
+
+			err := service.VerifyGitlabToken(tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhooksService_HandlePullRequestEvent(t *testing.T) {
+	ctx := context.Background()
+	author := domain.MemberId("8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001")
+	event := domain.PullRequestEvent{
+		Provider:   domain.ProviderGithub,
+		Repository: "acme/api",
+		Number:     7,
+		Title:      "Add search",
+		Author:     "octocat",
+		Sender:     "hubot",
+	}
+	id := event.PrId()
+	key := event.ExternalKey()
+	audit := domain.AssignmentAudit{Actor: "github:hubot"}
+	pr := domain.PullRequest{Id: id, Name: "Add search", AuthorId: author}
+
+	tests := []struct {
+		name    string
+		action  domain.PrEventAction
+		draft   bool
+		setup   func(*mocks.WebhooksRepository, *mocks.PullRequestService)
+		wantErr error
+	}{
+		{
+			name:   "opened creates the pull request",
+			action: domain.PrEventOpened,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				repo.EXPECT().GetMemberIdByIdentity(ctx, domain.NewIdentity(domain.ProviderGithub, "octocat")).Return(author, nil)
+				prs.EXPECT().NewPullRequest(ctx, domain.PullRequestShort{Id: id, External: key, Name: "Add search", AuthorId: author}).Return(pr, nil)
+			},
+		},
+		{
+			name:   "opened draft",
+			action: domain.PrEventOpened,
+			draft:  true,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				repo.EXPECT().GetMemberIdByIdentity(ctx, domain.NewIdentity(domain.ProviderGithub, "octocat")).Return(author, nil)
+				prs.EXPECT().NewPullRequest(ctx, domain.PullRequestShort{Id: id, External: key, Name: "Add search", AuthorId: author, Draft: true}).Return(pr, nil)
+			},
+		},
+		{
+			name:   "redelivered opened returns the existing pull request",
+			action: domain.PrEventOpened,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				repo.EXPECT().GetMemberIdByIdentity(ctx, domain.NewIdentity(domain.ProviderGithub, "octocat")).Return(author, nil)
+				prs.EXPECT().NewPullRequest(ctx, domain.PullRequestShort{Id: id, External: key, Name: "Add search", AuthorId: author}).Return(domain.PullRequest{}, domain.ErrDuplicate)
+				prs.EXPECT().Get(ctx, id).Return(pr, nil)
+			},
+		},
+		{
+			name:   "unknown author",
+			action: domain.PrEventOpened,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				repo.EXPECT().GetMemberIdByIdentity(ctx, domain.NewIdentity(domain.ProviderGithub, "octocat")).Return("", domain.ErrNotFound)
+			},
+			wantErr: domain.ErrUnknownIdentity,
+		},
+		{
+			name:   "identity lookup failure",
+			action: domain.PrEventOpened,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				repo.EXPECT().GetMemberIdByIdentity(ctx, domain.NewIdentity(domain.ProviderGithub, "octocat")).Return("", errors.New("database error"))
+			},
+			wantErr: domain.ErrInternal,
+		},
+		{
+			name:   "ready for review",
+			action: domain.PrEventReady,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				prs.EXPECT().Ready(ctx, id, domain.AnyVersion, audit).Return(pr, nil)
+			},
+		},
+		{
+			name:   "closed",
+			action: domain.PrEventClosed,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				prs.EXPECT().Close(ctx, id, domain.AnyVersion, audit).Return(pr, nil)
+			},
+		},
+		{
+			name:   "reopened",
+			action: domain.PrEventReopened,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				prs.EXPECT().Reopen(ctx, id, domain.AnyVersion, audit).Return(pr, nil)
+			},
+		},
+		{
+			name:   "merged",
+			action: domain.PrEventMerged,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				prs.EXPECT().RecordMerge(ctx, id, domain.AnyVersion).Return(pr, nil)
+			},
+		},
+		{
+			name:   "merged pull request unknown to the service",
+			action: domain.PrEventMerged,
+			setup: func(repo *mocks.WebhooksRepository, prs *mocks.PullRequestService) {
+				prs.EXPECT().RecordMerge(ctx, id, domain.AnyVersion).Return(domain.PullRequest{}, domain.ErrNotFound)
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:    "unknown action",
+			action:  "labeled",
+			setup:   func(*mocks.WebhooksRepository, *mocks.PullRequestService) {},
+			wantErr: domain.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewWebhooksRepository(t)
+			mockPrs := mocks.NewPullRequestService(t)
+			tt.setup(mockRepo, mockPrs)
+
+			e := event
+			e.Action = tt.action
+			e.Draft = tt.draft
+
+			service := servwebhooks.NewWebhooksService(&configs.BussinesLogic{}, mockRepo, mockPrs)
+			got, err := service.HandlePullRequestEvent(ctx, e)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, pr, got)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Reconciler is an autogenerated mock type for the Reconciler type
+type Reconciler struct {
+	mock.Mock
+}
+
+type Reconciler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Reconciler) EXPECT() *Reconciler_Expecter {
+	return &Reconciler_Expecter{mock: &_m.Mock}
+}
+
+// ReconcileTeams provides a mock function with given fields: ctx, spec, apply
+func (_m *Reconciler) ReconcileTeams(ctx context.Context, spec domain.OrgSpec, apply bool) (domain.ReconcilePlan, error) {
+	ret := _m.Called(ctx, spec, apply)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileTeams")
+	}
+
+	var r0 domain.ReconcilePlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OrgSpec, bool) (domain.ReconcilePlan, error)); ok {
+		return rf(ctx, spec, apply)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OrgSpec, bool) domain.ReconcilePlan); ok {
+		r0 = rf(ctx, spec, apply)
+	} else {
+		r0 = ret.Get(0).(domain.ReconcilePlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.OrgSpec, bool) error); ok {
+		r1 = rf(ctx, spec, apply)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reconciler_ReconcileTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileTeams'
+type Reconciler_ReconcileTeams_Call struct {
+	*mock.Call
+}
+
+// ReconcileTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec domain.OrgSpec
+//   - apply bool
+func (_e *Reconciler_Expecter) ReconcileTeams(ctx interface{}, spec interface{}, apply interface{}) *Reconciler_ReconcileTeams_Call {
+	return &Reconciler_ReconcileTeams_Call{Call: _e.mock.On("ReconcileTeams", ctx, spec, apply)}
+}
+
+func (_c *Reconciler_ReconcileTeams_Call) Run(run func(ctx context.Context, spec domain.OrgSpec, apply bool)) *Reconciler_ReconcileTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.OrgSpec), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *Reconciler_ReconcileTeams_Call) Return(_a0 domain.ReconcilePlan, _a1 error) *Reconciler_ReconcileTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Reconciler_ReconcileTeams_Call) RunAndReturn(run func(context.Context, domain.OrgSpec, bool) (domain.ReconcilePlan, error)) *Reconciler_ReconcileTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReconciler creates a new instance of Reconciler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReconciler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Reconciler {
+	mock := &Reconciler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
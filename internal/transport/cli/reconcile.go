@@ -0,0 +1,163 @@
+package clitransport
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ExitOK    = 0
+	ExitError = 1
+	ExitUsage = 2
+)
+
+type Reconciler interface {
+	ReconcileTeams(ctx context.Context, spec domain.OrgSpec, apply bool) (domain.ReconcilePlan, error)
+}
+
+// Document is the teams-as-code file, it mirrors the body of POST /admin/reconcile.
+type Document struct {
+	Teams []DocumentTeam `yaml:"teams"`
+}
+
+type DocumentTeam struct {
+	TeamName string           `yaml:"team_name"`
+	Members  []DocumentMember `yaml:"members"`
+}
+
+// DocumentMember is_active defaults to true.
+type DocumentMember struct {
+	UserID   string `yaml:"user_id"`
+	Username string `yaml:"username"`
+	IsActive *bool  `yaml:"is_active"`
+}
+
+// Reconcile runs `reconcile -f teams.yaml [-apply]`, prints the plan and returns the exit code.
+func Reconcile(ctx context.Context, r Reconciler, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	file := fs.String("f", "", "teams YAML document")
+	apply := fs.Bool("apply", false, "apply the plan, otherwise only print it")
+
+	if err := fs.Parse(args); err != nil {
+		return ExitUsage
+	}
+	if *file == "" {
+		fmt.Fprintln(stderr, "reconcile: -f is required")
+		fs.Usage()
+		return ExitUsage
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		fmt.Fprintf(stderr, "reconcile: %v\n", err)
+		return ExitError
+	}
+	defer f.Close()
+
+	spec, err := ParseDocument(f)
+	if err != nil {
+		fmt.Fprintf(stderr, "reconcile: %v\n", err)
+		return ExitError
+	}
+
+	plan, err := r.ReconcileTeams(ctx, spec, *apply)
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			fmt.Fprintln(stderr, "reconcile: invalid document: duplicate team, bad user_id or conflicting member")
+			return ExitError
+		}
+		fmt.Fprintf(stderr, "reconcile: %v\n", err)
+		return ExitError
+	}
+
+	WritePlan(stdout, plan)
+	return ExitOK
+}
+
+// ParseDocument decodes the YAML document, unknown keys are rejected to catch typos.
+func ParseDocument(r io.Reader) (domain.OrgSpec, error) {
+	var doc Document
+
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+		return domain.OrgSpec{}, fmt.Errorf("failed to parse document: %w", err)
+	}
+
+	teams := make([]domain.Team, 0, len(doc.Teams))
+	for _, t := range doc.Teams {
+		mems := make([]domain.Member, 0, len(t.Members))
+		for _, m := range t.Members {
+			active := m.IsActive == nil || *m.IsActive
+			mems = append(mems, domain.MemberBuilder(domain.MemberId(m.UserID)).
+				Name(m.Username).
+				Status(domain.MemberStatusIsActiveByBool(active)).
+				Build())
+		}
+		teams = append(teams, domain.NewTeam(domain.TeamName(t.TeamName), mems...))
+	}
+
+	return domain.OrgSpec{Teams: teams}, nil
+}
+
+// WritePlan prints one line per change and a summary, reviews of removed and deactivated members
+// are marked with !, the applied replacements with >.
+func WritePlan(w io.Writer, p domain.ReconcilePlan) {
+	for _, t := range p.CreateTeams {
+		fmt.Fprintf(w, "+ team %s\n", t)
+	}
+	for _, id := range p.CreateMembers {
+		fmt.Fprintf(w, "+ user %s\n", id)
+	}
+	for _, c := range p.AddMembers {
+		fmt.Fprintf(w, "+ %s: %s\n", c.Team, c.MemberId)
+	}
+	for _, c := range p.RemoveMembers {
+		fmt.Fprintf(w, "- %s: %s\n", c.Team, c.MemberId)
+	}
+	for _, id := range p.Activate {
+		fmt.Fprintf(w, "~ activate %s\n", id)
+	}
+	for _, id := range p.Deactivate {
+		fmt.Fprintf(w, "~ deactivate %s\n", id)
+	}
+	for _, id := range p.Rename {
+		fmt.Fprintf(w, "~ rename %s\n", id)
+	}
+	for _, a := range p.Affected {
+		if slices.Contains(p.Deactivate, a.MemberId) {
+			fmt.Fprintf(w, "! %s: pull request %s replaces reviewer %s\n", a.Team, a.PrId, a.MemberId)
+			continue
+		}
+		fmt.Fprintf(w, "! %s: pull request %s keeps reviewer %s\n", a.Team, a.PrId, a.MemberId)
+	}
+	for _, r := range p.Reassigned {
+		fmt.Fprintf(w, "> pull request %s: %s -> %s\n", r.PrId, r.OldMemberId, r.NewMemberId)
+	}
+	for _, r := range p.Unfilled {
+		fmt.Fprintf(w, "> pull request %s: %s has no replacement\n", r.PrId, r.OldMemberId)
+	}
+
+	if p.Empty() {
+		fmt.Fprintln(w, "No changes, teams are in sync.")
+		return
+	}
+
+	fmt.Fprintf(w, "Plan: %d team(s) to create, %d membership(s) to add, %d to remove, %d user(s) to update, %d pull request(s) affected.\n",
+		len(p.CreateTeams), len(p.AddMembers), len(p.RemoveMembers),
+		len(p.CreateMembers)+len(p.Activate)+len(p.Deactivate)+len(p.Rename), len(p.Affected))
+	if p.Applied {
+		fmt.Fprintln(w, "Applied.")
+		return
+	}
+	fmt.Fprintln(w, "Dry run, rerun with -apply to apply.")
+}
@@ -0,0 +1,156 @@
+package clitransport
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/transport/cli/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testDocument = `
+teams:
+  - team_name: backend
+    members:
+      - user_id: 8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001
+        username: Alice
+      - user_id: 8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0002
+        username: Bob
+        is_active: false
+`
+
+func TestParseDocument(t *testing.T) {
+	spec, err := ParseDocument(strings.NewReader(testDocument))
+	require.NoError(t, err)
+
+	require.Len(t, spec.Teams, 1)
+	assert.Equal(t, domain.TeamName("backend"), spec.Teams[0].Name)
+	require.Len(t, spec.Teams[0].Members, 2)
+	assert.True(t, spec.Teams[0].Members[0].Status.IsActive())
+	assert.False(t, spec.Teams[0].Members[1].Status.IsActive())
+
+	_, err = ParseDocument(strings.NewReader("teams:\n  - name: backend\n"))
+	assert.Error(t, err)
+}
+
+func TestReconcile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "teams.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testDocument), 0o600))
+
+	plan := domain.ReconcilePlan{
+		CreateTeams:   []domain.TeamName{"backend"},
+		RemoveMembers: domain.MembershipChanges{{Team: "backend", MemberId: "u3"}},
+		Affected:      domain.ReviewAssignments{{PrId: "pr-1", Team: "backend", MemberId: "u3"}},
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		setup     func(*mocks.Reconciler)
+		wantCode  int
+		wantOut   []string
+		wantError string
+	}{
+		{
+			name: "dry run",
+			args: []string{"-f", path},
+			setup: func(r *mocks.Reconciler) {
+				r.EXPECT().ReconcileTeams(mock.Anything, mock.Anything, false).Return(plan, nil)
+			},
+			wantCode: ExitOK,
+			wantOut:  []string{"+ team backend", "- backend: u3", "! backend: pull request pr-1 keeps reviewer u3", "Dry run"},
+		},
+		{
+			name: "apply",
+			args: []string{"-f", path, "-apply"},
+			setup: func(r *mocks.Reconciler) {
+				applied := plan
+				applied.Applied = true
+				r.EXPECT().ReconcileTeams(mock.Anything, mock.Anything, true).Return(applied, nil)
+			},
+			wantCode: ExitOK,
+			wantOut:  []string{"Applied."},
+		},
+		{
+			name: "apply deactivation",
+			args: []string{"-f", path, "-apply"},
+			setup: func(r *mocks.Reconciler) {
+				r.EXPECT().ReconcileTeams(mock.Anything, mock.Anything, true).Return(domain.ReconcilePlan{
+					Deactivate: []domain.MemberId{"u2"},
+					Affected: domain.ReviewAssignments{
+						{PrId: "pr-1", Team: "backend", MemberId: "u2"},
+						{PrId: "pr-2", Team: "platform", MemberId: "u2"},
+					},
+					Reassigned: []domain.Reassignment{{PrId: "pr-1", OldMemberId: "u2", NewMemberId: "u1"}},
+					Unfilled:   []domain.Reassignment{{PrId: "pr-2", OldMemberId: "u2"}},
+					Applied:    true,
+				}, nil)
+			},
+			wantCode: ExitOK,
+			wantOut: []string{
+				"~ deactivate u2",
+				"! backend: pull request pr-1 replaces reviewer u2",
+				"> pull request pr-1: u2 -> u1",
+				"> pull request pr-2: u2 has no replacement",
+				"Applied.",
+			},
+		},
+		{
+			name: "in sync",
+			args: []string{"-f", path},
+			setup: func(r *mocks.Reconciler) {
+				r.EXPECT().ReconcileTeams(mock.Anything, mock.Anything, false).Return(domain.ReconcilePlan{}, nil)
+			},
+			wantCode: ExitOK,
+			wantOut:  []string{"No changes"},
+		},
+		{
+			name: "invalid document",
+			args: []string{"-f", path},
+			setup: func(r *mocks.Reconciler) {
+				r.EXPECT().ReconcileTeams(mock.Anything, mock.Anything, false).Return(domain.ReconcilePlan{}, domain.ErrValidation)
+			},
+			wantCode:  ExitError,
+			wantError: "invalid document",
+		},
+		{
+			name:      "missing file flag",
+			args:      []string{},
+			setup:     func(r *mocks.Reconciler) {},
+			wantCode:  ExitUsage,
+			wantError: "-f is required",
+		},
+		{
+			name:      "file not found",
+			args:      []string{"-f", filepath.Join(t.TempDir(), "missing.yaml")},
+			setup:     func(r *mocks.Reconciler) {},
+			wantCode:  ExitError,
+			wantError: "missing.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := mocks.NewReconciler(t)
+			tt.setup(r)
+
+			var stdout, stderr bytes.Buffer
+			code := Reconcile(context.Background(), r, tt.args, &stdout, &stderr)
+
+			assert.Equal(t, tt.wantCode, code)
+			for _, line := range tt.wantOut {
+				assert.Contains(t, stdout.String(), line)
+			}
+			if tt.wantError != "" {
+				assert.Contains(t, stderr.String(), tt.wantError)
+			}
+		})
+	}
+}
@@ -10,8 +10,9 @@ import (
 
 type ErrorResponse struct {
 	Error struct {
-		Code    string `json:"code"`
-		Message string `json:"message"`
+		Code    string   `json:"code"`
+		Message string   `json:"message"`
+		Details []string `json:"details,omitempty"`
 	} `json:"error"`
 }
 
@@ -21,11 +22,13 @@ func HTTPErrorHandler(err error, c echo.Context) {
 	if errors.As(err, &customHttpError) {
 		_ = c.JSON(customHttpError.HttpCode, ErrorResponse{
 			Error: struct {
-				Code    string `json:"code"`
-				Message string `json:"message"`
+				Code    string   `json:"code"`
+				Message string   `json:"message"`
+				Details []string `json:"details,omitempty"`
 			}{
 				Code:    string(customHttpError.Code),
 				Message: customHttpError.Message,
+				Details: customHttpError.Details,
 			},
 		})
 		return
@@ -39,8 +42,9 @@ func HTTPErrorHandler(err error, c echo.Context) {
 		}
 		_ = c.JSON(httpErr.Code, ErrorResponse{
 			Error: struct {
-				Code    string `json:"code"`
-				Message string `json:"message"`
+				Code    string   `json:"code"`
+				Message string   `json:"message"`
+				Details []string `json:"details,omitempty"`
 			}{
 				Code:    "BAD_REQUEST",
 				Message: msg.(string),
@@ -51,8 +55,9 @@ func HTTPErrorHandler(err error, c echo.Context) {
 
 	_ = c.JSON(http.StatusInternalServerError, ErrorResponse{
 		Error: struct {
-			Code    string `json:"code"`
-			Message string `json:"message"`
+			Code    string   `json:"code"`
+			Message string   `json:"message"`
+			Details []string `json:"details,omitempty"`
 		}{
 			Code:    "INTERNAL_ERROR",
 			Message: err.Error(),
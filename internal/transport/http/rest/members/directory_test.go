@@ -0,0 +1,285 @@
+package restmembers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/members/mocks"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRestMembers_GetUser(t *testing.T) {
+	userID := uuid.New().String()
+
+	tests := []struct {
+		name         string
+		userID       string
+		serviceSetup func(*mocks.MembersService)
+		wantErr      error
+	}{
+		{
+			name:   "found",
+			userID: userID,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("Member", domain.MemberId(userID)).Return(domain.MemberBuilder(domain.MemberId(userID)).
+					Name("Alice").
+					Profile(domain.MemberProfile{Email: "alice@example.com", Title: "Engineer"}).
+					Teams(domain.TeamMemberships{{Team: "backend", Primary: true}, {Team: "platform"}}).
+					Build(), nil)
+			},
+		},
+		{
+			name:   "found by identity",
+			userID: "github:alice",
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("github:alice")).
+					Return(domain.MemberId(userID), nil)
+				mockService.On("Member", domain.MemberId(userID)).Return(domain.MemberBuilder(domain.MemberId(userID)).
+					Name("Alice").
+					Profile(domain.MemberProfile{Email: "alice@example.com", Title: "Engineer"}).
+					Teams(domain.TeamMemberships{{Team: "backend", Primary: true}, {Team: "platform"}}).
+					Build(), nil)
+			},
+		},
+		{
+			name:         "invalid id",
+			userID:       "not-a-uuid",
+			serviceSetup: func(mockService *mocks.MembersService) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name:   "not found",
+			userID: userID,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("Member", domain.MemberId(userID)).Return(domain.Member{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:   "unknown identity",
+			userID: "github:ghost",
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("github:ghost")).
+					Return(domain.MemberId(""), domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewMembersService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.userID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.userID)
+
+			err := handler.GetUser(c)
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp struct {
+				User UserResponse `json:"user"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, "Alice", resp.User.Username)
+			assert.Equal(t, "backend", resp.User.TeamName)
+			assert.Equal(t, "alice@example.com", resp.User.Email)
+			assert.Equal(t, "Engineer", resp.User.Title)
+			assert.Len(t, resp.User.Teams, 2)
+		})
+	}
+}
+
+func TestRestMembers_ListUsers(t *testing.T) {
+	cursor := domain.MemberCursor{Name: "Alice", Id: domain.MemberId(uuid.New().String())}.String()
+
+	tests := []struct {
+		name         string
+		query        string
+		serviceSetup func(*mocks.MembersService)
+		wantUsers    []string
+		wantNext     string
+		wantErr      error
+	}{
+		{
+			name:  "filters and next cursor",
+			query: "?username=al&team_name=backend&is_active=true&limit=1",
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("ListMembers",
+					mock.MatchedBy(func(f domain.MemberFilter) bool {
+						return f.Name == "al" && f.Team == "backend" && f.Active != nil && *f.Active
+					}),
+					domain.MemberPageRequest{Limit: 1},
+				).Return(domain.MembersPage{
+					Items:      domain.Members{domain.MemberBuilder("u1").Name("Alice").Build()},
+					NextCursor: "next-page",
+				}, nil)
+			},
+			wantUsers: []string{"Alice"},
+			wantNext:  "next-page",
+		},
+		{
+			name:  "defaults with cursor",
+			query: "?cursor=" + cursor,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("ListMembers",
+					domain.MemberFilter{},
+					mock.MatchedBy(func(p domain.MemberPageRequest) bool {
+						return p.Limit == domain.DefaultPageLimit && p.After != nil && p.After.Name == "Alice"
+					}),
+				).Return(domain.MembersPage{Items: domain.Members{}}, nil)
+			},
+			wantUsers: []string{},
+		},
+		{
+			name:         "bad active flag",
+			query:        "?is_active=maybe",
+			serviceSetup: func(mockService *mocks.MembersService) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name:         "malformed cursor",
+			query:        "?cursor=not-a-cursor",
+			serviceSetup: func(mockService *mocks.MembersService) {},
+			wantErr:      ErrBadReqParam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewMembersService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodGet, "/users"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			err := handler.ListUsers(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp ListUsersResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			names := make([]string, 0, len(resp.Users))
+			for _, u := range resp.Users {
+				names = append(names, u.Username)
+			}
+			assert.Equal(t, tt.wantUsers, names)
+			assert.Equal(t, tt.wantNext, resp.NextCursor)
+		})
+	}
+}
+
+func TestRestMembers_UpdateUser(t *testing.T) {
+	userID := uuid.New().String()
+
+	tests := []struct {
+		name         string
+		body         string
+		serviceSetup func(*mocks.MembersService)
+		wantTitle    string
+		wantErr      error
+	}{
+		{
+			name: "partial update",
+			body: `{"title":"Staff Engineer"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("UpdateMember", domain.MemberId(userID),
+					mock.MatchedBy(func(p domain.MemberPatch) bool {
+						return p.Name == nil && p.Email == nil && p.Title != nil && *p.Title == "Staff Engineer"
+					}),
+				).Return(domain.MemberBuilder(domain.MemberId(userID)).
+					Name("Alice").
+					Profile(domain.MemberProfile{Title: "Staff Engineer"}).
+					Build(), nil)
+			},
+			wantTitle: "Staff Engineer",
+		},
+		{
+			name:         "invalid email",
+			body:         `{"email":"not-an-email"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name: "empty patch",
+			body: `{}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("UpdateMember", domain.MemberId(userID), domain.MemberPatch{}).
+					Return(domain.Member{}, domain.ErrValidation)
+			},
+			wantErr: ErrBadReqBody,
+		},
+		{
+			name: "not found",
+			body: `{"username":"Bob"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("UpdateMember", domain.MemberId(userID), mock.Anything).
+					Return(domain.Member{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewMembersService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodPatch, "/users/"+userID, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(userID)
+
+			err := handler.UpdateUser(c)
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp struct {
+				User UserResponse `json:"user"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantTitle, resp.User.Title)
+		})
+	}
+}
@@ -1,22 +1,107 @@
 package restmembers
 
-import "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+import (
+	"strconv"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
 
 type SetIsActiveRequest struct {
-	UserID   string `json:"user_id" validate:"required,uuid"`
-	IsActive bool   `json:"is_active"`
+	UserID              string `json:"user_id" validate:"required,member_ref"`
+	IsActive            bool   `json:"is_active"`
+	ReassignOpenReviews *bool  `json:"reassign_open_reviews"`
+}
+
+type SetReviewCapacityRequest struct {
+	UserID         string `json:"user_id" validate:"required,member_ref"`
+	ReviewCapacity *int   `json:"review_capacity" validate:"omitempty,gte=0"`
+}
+
+type SetPrimaryTeamRequest struct {
+	UserID   string `json:"user_id" validate:"required,member_ref"`
+	TeamName string `json:"team_name" validate:"required"`
+}
+
+// UserReviewsRequest status is a comma separated list of PR statuses, cursor is next_cursor of the previous page.
+type UserReviewsRequest struct {
+	Status string `query:"status"`
+	Cursor string `query:"cursor"`
+	Limit  int    `query:"limit"`
+}
+
+type GetUserRequest struct {
+	UserID string `param:"id" validate:"required,member_ref"`
+}
+
+// ListUsersRequest username matches a substring of the name, is_active is true or false, empty matches any.
+type ListUsersRequest struct {
+	Username string `query:"username"`
+	TeamName string `query:"team_name"`
+	IsActive string `query:"is_active" validate:"omitempty,oneof=true false"`
+	Cursor   string `query:"cursor"`
+	Limit    int    `query:"limit"`
+}
+
+// UpdateUserRequest changes only the present fields, an empty email or title clears it.
+type UpdateUserRequest struct {
+	UserID   string  `param:"id" validate:"required,member_ref"`
+	Username *string `json:"username" validate:"omitempty,max=100"`
+	Email    *string `json:"email" validate:"omitempty,email,max=255"`
+	Title    *string `json:"title" validate:"omitempty,max=100"`
+}
+
+// LinkIdentityRequest provider is a lower case name like github, external_id is the login there.
+type LinkIdentityRequest struct {
+	UserID     string `json:"user_id" validate:"required,member_ref"`
+	Provider   string `json:"provider" validate:"required,max=50"`
+	ExternalID string `json:"external_id" validate:"required,max=255"`
+}
+
+type UnlinkIdentityRequest = LinkIdentityRequest
+
+type GetUserIdentitiesRequest struct {
+	UserID string `param:"id" validate:"required,member_ref"`
+}
+
+type IdentityResponse struct {
+	UserID     string `json:"user_id"`
+	Provider   string `json:"provider"`
+	ExternalID string `json:"external_id"`
+}
+
+type ListUsersResponse struct {
+	Users      []UserResponse `json:"users"`
+	NextCursor string         `json:"next_cursor,omitempty"`
 }
 
 type UserResponse struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	UserID         string                   `json:"user_id"`
+	Username       string                   `json:"username"`
+	TeamName       string                   `json:"team_name"`
+	Teams          []TeamMembershipResponse `json:"teams"`
+	IsActive       bool                     `json:"is_active"`
+	ReviewCapacity *int                     `json:"review_capacity"`
+	Email          string                   `json:"email,omitempty"`
+	Title          string                   `json:"title,omitempty"`
+}
+
+type TeamMembershipResponse struct {
+	TeamName  string `json:"team_name"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+type ReassignmentResponse struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
 }
 
 type UserReviewsResponse struct {
-	UserID       string                `json:"user_id"`
-	PullRequests []PullRequestShortDTO `json:"pull_requests"`
+	UserID         string                `json:"user_id"`
+	ReviewCapacity *int                  `json:"review_capacity"`
+	OpenReviews    int                   `json:"open_reviews"`
+	PullRequests   []PullRequestShortDTO `json:"pull_requests"`
+	NextCursor     string                `json:"next_cursor,omitempty"`
 }
 
 type PullRequestShortDTO struct {
@@ -32,32 +117,139 @@ func (req *SetIsActiveRequest) domain() domain.Member {
 		Build()
 }
 
+func (req *SetReviewCapacityRequest) domain() domain.Member {
+	capacity := domain.UnlimitedReviewCapacity()
+	if req.ReviewCapacity != nil {
+		capacity = domain.NewReviewCapacity(*req.ReviewCapacity)
+	}
+	return domain.MemberBuilder(domain.MemberId(req.UserID)).
+		Capacity(capacity).
+		Build()
+}
+
+func (req *SetPrimaryTeamRequest) domain() domain.Member {
+	member := domain.MemberBuilder(domain.MemberId(req.UserID)).Build()
+	member.Team = domain.TeamName(req.TeamName)
+	return member
+}
+
+func (req *UserReviewsRequest) domain() ([]domain.PrStatus, domain.PageRequest, error) {
+	statuses, err := domain.ParsePrStatuses(req.Status)
+	if err != nil {
+		return nil, domain.PageRequest{}, err
+	}
+
+	page, err := domain.NewPageRequest(req.Cursor, req.Limit)
+	if err != nil {
+		return nil, domain.PageRequest{}, err
+	}
+
+	return statuses, page, nil
+}
+
+func (req *ListUsersRequest) domain() (domain.MemberFilter, domain.MemberPageRequest, error) {
+	f := domain.MemberFilter{
+		Name: req.Username,
+		Team: domain.TeamName(req.TeamName),
+	}
+	if req.IsActive != "" {
+		active, err := strconv.ParseBool(req.IsActive)
+		if err != nil {
+			return domain.MemberFilter{}, domain.MemberPageRequest{}, err
+		}
+		f.Active = &active
+	}
+
+	page, err := domain.NewMemberPageRequest(req.Cursor, req.Limit)
+	if err != nil {
+		return domain.MemberFilter{}, domain.MemberPageRequest{}, err
+	}
+
+	return f, page, nil
+}
+
+func (req *UpdateUserRequest) domain() domain.MemberPatch {
+	return domain.MemberPatch{
+		Name:  req.Username,
+		Email: req.Email,
+		Title: req.Title,
+	}
+}
+
+func (req *LinkIdentityRequest) domain() (domain.MemberId, domain.Identity) {
+	return domain.MemberId(req.UserID), domain.NewIdentity(domain.IdentityProvider(req.Provider), req.ExternalID)
+}
+
+func identitiesResponse(id domain.MemberId, identities []domain.Identity) []IdentityResponse {
+	res := make([]IdentityResponse, 0, len(identities))
+	for _, identity := range identities {
+		res = append(res, identityResponse(domain.MemberIdentity{MemberId: id, Identity: identity}))
+	}
+	return res
+}
+
+func identityResponse(mi domain.MemberIdentity) IdentityResponse {
+	return IdentityResponse{
+		UserID:     mi.MemberId.String(),
+		Provider:   mi.Identity.Provider.String(),
+		ExternalID: mi.Identity.ExternalId,
+	}
+}
+
 func userResponse(m domain.Member) UserResponse {
 	return UserResponse{
-		UserID:   m.Id.String(),
-		Username: m.Name,
-		TeamName: m.Team.String(),
-		IsActive: m.Status.IsActive(),
+		UserID:         m.Id.String(),
+		Username:       m.Name,
+		TeamName:       m.Team.String(),
+		Teams:          teamMemberships(m.Teams),
+		IsActive:       m.Status.IsActive(),
+		ReviewCapacity: reviewCapacity(m.Capacity),
+		Email:          m.Profile.Email,
+		Title:          m.Profile.Title,
+	}
+}
+
+func listUsersResponse(p domain.MembersPage) ListUsersResponse {
+	users := make([]UserResponse, 0, len(p.Items))
+	for _, m := range p.Items {
+		users = append(users, userResponse(m))
+	}
+	return ListUsersResponse{Users: users, NextCursor: p.NextCursor}
+}
+
+func teamMemberships(tms domain.TeamMemberships) []TeamMembershipResponse {
+	res := make([]TeamMembershipResponse, 0, len(tms))
+	for _, tm := range tms {
+		res = append(res, TeamMembershipResponse{TeamName: tm.Team.String(), IsPrimary: tm.Primary})
 	}
+	return res
 }
 
-func userReviewsResponse(m domain.Member) UserReviewsResponse {
+// userReviewsResponse lists one page of the reviews, open_reviews always counts all open ones.
+func userReviewsResponse(m domain.Member, nextCursor string) UserReviewsResponse {
 	return UserReviewsResponse{
-		UserID:       m.Id.String(),
-		PullRequests: pullRequestShorts(m.Reviews),
+		UserID:         m.Id.String(),
+		ReviewCapacity: reviewCapacity(m.Capacity),
+		OpenReviews:    m.OpenReviews,
+		PullRequests:   pullRequestShorts(m.Reviews),
+		NextCursor:     nextCursor,
 	}
 }
 
-func pullRequestShort(pr domain.PullRequestShort) PullRequestShortDTO {
-	status := "OPEN"
-	if pr.Status == domain.PrStatusMerged {
-		status = "MERGED"
+func reviewCapacity(c domain.ReviewCapacity) *int {
+	limit, ok := c.Limit()
+	if !ok {
+		return nil
 	}
+	return &limit
+}
+
+func pullRequestShort(pr domain.PullRequestShort) PullRequestShortDTO {
 	return PullRequestShortDTO{
 		PullRequestID:   pr.Id.String(),
 		PullRequestName: pr.Name.String(),
 		AuthorID:        pr.AuthorId.String(),
-		Status:          status,
+		Status:          pr.Status.String(),
 	}
 }
 
@@ -72,3 +264,15 @@ func pullRequestShorts(prs domain.PullRequests) []PullRequestShortDTO {
 	}
 	return res
 }
+
+func reassignmentsResponse(rs []domain.Reassignment) []ReassignmentResponse {
+	res := make([]ReassignmentResponse, 0, len(rs))
+	for _, r := range rs {
+		res = append(res, ReassignmentResponse{
+			PullRequestID: r.PrId.String(),
+			OldReviewerID: r.OldMemberId.String(),
+			NewReviewerID: r.NewMemberId.String(),
+		})
+	}
+	return res
+}
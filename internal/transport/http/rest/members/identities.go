@@ -0,0 +1,152 @@
+package restmembers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/labstack/echo/v4"
+)
+
+func (mt *RestMembers) UserLinkIdentity(c echo.Context) error {
+	var req = &LinkIdentityRequest{}
+
+	l := mt.l.With("req", req)
+	l.Infof("UserLinkIdentity called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	id, err := mt.resolveMember(c, req.UserID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+	req.UserID = id.String()
+
+	id, identity := req.domain()
+	linked, err := mt.s.LinkIdentity(ctx(c), id, identity)
+	if err != nil {
+		l.Errorf("failed to link identity: %v", err)
+
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		if errors.Is(err, domain.ErrDuplicate) {
+			return domain.HttpErrIdentityExists()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("user_id", linked.MemberId.String(), "identity", linked.Identity.String())
+	l.Infof("identity linked successfully")
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"identity": identityResponse(linked),
+	})
+}
+
+// UserUnlinkIdentity detaches an external account and returns the accounts left.
+func (mt *RestMembers) UserUnlinkIdentity(c echo.Context) error {
+	var req = &UnlinkIdentityRequest{}
+
+	l := mt.l.With("req", req)
+	l.Infof("UserUnlinkIdentity called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	id, err := mt.resolveMember(c, req.UserID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+	req.UserID = id.String()
+
+	id, identity := req.domain()
+	if err := mt.s.UnlinkIdentity(ctx(c), id, identity); err != nil {
+		l.Errorf("failed to unlink identity: %v", err)
+
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	identities, err := mt.s.MemberIdentities(ctx(c), id)
+	if err != nil {
+		l.Errorf("failed to get identities: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("user_id", id.String(), "identity", identity.String())
+	l.Infof("identity unlinked successfully")
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"identities": identitiesResponse(id, identities),
+	})
+}
+
+func (mt *RestMembers) GetUserIdentities(c echo.Context) error {
+	var req = &GetUserIdentitiesRequest{}
+
+	l := mt.l.With("req", req)
+	l.Infof("GetUserIdentities called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	id, err := mt.resolveMember(c, req.UserID, ErrBadReqParam)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+
+	identities, err := mt.s.MemberIdentities(ctx(c), id)
+	if err != nil {
+		l.Errorf("failed to get identities: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("user_id", id.String(), "count", len(identities))
+	l.Infof("identities fetched successfully")
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"identities": identitiesResponse(id, identities),
+	})
+}
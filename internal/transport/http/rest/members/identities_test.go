@@ -0,0 +1,243 @@
+package restmembers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/members/mocks"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRestMembers_UserLinkIdentity(t *testing.T) {
+	userID := uuid.New().String()
+	identity := domain.NewIdentity(domain.ProviderGithub, "octocat")
+
+	tests := []struct {
+		name         string
+		body         string
+		serviceSetup func(*mocks.MembersService)
+		wantErr      error
+	}{
+		{
+			name: "linked",
+			body: `{"user_id":"` + userID + `","provider":"github","external_id":"octocat"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("LinkIdentity", mock.Anything, domain.MemberId(userID), identity).
+					Return(domain.MemberIdentity{MemberId: domain.MemberId(userID), Identity: identity}, nil)
+			},
+		},
+		{
+			name:         "missing external id",
+			body:         `{"user_id":"` + userID + `","provider":"github"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name: "bad provider",
+			body: `{"user_id":"` + userID + `","provider":"Git Hub","external_id":"octocat"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("LinkIdentity", mock.Anything, domain.MemberId(userID), mock.Anything).
+					Return(domain.MemberIdentity{}, domain.ErrValidation)
+			},
+			wantErr: ErrBadReqBody,
+		},
+		{
+			name: "user not found",
+			body: `{"user_id":"` + userID + `","provider":"github","external_id":"octocat"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("LinkIdentity", mock.Anything, domain.MemberId(userID), identity).
+					Return(domain.MemberIdentity{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name: "linked to another user",
+			body: `{"user_id":"` + userID + `","provider":"github","external_id":"octocat"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("LinkIdentity", mock.Anything, domain.MemberId(userID), identity).
+					Return(domain.MemberIdentity{}, domain.ErrDuplicate)
+			},
+			wantErr: domain.HttpErrIdentityExists(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewMembersService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodPost, "/users/linkIdentity", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err := handler.UserLinkIdentity(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp struct {
+				Identity IdentityResponse `json:"identity"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, IdentityResponse{UserID: userID, Provider: "github", ExternalID: "octocat"}, resp.Identity)
+		})
+	}
+}
+
+func TestRestMembers_UserUnlinkIdentity(t *testing.T) {
+	userID := uuid.New().String()
+	identity := domain.NewIdentity(domain.ProviderGithub, "octocat")
+	left := []domain.Identity{domain.NewIdentity(domain.ProviderGitlab, "octo")}
+
+	tests := []struct {
+		name         string
+		body         string
+		serviceSetup func(*mocks.MembersService)
+		wantErr      error
+	}{
+		{
+			name: "unlinked",
+			body: `{"user_id":"` + userID + `","provider":"github","external_id":"octocat"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("UnlinkIdentity", mock.Anything, domain.MemberId(userID), identity).Return(nil)
+				mockService.On("MemberIdentities", mock.Anything, domain.MemberId(userID)).Return(left, nil)
+			},
+		},
+		{
+			name: "user referenced by identity",
+			body: `{"user_id":"github:octocat","provider":"github","external_id":"octocat"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("github:octocat")).
+					Return(domain.MemberId(userID), nil)
+				mockService.On("UnlinkIdentity", mock.Anything, domain.MemberId(userID), identity).Return(nil)
+				mockService.On("MemberIdentities", mock.Anything, domain.MemberId(userID)).Return(left, nil)
+			},
+		},
+		{
+			name: "not linked",
+			body: `{"user_id":"` + userID + `","provider":"github","external_id":"octocat"}`,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("UnlinkIdentity", mock.Anything, domain.MemberId(userID), identity).Return(domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewMembersService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodPost, "/users/unlinkIdentity", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err := handler.UserUnlinkIdentity(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp struct {
+				Identities []IdentityResponse `json:"identities"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, []IdentityResponse{{UserID: userID, Provider: "gitlab", ExternalID: "octo"}}, resp.Identities)
+		})
+	}
+}
+
+func TestRestMembers_GetUserIdentities(t *testing.T) {
+	userID := uuid.New().String()
+
+	tests := []struct {
+		name         string
+		ref          string
+		serviceSetup func(*mocks.MembersService)
+		wantErr      error
+	}{
+		{
+			name: "listed by uuid",
+			ref:  userID,
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("MemberIdentities", mock.Anything, domain.MemberId(userID)).
+					Return([]domain.Identity{domain.NewIdentity(domain.ProviderGithub, "octocat")}, nil)
+			},
+		},
+		{
+			name: "listed by identity",
+			ref:  "github:octocat",
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("github:octocat")).
+					Return(domain.MemberId(userID), nil)
+				mockService.On("MemberIdentities", mock.Anything, domain.MemberId(userID)).
+					Return([]domain.Identity{domain.NewIdentity(domain.ProviderGithub, "octocat")}, nil)
+			},
+		},
+		{
+			name: "unknown identity",
+			ref:  "github:ghost",
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("github:ghost")).
+					Return(domain.MemberId(""), domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:         "neither uuid nor identity",
+			ref:          "u1",
+			serviceSetup: func(mockService *mocks.MembersService) {},
+			wantErr:      ErrBadReqParam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewMembersService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.ref+"/identities", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.ref)
+
+			err := handler.GetUserIdentities(c)
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"external_id":"octocat"`)
+		})
+	}
+}
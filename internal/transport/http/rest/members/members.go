@@ -7,7 +7,6 @@ import (
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	"github.com/eragon-mdi/pr-reviewer-service/pkg/validator"
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
@@ -17,8 +16,17 @@ var (
 )
 
 type MembersService interface {
-	MemberReviews(id domain.MemberId) (domain.Member, error)
-	SetMemberIsActive(member domain.Member) (domain.Member, error)
+	MemberReviews(id domain.MemberId, statuses []domain.PrStatus, page domain.PageRequest) (domain.Member, string, error)
+	SetMemberIsActive(ctx context.Context, member domain.Member, reassign *bool) (domain.Member, domain.DeactivationReport, error)
+	SetMemberReviewCapacity(member domain.Member) (domain.Member, error)
+	SetMemberPrimaryTeam(member domain.Member) (domain.Member, error)
+	Member(id domain.MemberId) (domain.Member, error)
+	ListMembers(filter domain.MemberFilter, page domain.MemberPageRequest) (domain.MembersPage, error)
+	UpdateMember(id domain.MemberId, patch domain.MemberPatch) (domain.Member, error)
+	LinkIdentity(ctx context.Context, id domain.MemberId, identity domain.Identity) (domain.MemberIdentity, error)
+	UnlinkIdentity(ctx context.Context, id domain.MemberId, identity domain.Identity) error
+	MemberIdentities(ctx context.Context, id domain.MemberId) ([]domain.Identity, error)
+	ResolveMember(ctx context.Context, ref domain.MemberRef) (domain.MemberId, error)
 }
 
 func (mt *RestMembers) UserSetIsActive(c echo.Context) error {
@@ -37,8 +45,15 @@ func (mt *RestMembers) UserSetIsActive(c echo.Context) error {
 		return ErrBadReqBody
 	}
 
+	id, err := mt.resolveMember(c, req.UserID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+	req.UserID = id.String()
+
 	member := req.domain()
-	updMember, err := mt.s.SetMemberIsActive(member)
+	updMember, report, err := mt.s.SetMemberIsActive(c.Request().Context(), member, req.ReassignOpenReviews)
 	if err != nil {
 		l.Errorf("failed to set member is active: %v", err)
 
@@ -51,6 +66,94 @@ func (mt *RestMembers) UserSetIsActive(c echo.Context) error {
 	l = l.With("user_id", updMember.Id.String())
 	l.Infof("member status updated successfully")
 
+	resp := echo.Map{
+		"user": userResponse(updMember),
+	}
+	if len(report.Deactivated) > 0 {
+		resp["reassigned"] = reassignmentsResponse(report.Reassigned)
+		resp["unfilled"] = reassignmentsResponse(report.Unfilled)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (mt *RestMembers) UserSetReviewCapacity(c echo.Context) error {
+	var req = &SetReviewCapacityRequest{}
+
+	l := mt.l.With("req", req)
+	l.Infof("UserSetReviewCapacity called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	id, err := mt.resolveMember(c, req.UserID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+	req.UserID = id.String()
+
+	updMember, err := mt.s.SetMemberReviewCapacity(req.domain())
+	if err != nil {
+		l.Errorf("failed to set member review capacity: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("user_id", updMember.Id.String())
+	l.Infof("member review capacity updated successfully")
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"user": userResponse(updMember),
+	})
+}
+
+func (mt *RestMembers) UserSetPrimaryTeam(c echo.Context) error {
+	var req = &SetPrimaryTeamRequest{}
+
+	l := mt.l.With("req", req)
+	l.Infof("UserSetPrimaryTeam called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	id, err := mt.resolveMember(c, req.UserID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+	req.UserID = id.String()
+
+	updMember, err := mt.s.SetMemberPrimaryTeam(req.domain())
+	if err != nil {
+		l.Errorf("failed to set member primary team: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("user_id", updMember.Id.String())
+	l.Infof("member primary team updated successfully")
+
 	return c.JSON(http.StatusOK, echo.Map{
 		"user": userResponse(updMember),
 	})
@@ -62,12 +165,30 @@ func (mt *RestMembers) GetUserPeviewsById(c echo.Context) error {
 	l := mt.l.With("user_id", userID)
 	l.Infof("GetUserPeviewsById called")
 
-	if err := idValidate(userID); err != nil {
-		l.Errorf("invalid user_id format: %v", err)
+	if !domain.MemberRef(userID).Valid() {
+		l.Errorf("invalid user_id format")
 		return ErrBadReqParam
 	}
 
-	member, err := mt.s.MemberReviews(domain.MemberId(userID))
+	id, err := mt.resolveMember(c, userID, ErrBadReqParam)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+
+	var req = &UserReviewsRequest{}
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind query: %v", err)
+		return ErrBadReqParam
+	}
+
+	statuses, page, err := req.domain()
+	if err != nil {
+		l.Errorf("invalid reviews query: %v", err)
+		return ErrBadReqParam
+	}
+
+	member, next, err := mt.s.MemberReviews(id, statuses, page)
 	if err != nil && !errors.Is(err, domain.ErrNoContent) {
 		l.Errorf("failed to get member reviews: %v", err)
 
@@ -80,16 +201,150 @@ func (mt *RestMembers) GetUserPeviewsById(c echo.Context) error {
 	l = l.With("user_id", member.Id.String())
 	l.Infof("member reviews fetched successfully")
 
-	return c.JSON(http.StatusOK, userReviewsResponse(member))
+	return c.JSON(http.StatusOK, userReviewsResponse(member, next))
+}
+
+func (mt *RestMembers) GetUser(c echo.Context) error {
+	var req = &GetUserRequest{}
+
+	l := mt.l.With("req", req)
+	l.Infof("GetUser called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	id, err := mt.resolveMember(c, req.UserID, ErrBadReqParam)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+
+	member, err := mt.s.Member(id)
+	if err != nil {
+		l.Errorf("failed to get member: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("user_id", member.Id.String())
+	l.Infof("member fetched successfully")
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"user": userResponse(member),
+	})
+}
+
+func (mt *RestMembers) ListUsers(c echo.Context) error {
+	var req = &ListUsersRequest{}
+
+	l := mt.l.With("req", req)
+	l.Infof("ListUsers called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	filter, page, err := req.domain()
+	if err != nil {
+		l.Errorf("invalid list query: %v", err)
+		return ErrBadReqParam
+	}
+
+	members, err := mt.s.ListMembers(filter, page)
+	if err != nil {
+		l.Errorf("failed to list members: %v", err)
+		return domain.ErrInternal
+	}
+
+	l = l.With("count", len(members.Items))
+	l.Infof("members listed successfully")
+
+	return c.JSON(http.StatusOK, listUsersResponse(members))
+}
+
+func (mt *RestMembers) UpdateUser(c echo.Context) error {
+	var req = &UpdateUserRequest{}
+
+	l := mt.l.With("req", req)
+	l.Infof("UpdateUser called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	id, err := mt.resolveMember(c, req.UserID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+	req.UserID = id.String()
+
+	member, err := mt.s.UpdateMember(id, req.domain())
+	if err != nil {
+		l.Errorf("failed to update member: %v", err)
+
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("user_id", member.Id.String())
+	l.Infof("member updated successfully")
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"user": userResponse(member),
+	})
 }
 
 func validate(c echo.Context, structure any) error {
 	return validator.Validate(ctx(c), structure)
 }
 
-func idValidate(id string) error {
-	_, err := uuid.Parse(id)
-	return err
+// resolveMember turns a validated user reference into the member id, UUIDs are taken as is
+// and an unknown provider:external_id is 404.
+func (mt *RestMembers) resolveMember(c echo.Context, ref string, badReq error) (domain.MemberId, error) {
+	if id, ok := domain.MemberRef(ref).MemberId(); ok {
+		return id, nil
+	}
+
+	id, err := mt.s.ResolveMember(ctx(c), domain.MemberRef(ref))
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return "", badReq
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return "", domain.HttpErrNotFound()
+		}
+		return "", domain.ErrInternal
+	}
+
+	return id, nil
 }
 
 func ctx(c echo.Context) context.Context {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/members/mocks"
@@ -31,6 +32,7 @@ func TestRestMembers_UserSetIsActive(t *testing.T) {
 		requestBody  interface{}
 		serviceSetup func(*mocks.MembersService, string)
 		wantStatus   int
+		wantBody     []string
 		wantErr      error
 	}{
 		{
@@ -42,13 +44,15 @@ func TestRestMembers_UserSetIsActive(t *testing.T) {
 			serviceSetup: func(mockService *mocks.MembersService, userID string) {
 				mockService.On(
 					"SetMemberIsActive",
+					mock.Anything,
 					mock.MatchedBy(func(m domain.Member) bool { return string(m.Id) == userID }),
+					(*bool)(nil),
 				).Return(domain.Member{
 					Id:     domain.MemberId(userID),
 					Name:   "Test User",
 					Status: domain.MemberStatusActive,
 					Team:   domain.TeamName("backend"),
-				}, nil)
+				}, domain.DeactivationReport{}, nil)
 			},
 			wantStatus: http.StatusOK,
 		},
@@ -61,15 +65,43 @@ func TestRestMembers_UserSetIsActive(t *testing.T) {
 			serviceSetup: func(mockService *mocks.MembersService, userID string) {
 				mockService.On(
 					"SetMemberIsActive",
+					mock.Anything,
 					mock.MatchedBy(func(m domain.Member) bool { return string(m.Id) == userID }),
+					(*bool)(nil),
 				).Return(domain.Member{
 					Id:     domain.MemberId(userID),
 					Name:   "Test User",
 					Status: domain.MemberStatusInactive,
 					Team:   domain.TeamName("backend"),
+				}, domain.DeactivationReport{}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "deactivation reassigns open reviews",
+			requestBody: SetIsActiveRequest{
+				UserID:              uuid.New().String(),
+				IsActive:            false,
+				ReassignOpenReviews: func() *bool { b := true; return &b }(),
+			},
+			serviceSetup: func(mockService *mocks.MembersService, userID string) {
+				mockService.On(
+					"SetMemberIsActive",
+					mock.Anything,
+					mock.MatchedBy(func(m domain.Member) bool { return string(m.Id) == userID }),
+					mock.MatchedBy(func(b *bool) bool { return b != nil && *b }),
+				).Return(domain.Member{
+					Id:     domain.MemberId(userID),
+					Name:   "Test User",
+					Status: domain.MemberStatusInactive,
+				}, domain.DeactivationReport{
+					Deactivated: []domain.MemberId{domain.MemberId(userID)},
+					Reassigned:  []domain.Reassignment{{PrId: "pr-1", OldMemberId: domain.MemberId(userID), NewMemberId: "u2"}},
+					Unfilled:    []domain.Reassignment{{PrId: "pr-2", OldMemberId: domain.MemberId(userID)}},
 				}, nil)
 			},
 			wantStatus: http.StatusOK,
+			wantBody:   []string{`"new_reviewer_id":"u2"`, `"unfilled":[{"pull_request_id":"pr-2"`},
 		},
 		{
 			name: "member not found",
@@ -80,10 +112,12 @@ func TestRestMembers_UserSetIsActive(t *testing.T) {
 			serviceSetup: func(mockService *mocks.MembersService, userID string) {
 				mockService.On(
 					"SetMemberIsActive",
+					mock.Anything,
 					mock.MatchedBy(func(m domain.Member) bool {
 						return string(m.Id) == userID
 					}),
-				).Return(domain.Member{}, domain.ErrNotFound)
+					mock.Anything,
+				).Return(domain.Member{}, domain.DeactivationReport{}, domain.ErrNotFound)
 			},
 			wantErr: domain.HttpErrNotFound(),
 		},
@@ -149,6 +183,9 @@ func TestRestMembers_UserSetIsActive(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.wantStatus, rec.Code)
+				for _, want := range tt.wantBody {
+					assert.Contains(t, rec.Body.String(), want)
+				}
 			}
 
 			mockService.AssertExpectations(t)
@@ -157,19 +194,78 @@ func TestRestMembers_UserSetIsActive(t *testing.T) {
 }
 
 func TestRestMembers_GetUserPeviewsById(t *testing.T) {
+	pageCursor := domain.PageCursor{CreatedAt: time.Now(), Id: domain.PrId(uuid.New().String())}.String()
+
 	tests := []struct {
 		name         string
 		userID       string
+		query        string
 		serviceSetup func(*mocks.MembersService, string)
 		wantStatus   int
+		wantPRs      []string
+		wantOpen     int
+		wantNext     string
 		wantErr      error // может быть echo.HTTPError или domain.CustomHttpError
 	}{
+		{
+			name:   "status filter",
+			userID: uuid.New().String(),
+			query:  "?status=merged,CLOSED",
+			serviceSetup: func(mockService *mocks.MembersService, userID string) {
+				statuses := []domain.PrStatus{domain.PrStatusMerged, domain.PrStatusClosed}
+				mockService.On("MemberReviews", domain.MemberId(userID), statuses, domain.PageRequest{Limit: domain.DefaultPageLimit}).
+					Return(domain.MemberBuilder(domain.MemberId(userID)).Reviews(domain.PullRequests{
+						{Id: "pr-merged", Status: domain.PrStatusMerged},
+						{Id: "pr-closed", Status: domain.PrStatusClosed},
+					}).OpenReviews(1).Build(), "", nil)
+			},
+			wantStatus: http.StatusOK,
+			wantPRs:    []string{"pr-merged", "pr-closed"},
+			wantOpen:   1,
+		},
+		{
+			name:   "page with next cursor",
+			userID: uuid.New().String(),
+			query:  "?limit=1&cursor=" + pageCursor,
+			serviceSetup: func(mockService *mocks.MembersService, userID string) {
+				mockService.On("MemberReviews", domain.MemberId(userID), []domain.PrStatus(nil),
+					mock.MatchedBy(func(p domain.PageRequest) bool { return p.Limit == 1 && p.After != nil })).
+					Return(domain.MemberBuilder(domain.MemberId(userID)).Reviews(domain.PullRequests{
+						{Id: "pr-open", Status: domain.PrStatusOpen},
+					}).OpenReviews(2).Build(), "next-page", nil)
+			},
+			wantStatus: http.StatusOK,
+			wantPRs:    []string{"pr-open"},
+			wantOpen:   2,
+			wantNext:   "next-page",
+		},
+		{
+			name:         "unknown status",
+			userID:       uuid.New().String(),
+			query:        "?status=ABANDONED",
+			serviceSetup: func(mockService *mocks.MembersService, userID string) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name:         "limit above max",
+			userID:       uuid.New().String(),
+			query:        "?limit=1000",
+			serviceSetup: func(mockService *mocks.MembersService, userID string) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name:         "malformed cursor",
+			userID:       uuid.New().String(),
+			query:        "?cursor=not-a-cursor",
+			serviceSetup: func(mockService *mocks.MembersService, userID string) {},
+			wantErr:      ErrBadReqParam,
+		},
 		{
 			name:   "successful get",
 			userID: uuid.New().String(),
 			serviceSetup: func(mockService *mocks.MembersService, userID string) {
-				mockService.On("MemberReviews", domain.MemberId(userID)).
-					Return(domain.Member{Id: domain.MemberId(userID)}, nil)
+				mockService.On("MemberReviews", domain.MemberId(userID), []domain.PrStatus(nil), domain.PageRequest{Limit: domain.DefaultPageLimit}).
+					Return(domain.Member{Id: domain.MemberId(userID)}, "", nil)
 			},
 			wantStatus: http.StatusOK,
 		},
@@ -177,8 +273,8 @@ func TestRestMembers_GetUserPeviewsById(t *testing.T) {
 			name:   "member not found",
 			userID: uuid.New().String(),
 			serviceSetup: func(mockService *mocks.MembersService, userID string) {
-				mockService.On("MemberReviews", domain.MemberId(userID)).
-					Return(domain.Member{}, domain.ErrNotFound)
+				mockService.On("MemberReviews", domain.MemberId(userID), []domain.PrStatus(nil), domain.PageRequest{Limit: domain.DefaultPageLimit}).
+					Return(domain.Member{}, "", domain.ErrNotFound)
 			},
 			wantErr: domain.HttpErrNotFound(),
 		},
@@ -199,7 +295,7 @@ func TestRestMembers_GetUserPeviewsById(t *testing.T) {
 
 			handler := New(mockService, zap.NewNop().Sugar())
 
-			req := httptest.NewRequest(http.MethodGet, "/users/getReview/:id", nil)
+			req := httptest.NewRequest(http.MethodGet, "/users/getReview/:id"+tt.query, nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			c.SetParamNames("id")
@@ -232,6 +328,251 @@ func TestRestMembers_GetUserPeviewsById(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.wantStatus, rec.Code)
 			}
+
+			if tt.wantPRs != nil {
+				var resp UserReviewsResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				ids := make([]string, 0, len(resp.PullRequests))
+				for _, pr := range resp.PullRequests {
+					ids = append(ids, pr.PullRequestID)
+				}
+				assert.Equal(t, tt.wantPRs, ids)
+				assert.Equal(t, tt.wantOpen, resp.OpenReviews)
+				assert.Equal(t, tt.wantNext, resp.NextCursor)
+			}
+		})
+	}
+}
+
+func TestRestMembers_UserSetReviewCapacity(t *testing.T) {
+	three := 3
+	negative := -1
+
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.MembersService)
+		wantStatus   int
+		wantCapacity *int
+		wantErr      error
+	}{
+		{
+			name:        "set capacity",
+			requestBody: SetReviewCapacityRequest{UserID: "7d3c1bd6-30bb-4c0a-a4a4-3b6dc1c9a4c1", ReviewCapacity: &three},
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On(
+					"SetMemberReviewCapacity",
+					mock.MatchedBy(func(m domain.Member) bool { return m.Capacity == domain.NewReviewCapacity(3) }),
+				).Return(domain.Member{
+					Id:       "7d3c1bd6-30bb-4c0a-a4a4-3b6dc1c9a4c1",
+					Capacity: domain.NewReviewCapacity(3),
+				}, nil)
+			},
+			wantStatus:   http.StatusOK,
+			wantCapacity: &three,
+		},
+		{
+			name:        "reset capacity to team default",
+			requestBody: SetReviewCapacityRequest{UserID: "7d3c1bd6-30bb-4c0a-a4a4-3b6dc1c9a4c1"},
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On(
+					"SetMemberReviewCapacity",
+					mock.MatchedBy(func(m domain.Member) bool { return !m.Capacity.IsLimited() }),
+				).Return(domain.Member{Id: "7d3c1bd6-30bb-4c0a-a4a4-3b6dc1c9a4c1"}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "negative capacity",
+			requestBody:  SetReviewCapacityRequest{UserID: uuid.New().String(), ReviewCapacity: &negative},
+			serviceSetup: func(mockService *mocks.MembersService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:        "member not found",
+			requestBody: SetReviewCapacityRequest{UserID: uuid.New().String(), ReviewCapacity: &three},
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("SetMemberReviewCapacity", mock.Anything).Return(domain.Member{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewMembersService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/setReviewCapacity", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.UserSetReviewCapacity(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp struct {
+				User UserResponse `json:"user"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantCapacity, resp.User.ReviewCapacity)
+		})
+	}
+}
+
+func TestRestMembers_GetUserPeviewsById_Capacity(t *testing.T) {
+	e := setupEcho()
+	mockService := mocks.NewMembersService(t)
+	userID := uuid.New().String()
+
+	mockService.On("MemberReviews", domain.MemberId(userID), []domain.PrStatus(nil), domain.PageRequest{Limit: domain.DefaultPageLimit}).Return(domain.Member{
+		Id:       domain.MemberId(userID),
+		Capacity: domain.NewReviewCapacity(2),
+		Reviews: domain.PullRequests{
+			{Id: "pr-1", Status: domain.PrStatusOpen},
+			{Id: "pr-2", Status: domain.PrStatusMerged},
+		},
+		OpenReviews: 1,
+	}, "", nil)
+
+	handler := New(mockService, zap.NewNop().Sugar())
+
+	req := httptest.NewRequest(http.MethodGet, "/users/getReview/:id", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(userID)
+
+	assert.NoError(t, handler.GetUserPeviewsById(c))
+
+	var resp UserReviewsResponse
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	if assert.NotNil(t, resp.ReviewCapacity) {
+		assert.Equal(t, 2, *resp.ReviewCapacity)
+	}
+	assert.Equal(t, 1, resp.OpenReviews)
+	assert.Len(t, resp.PullRequests, 2)
+}
+
+func assertHTTPError(t *testing.T, want, got error) {
+	t.Helper()
+
+	switch want := want.(type) {
+	case *echo.HTTPError:
+		var httpErr *echo.HTTPError
+		if errors.As(got, &httpErr) {
+			assert.Equal(t, want.Code, httpErr.Code)
+			assert.Equal(t, want.Message, httpErr.Message)
+		} else {
+			t.Fatalf("expected echo.HTTPError, got %v", got)
+		}
+	case *domain.CustomHttpError:
+		var customErr *domain.CustomHttpError
+		if errors.As(got, &customErr) {
+			assert.Equal(t, want.HttpCode, customErr.HttpCode)
+			assert.Equal(t, want.Code, customErr.Code)
+		} else {
+			t.Fatalf("expected CustomHttpError, got %v", got)
+		}
+	default:
+		assert.ErrorIs(t, got, want)
+	}
+}
+
+func TestRestMembers_UserSetPrimaryTeam(t *testing.T) {
+	userID := uuid.New().String()
+
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.MembersService)
+		wantStatus   int
+		wantTeams    []TeamMembershipResponse
+		wantErr      error
+	}{
+		{
+			name:        "set primary team",
+			requestBody: SetPrimaryTeamRequest{UserID: userID, TeamName: "platform"},
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On(
+					"SetMemberPrimaryTeam",
+					mock.MatchedBy(func(m domain.Member) bool {
+						return m.Id.String() == userID && m.Team == "platform"
+					}),
+				).Return(domain.Member{
+					Id:   domain.MemberId(userID),
+					Team: "platform",
+					Teams: domain.TeamMemberships{
+						{Team: "platform", Primary: true},
+						{Team: "backend"},
+					},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantTeams: []TeamMembershipResponse{
+				{TeamName: "platform", IsPrimary: true},
+				{TeamName: "backend"},
+			},
+		},
+		{
+			name:         "missing team name",
+			requestBody:  SetPrimaryTeamRequest{UserID: userID},
+			serviceSetup: func(mockService *mocks.MembersService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:        "member not in team",
+			requestBody: SetPrimaryTeamRequest{UserID: userID, TeamName: "frontend"},
+			serviceSetup: func(mockService *mocks.MembersService) {
+				mockService.On("SetMemberPrimaryTeam", mock.Anything).Return(domain.Member{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewMembersService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/setPrimaryTeam", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.UserSetPrimaryTeam(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp struct {
+				User UserResponse `json:"user"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, "platform", resp.User.TeamName)
+			assert.Equal(t, tt.wantTeams, resp.User.Teams)
 		})
 	}
 }
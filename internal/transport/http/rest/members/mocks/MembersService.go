@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,12 +22,127 @@ func (_m *MembersService) EXPECT() *MembersService_Expecter {
 	return &MembersService_Expecter{mock: &_m.Mock}
 }
 
-// MemberReviews provides a mock function with given fields: id
-func (_m *MembersService) MemberReviews(id domain.MemberId) (domain.Member, error) {
+// LinkIdentity provides a mock function with given fields: ctx, id, identity
+func (_m *MembersService) LinkIdentity(ctx context.Context, id domain.MemberId, identity domain.Identity) (domain.MemberIdentity, error) {
+	ret := _m.Called(ctx, id, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkIdentity")
+	}
+
+	var r0 domain.MemberIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberId, domain.Identity) (domain.MemberIdentity, error)); ok {
+		return rf(ctx, id, identity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberId, domain.Identity) domain.MemberIdentity); ok {
+		r0 = rf(ctx, id, identity)
+	} else {
+		r0 = ret.Get(0).(domain.MemberIdentity)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MemberId, domain.Identity) error); ok {
+		r1 = rf(ctx, id, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersService_LinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkIdentity'
+type MembersService_LinkIdentity_Call struct {
+	*mock.Call
+}
+
+// LinkIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.MemberId
+//   - identity domain.Identity
+func (_e *MembersService_Expecter) LinkIdentity(ctx interface{}, id interface{}, identity interface{}) *MembersService_LinkIdentity_Call {
+	return &MembersService_LinkIdentity_Call{Call: _e.mock.On("LinkIdentity", ctx, id, identity)}
+}
+
+func (_c *MembersService_LinkIdentity_Call) Run(run func(ctx context.Context, id domain.MemberId, identity domain.Identity)) *MembersService_LinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberId), args[2].(domain.Identity))
+	})
+	return _c
+}
+
+func (_c *MembersService_LinkIdentity_Call) Return(_a0 domain.MemberIdentity, _a1 error) *MembersService_LinkIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersService_LinkIdentity_Call) RunAndReturn(run func(context.Context, domain.MemberId, domain.Identity) (domain.MemberIdentity, error)) *MembersService_LinkIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMembers provides a mock function with given fields: filter, page
+func (_m *MembersService) ListMembers(filter domain.MemberFilter, page domain.MemberPageRequest) (domain.MembersPage, error) {
+	ret := _m.Called(filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 domain.MembersPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberFilter, domain.MemberPageRequest) (domain.MembersPage, error)); ok {
+		return rf(filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberFilter, domain.MemberPageRequest) domain.MembersPage); ok {
+		r0 = rf(filter, page)
+	} else {
+		r0 = ret.Get(0).(domain.MembersPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberFilter, domain.MemberPageRequest) error); ok {
+		r1 = rf(filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersService_ListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMembers'
+type MembersService_ListMembers_Call struct {
+	*mock.Call
+}
+
+// ListMembers is a helper method to define mock.On call
+//   - filter domain.MemberFilter
+//   - page domain.MemberPageRequest
+func (_e *MembersService_Expecter) ListMembers(filter interface{}, page interface{}) *MembersService_ListMembers_Call {
+	return &MembersService_ListMembers_Call{Call: _e.mock.On("ListMembers", filter, page)}
+}
+
+func (_c *MembersService_ListMembers_Call) Run(run func(filter domain.MemberFilter, page domain.MemberPageRequest)) *MembersService_ListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberFilter), args[1].(domain.MemberPageRequest))
+	})
+	return _c
+}
+
+func (_c *MembersService_ListMembers_Call) Return(_a0 domain.MembersPage, _a1 error) *MembersService_ListMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersService_ListMembers_Call) RunAndReturn(run func(domain.MemberFilter, domain.MemberPageRequest) (domain.MembersPage, error)) *MembersService_ListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Member provides a mock function with given fields: id
+func (_m *MembersService) Member(id domain.MemberId) (domain.Member, error) {
 	ret := _m.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MemberReviews")
+		panic("no return value specified for Member")
 	}
 
 	var r0 domain.Member
@@ -48,6 +165,128 @@ func (_m *MembersService) MemberReviews(id domain.MemberId) (domain.Member, erro
 	return r0, r1
 }
 
+// MembersService_Member_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Member'
+type MembersService_Member_Call struct {
+	*mock.Call
+}
+
+// Member is a helper method to define mock.On call
+//   - id domain.MemberId
+func (_e *MembersService_Expecter) Member(id interface{}) *MembersService_Member_Call {
+	return &MembersService_Member_Call{Call: _e.mock.On("Member", id)}
+}
+
+func (_c *MembersService_Member_Call) Run(run func(id domain.MemberId)) *MembersService_Member_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *MembersService_Member_Call) Return(_a0 domain.Member, _a1 error) *MembersService_Member_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersService_Member_Call) RunAndReturn(run func(domain.MemberId) (domain.Member, error)) *MembersService_Member_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MemberIdentities provides a mock function with given fields: ctx, id
+func (_m *MembersService) MemberIdentities(ctx context.Context, id domain.MemberId) ([]domain.Identity, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MemberIdentities")
+	}
+
+	var r0 []domain.Identity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberId) ([]domain.Identity, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberId) []domain.Identity); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Identity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MemberId) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersService_MemberIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MemberIdentities'
+type MembersService_MemberIdentities_Call struct {
+	*mock.Call
+}
+
+// MemberIdentities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.MemberId
+func (_e *MembersService_Expecter) MemberIdentities(ctx interface{}, id interface{}) *MembersService_MemberIdentities_Call {
+	return &MembersService_MemberIdentities_Call{Call: _e.mock.On("MemberIdentities", ctx, id)}
+}
+
+func (_c *MembersService_MemberIdentities_Call) Run(run func(ctx context.Context, id domain.MemberId)) *MembersService_MemberIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberId))
+	})
+	return _c
+}
+
+func (_c *MembersService_MemberIdentities_Call) Return(_a0 []domain.Identity, _a1 error) *MembersService_MemberIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersService_MemberIdentities_Call) RunAndReturn(run func(context.Context, domain.MemberId) ([]domain.Identity, error)) *MembersService_MemberIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MemberReviews provides a mock function with given fields: id, statuses, page
+func (_m *MembersService) MemberReviews(id domain.MemberId, statuses []domain.PrStatus, page domain.PageRequest) (domain.Member, string, error) {
+	ret := _m.Called(id, statuses, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MemberReviews")
+	}
+
+	var r0 domain.Member
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId, []domain.PrStatus, domain.PageRequest) (domain.Member, string, error)); ok {
+		return rf(id, statuses, page)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId, []domain.PrStatus, domain.PageRequest) domain.Member); ok {
+		r0 = rf(id, statuses, page)
+	} else {
+		r0 = ret.Get(0).(domain.Member)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberId, []domain.PrStatus, domain.PageRequest) string); ok {
+		r1 = rf(id, statuses, page)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(domain.MemberId, []domain.PrStatus, domain.PageRequest) error); ok {
+		r2 = rf(id, statuses, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // MembersService_MemberReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MemberReviews'
 type MembersService_MemberReviews_Call struct {
 	*mock.Call
@@ -55,35 +294,159 @@ type MembersService_MemberReviews_Call struct {
 
 // MemberReviews is a helper method to define mock.On call
 //   - id domain.MemberId
-func (_e *MembersService_Expecter) MemberReviews(id interface{}) *MembersService_MemberReviews_Call {
-	return &MembersService_MemberReviews_Call{Call: _e.mock.On("MemberReviews", id)}
+//   - statuses []domain.PrStatus
+//   - page domain.PageRequest
+func (_e *MembersService_Expecter) MemberReviews(id interface{}, statuses interface{}, page interface{}) *MembersService_MemberReviews_Call {
+	return &MembersService_MemberReviews_Call{Call: _e.mock.On("MemberReviews", id, statuses, page)}
 }
 
-func (_c *MembersService_MemberReviews_Call) Run(run func(id domain.MemberId)) *MembersService_MemberReviews_Call {
+func (_c *MembersService_MemberReviews_Call) Run(run func(id domain.MemberId, statuses []domain.PrStatus, page domain.PageRequest)) *MembersService_MemberReviews_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.MemberId))
+		run(args[0].(domain.MemberId), args[1].([]domain.PrStatus), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *MembersService_MemberReviews_Call) Return(_a0 domain.Member, _a1 string, _a2 error) *MembersService_MemberReviews_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MembersService_MemberReviews_Call) RunAndReturn(run func(domain.MemberId, []domain.PrStatus, domain.PageRequest) (domain.Member, string, error)) *MembersService_MemberReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveMember provides a mock function with given fields: ctx, ref
+func (_m *MembersService) ResolveMember(ctx context.Context, ref domain.MemberRef) (domain.MemberId, error) {
+	ret := _m.Called(ctx, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveMember")
+	}
+
+	var r0 domain.MemberId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberRef) (domain.MemberId, error)); ok {
+		return rf(ctx, ref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberRef) domain.MemberId); ok {
+		r0 = rf(ctx, ref)
+	} else {
+		r0 = ret.Get(0).(domain.MemberId)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MemberRef) error); ok {
+		r1 = rf(ctx, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersService_ResolveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveMember'
+type MembersService_ResolveMember_Call struct {
+	*mock.Call
+}
+
+// ResolveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ref domain.MemberRef
+func (_e *MembersService_Expecter) ResolveMember(ctx interface{}, ref interface{}) *MembersService_ResolveMember_Call {
+	return &MembersService_ResolveMember_Call{Call: _e.mock.On("ResolveMember", ctx, ref)}
+}
+
+func (_c *MembersService_ResolveMember_Call) Run(run func(ctx context.Context, ref domain.MemberRef)) *MembersService_ResolveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberRef))
 	})
 	return _c
 }
 
-func (_c *MembersService_MemberReviews_Call) Return(_a0 domain.Member, _a1 error) *MembersService_MemberReviews_Call {
+func (_c *MembersService_ResolveMember_Call) Return(_a0 domain.MemberId, _a1 error) *MembersService_ResolveMember_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MembersService_MemberReviews_Call) RunAndReturn(run func(domain.MemberId) (domain.Member, error)) *MembersService_MemberReviews_Call {
+func (_c *MembersService_ResolveMember_Call) RunAndReturn(run func(context.Context, domain.MemberRef) (domain.MemberId, error)) *MembersService_ResolveMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetMemberIsActive provides a mock function with given fields: member
-func (_m *MembersService) SetMemberIsActive(member domain.Member) (domain.Member, error) {
-	ret := _m.Called(member)
+// SetMemberIsActive provides a mock function with given fields: ctx, member, reassign
+func (_m *MembersService) SetMemberIsActive(ctx context.Context, member domain.Member, reassign *bool) (domain.Member, domain.DeactivationReport, error) {
+	ret := _m.Called(ctx, member, reassign)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetMemberIsActive")
 	}
 
+	var r0 domain.Member
+	var r1 domain.DeactivationReport
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Member, *bool) (domain.Member, domain.DeactivationReport, error)); ok {
+		return rf(ctx, member, reassign)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Member, *bool) domain.Member); ok {
+		r0 = rf(ctx, member, reassign)
+	} else {
+		r0 = ret.Get(0).(domain.Member)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Member, *bool) domain.DeactivationReport); ok {
+		r1 = rf(ctx, member, reassign)
+	} else {
+		r1 = ret.Get(1).(domain.DeactivationReport)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.Member, *bool) error); ok {
+		r2 = rf(ctx, member, reassign)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MembersService_SetMemberIsActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMemberIsActive'
+type MembersService_SetMemberIsActive_Call struct {
+	*mock.Call
+}
+
+// SetMemberIsActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member domain.Member
+//   - reassign *bool
+func (_e *MembersService_Expecter) SetMemberIsActive(ctx interface{}, member interface{}, reassign interface{}) *MembersService_SetMemberIsActive_Call {
+	return &MembersService_SetMemberIsActive_Call{Call: _e.mock.On("SetMemberIsActive", ctx, member, reassign)}
+}
+
+func (_c *MembersService_SetMemberIsActive_Call) Run(run func(ctx context.Context, member domain.Member, reassign *bool)) *MembersService_SetMemberIsActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Member), args[2].(*bool))
+	})
+	return _c
+}
+
+func (_c *MembersService_SetMemberIsActive_Call) Return(_a0 domain.Member, _a1 domain.DeactivationReport, _a2 error) *MembersService_SetMemberIsActive_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MembersService_SetMemberIsActive_Call) RunAndReturn(run func(context.Context, domain.Member, *bool) (domain.Member, domain.DeactivationReport, error)) *MembersService_SetMemberIsActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMemberPrimaryTeam provides a mock function with given fields: member
+func (_m *MembersService) SetMemberPrimaryTeam(member domain.Member) (domain.Member, error) {
+	ret := _m.Called(member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMemberPrimaryTeam")
+	}
+
 	var r0 domain.Member
 	var r1 error
 	if rf, ok := ret.Get(0).(func(domain.Member) (domain.Member, error)); ok {
@@ -104,30 +467,191 @@ func (_m *MembersService) SetMemberIsActive(member domain.Member) (domain.Member
 	return r0, r1
 }
 
-// MembersService_SetMemberIsActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMemberIsActive'
-type MembersService_SetMemberIsActive_Call struct {
+// MembersService_SetMemberPrimaryTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMemberPrimaryTeam'
+type MembersService_SetMemberPrimaryTeam_Call struct {
 	*mock.Call
 }
 
-// SetMemberIsActive is a helper method to define mock.On call
+// SetMemberPrimaryTeam is a helper method to define mock.On call
+//   - member domain.Member
+func (_e *MembersService_Expecter) SetMemberPrimaryTeam(member interface{}) *MembersService_SetMemberPrimaryTeam_Call {
+	return &MembersService_SetMemberPrimaryTeam_Call{Call: _e.mock.On("SetMemberPrimaryTeam", member)}
+}
+
+func (_c *MembersService_SetMemberPrimaryTeam_Call) Run(run func(member domain.Member)) *MembersService_SetMemberPrimaryTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.Member))
+	})
+	return _c
+}
+
+func (_c *MembersService_SetMemberPrimaryTeam_Call) Return(_a0 domain.Member, _a1 error) *MembersService_SetMemberPrimaryTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersService_SetMemberPrimaryTeam_Call) RunAndReturn(run func(domain.Member) (domain.Member, error)) *MembersService_SetMemberPrimaryTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMemberReviewCapacity provides a mock function with given fields: member
+func (_m *MembersService) SetMemberReviewCapacity(member domain.Member) (domain.Member, error) {
+	ret := _m.Called(member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMemberReviewCapacity")
+	}
+
+	var r0 domain.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.Member) (domain.Member, error)); ok {
+		return rf(member)
+	}
+	if rf, ok := ret.Get(0).(func(domain.Member) domain.Member); ok {
+		r0 = rf(member)
+	} else {
+		r0 = ret.Get(0).(domain.Member)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.Member) error); ok {
+		r1 = rf(member)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersService_SetMemberReviewCapacity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMemberReviewCapacity'
+type MembersService_SetMemberReviewCapacity_Call struct {
+	*mock.Call
+}
+
+// SetMemberReviewCapacity is a helper method to define mock.On call
 //   - member domain.Member
-func (_e *MembersService_Expecter) SetMemberIsActive(member interface{}) *MembersService_SetMemberIsActive_Call {
-	return &MembersService_SetMemberIsActive_Call{Call: _e.mock.On("SetMemberIsActive", member)}
+func (_e *MembersService_Expecter) SetMemberReviewCapacity(member interface{}) *MembersService_SetMemberReviewCapacity_Call {
+	return &MembersService_SetMemberReviewCapacity_Call{Call: _e.mock.On("SetMemberReviewCapacity", member)}
 }
 
-func (_c *MembersService_SetMemberIsActive_Call) Run(run func(member domain.Member)) *MembersService_SetMemberIsActive_Call {
+func (_c *MembersService_SetMemberReviewCapacity_Call) Run(run func(member domain.Member)) *MembersService_SetMemberReviewCapacity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(domain.Member))
 	})
 	return _c
 }
 
-func (_c *MembersService_SetMemberIsActive_Call) Return(_a0 domain.Member, _a1 error) *MembersService_SetMemberIsActive_Call {
+func (_c *MembersService_SetMemberReviewCapacity_Call) Return(_a0 domain.Member, _a1 error) *MembersService_SetMemberReviewCapacity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MembersService_SetMemberReviewCapacity_Call) RunAndReturn(run func(domain.Member) (domain.Member, error)) *MembersService_SetMemberReviewCapacity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlinkIdentity provides a mock function with given fields: ctx, id, identity
+func (_m *MembersService) UnlinkIdentity(ctx context.Context, id domain.MemberId, identity domain.Identity) error {
+	ret := _m.Called(ctx, id, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlinkIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberId, domain.Identity) error); ok {
+		r0 = rf(ctx, id, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MembersService_UnlinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkIdentity'
+type MembersService_UnlinkIdentity_Call struct {
+	*mock.Call
+}
+
+// UnlinkIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.MemberId
+//   - identity domain.Identity
+func (_e *MembersService_Expecter) UnlinkIdentity(ctx interface{}, id interface{}, identity interface{}) *MembersService_UnlinkIdentity_Call {
+	return &MembersService_UnlinkIdentity_Call{Call: _e.mock.On("UnlinkIdentity", ctx, id, identity)}
+}
+
+func (_c *MembersService_UnlinkIdentity_Call) Run(run func(ctx context.Context, id domain.MemberId, identity domain.Identity)) *MembersService_UnlinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberId), args[2].(domain.Identity))
+	})
+	return _c
+}
+
+func (_c *MembersService_UnlinkIdentity_Call) Return(_a0 error) *MembersService_UnlinkIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MembersService_UnlinkIdentity_Call) RunAndReturn(run func(context.Context, domain.MemberId, domain.Identity) error) *MembersService_UnlinkIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMember provides a mock function with given fields: id, patch
+func (_m *MembersService) UpdateMember(id domain.MemberId, patch domain.MemberPatch) (domain.Member, error) {
+	ret := _m.Called(id, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMember")
+	}
+
+	var r0 domain.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.MemberPatch) (domain.Member, error)); ok {
+		return rf(id, patch)
+	}
+	if rf, ok := ret.Get(0).(func(domain.MemberId, domain.MemberPatch) domain.Member); ok {
+		r0 = rf(id, patch)
+	} else {
+		r0 = ret.Get(0).(domain.Member)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.MemberId, domain.MemberPatch) error); ok {
+		r1 = rf(id, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MembersService_UpdateMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMember'
+type MembersService_UpdateMember_Call struct {
+	*mock.Call
+}
+
+// UpdateMember is a helper method to define mock.On call
+//   - id domain.MemberId
+//   - patch domain.MemberPatch
+func (_e *MembersService_Expecter) UpdateMember(id interface{}, patch interface{}) *MembersService_UpdateMember_Call {
+	return &MembersService_UpdateMember_Call{Call: _e.mock.On("UpdateMember", id, patch)}
+}
+
+func (_c *MembersService_UpdateMember_Call) Run(run func(id domain.MemberId, patch domain.MemberPatch)) *MembersService_UpdateMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.MemberId), args[1].(domain.MemberPatch))
+	})
+	return _c
+}
+
+func (_c *MembersService_UpdateMember_Call) Return(_a0 domain.Member, _a1 error) *MembersService_UpdateMember_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MembersService_SetMemberIsActive_Call) RunAndReturn(run func(domain.Member) (domain.Member, error)) *MembersService_SetMemberIsActive_Call {
+func (_c *MembersService_UpdateMember_Call) RunAndReturn(run func(domain.MemberId, domain.MemberPatch) (domain.Member, error)) *MembersService_UpdateMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,29 +6,103 @@ import (
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 )
 
+// CreatePRRequest pull_request_id is a UUID or an external key like github:acme/api#42,
+// a PR created under a key gets a UUID derived from it.
 type CreatePRRequest struct {
-	PullRequestID   string `json:"pull_request_id" validate:"required,uuid"`
+	PullRequestID   string `json:"pull_request_id" validate:"required,pr_ref"`
 	PullRequestName string `json:"pull_request_name" validate:"required"`
-	AuthorID        string `json:"author_id" validate:"required,uuid"`
+	AuthorID        string `json:"author_id" validate:"required,member_ref"`
+	TeamName        string `json:"team_name,omitempty"`
+	Draft           bool   `json:"draft,omitempty"`
 }
 
 type MergePRRequest struct {
-	PullRequestID string `json:"pull_request_id" validate:"required,uuid"`
+	PullRequestID string `json:"pull_request_id" validate:"required,pr_ref"`
 }
 
 type ReassignPRRequest struct {
-	PullRequestID string `json:"pull_request_id" validate:"required,uuid"`
-	OldUserID     string `json:"old_reviewer_id" validate:"required,uuid"`
+	PullRequestID string `json:"pull_request_id" validate:"required,pr_ref"`
+	OldUserID     string `json:"old_reviewer_id" validate:"required,member_ref"`
+	Actor         string `json:"actor,omitempty" validate:"max=255"`
+	Reason        string `json:"reason,omitempty" validate:"max=255"`
+}
+
+type PRStatusRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required,pr_ref"`
+	Actor         string `json:"actor,omitempty" validate:"max=255"`
+	Reason        string `json:"reason,omitempty" validate:"max=255"`
+}
+
+type PRHistoryRequest struct {
+	PullRequestID string `param:"id" validate:"required,pr_ref"`
+}
+
+type GetPRRequest struct {
+	PullRequestID string `param:"id" validate:"required,pr_ref"`
+}
+
+// ListPRsRequest status is a comma separated list, from and to are RFC3339 timestamps of the [from, to) creation range.
+type ListPRsRequest struct {
+	Status     string `query:"status"`
+	AuthorID   string `query:"author_id" validate:"omitempty,member_ref"`
+	ReviewerID string `query:"reviewer_id" validate:"omitempty,member_ref"`
+	TeamName   string `query:"team_name"`
+	From       string `query:"from"`
+	To         string `query:"to"`
+	Cursor     string `query:"cursor"`
+	Limit      int    `query:"limit"`
+}
+
+type ListPRsResponse struct {
+	PullRequests []PRResponse `json:"pull_requests"`
+	NextCursor   string       `json:"next_cursor,omitempty"`
+}
+
+type PRHistoryResponse struct {
+	PullRequestID string                 `json:"pull_request_id"`
+	Events        []AssignmentEventEntry `json:"events"`
+}
+
+type AssignmentEventEntry struct {
+	Type          string `json:"type"`
+	OldReviewerID string `json:"old_reviewer_id,omitempty"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+	Actor         string `json:"actor"`
+	Reason        string `json:"reason"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+type ReviewPRRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required,pr_ref"`
+	UserID        string `json:"user_id" validate:"required,member_ref"`
+	State         string `json:"state" validate:"required,oneof=APPROVED CHANGES_REQUESTED COMMENTED"`
 }
 
 type PRResponse struct {
 	PullRequestID     string   `json:"pull_request_id"`
+	ExternalID        string   `json:"external_id,omitempty"`
 	PullRequestName   string   `json:"pull_request_name"`
 	AuthorID          string   `json:"author_id"`
+	TeamName          string   `json:"team_name,omitempty"`
 	Status            string   `json:"status"`
 	AssignedReviewers []string `json:"assigned_reviewers"`
 	CreatedAt         *string  `json:"createdAt,omitempty"`
 	MergedAt          *string  `json:"mergedAt,omitempty"`
+	Version           int      `json:"version"`
+
+	Reviews        []ReviewResponse `json:"reviews,omitempty"`
+	CandidatesLoad []CandidateLoad  `json:"candidates_load,omitempty"`
+}
+
+type ReviewResponse struct {
+	UserID     string  `json:"user_id"`
+	State      string  `json:"state"`
+	ReviewedAt *string `json:"reviewed_at,omitempty"`
+}
+
+type CandidateLoad struct {
+	UserID      string `json:"user_id"`
+	OpenReviews int    `json:"open_reviews"`
 }
 
 type ReassignPRResponse struct {
@@ -37,20 +111,64 @@ type ReassignPRResponse struct {
 }
 
 func (req *CreatePRRequest) domain() domain.PullRequestShort {
-	return domain.PullRequestShort{
+	pr := domain.PullRequestShort{
 		Id:       domain.PrId(req.PullRequestID),
 		Name:     domain.PrName(req.PullRequestName),
 		AuthorId: domain.MemberId(req.AuthorID),
+		Team:     domain.TeamName(req.TeamName),
 		Status:   domain.PrStatusDefault,
+		Draft:    req.Draft,
+	}
+	if key, ok := domain.PrRef(req.PullRequestID).ExternalKey(); ok {
+		pr.Id, pr.External = key.PrId(), key
 	}
+	return pr
 }
 
-func pullRequestResponse(pr domain.PullRequest) PRResponse {
-	status := "OPEN"
-	if pr.Status == domain.PrStatusMerged {
-		status = "MERGED"
+func (req *ListPRsRequest) domain() (domain.PrFilter, domain.PageRequest, error) {
+	statuses, err := domain.ParsePrStatuses(req.Status)
+	if err != nil {
+		return domain.PrFilter{}, domain.PageRequest{}, err
+	}
+
+	f := domain.PrFilter{
+		Statuses:   statuses,
+		AuthorId:   domain.MemberId(req.AuthorID),
+		ReviewerId: domain.MemberId(req.ReviewerID),
+		Team:       domain.TeamName(req.TeamName),
+	}
+	if req.From != "" {
+		if f.From, err = time.Parse(time.RFC3339, req.From); err != nil {
+			return domain.PrFilter{}, domain.PageRequest{}, err
+		}
+	}
+	if req.To != "" {
+		if f.To, err = time.Parse(time.RFC3339, req.To); err != nil {
+			return domain.PrFilter{}, domain.PageRequest{}, err
+		}
+	}
+
+	page, err := domain.NewPageRequest(req.Cursor, req.Limit)
+	if err != nil {
+		return domain.PrFilter{}, domain.PageRequest{}, err
 	}
 
+	return f, page, nil
+}
+
+func (req *PRStatusRequest) audit() domain.AssignmentAudit {
+	return domain.AssignmentAudit{Actor: req.Actor, Reason: req.Reason}
+}
+
+func (req *ReviewPRRequest) domain() domain.Review {
+	return domain.Review{
+		PrId:     domain.PrId(req.PullRequestID),
+		MemberId: domain.MemberId(req.UserID),
+		State:    domain.ReviewStateFromString(req.State),
+	}
+}
+
+func pullRequestResponse(pr domain.PullRequest) PRResponse {
 	assignedReviewers := make([]string, 0, len(pr.AssignedReviews))
 	for _, member := range pr.AssignedReviews.Slice() {
 		assignedReviewers = append(assignedReviewers, member.Id.String())
@@ -70,18 +188,91 @@ func pullRequestResponse(pr domain.PullRequest) PRResponse {
 
 	return PRResponse{
 		PullRequestID:     pr.Id.String(),
+		ExternalID:        pr.External.String(),
 		PullRequestName:   pr.Name.String(),
 		AuthorID:          pr.AuthorId.String(),
-		Status:            status,
+		TeamName:          pr.Team.String(),
+		Status:            pr.Status.String(),
 		AssignedReviewers: assignedReviewers,
 		CreatedAt:         createdAt,
 		MergedAt:          mergedAt,
+		Version:           pr.Version,
+		Reviews:           reviewsResponse(pr.Reviews),
+		CandidatesLoad:    candidatesLoad(pr.Candidates),
 	}
 }
 
+func reviewsResponse(rs domain.Reviews) []ReviewResponse {
+	if rs.Empty() {
+		return nil
+	}
+
+	res := make([]ReviewResponse, 0, len(rs))
+	for _, r := range rs.Slice() {
+		var reviewedAt *string
+		if !r.ReviewedAt.IsZero() {
+			reviewedAtStr := r.ReviewedAt.Format(time.RFC3339)
+			reviewedAt = &reviewedAtStr
+		}
+
+		res = append(res, ReviewResponse{
+			UserID:     r.MemberId.String(),
+			State:      r.State.String(),
+			ReviewedAt: reviewedAt,
+		})
+	}
+	return res
+}
+
+func candidatesLoad(mh domain.MembersHistories) []CandidateLoad {
+	if mh.Empty() {
+		return nil
+	}
+
+	res := make([]CandidateLoad, 0, len(mh))
+	for _, c := range mh.Slice() {
+		res = append(res, CandidateLoad{
+			UserID:      c.Id.String(),
+			OpenReviews: c.Load,
+		})
+	}
+	return res
+}
+
 func reassignPRResponse(pr domain.PrWithReasignMember) ReassignPRResponse {
 	return ReassignPRResponse{
 		PR:         pullRequestResponse(pr.PullRequest),
 		ReplacedBy: string(pr.MemberId),
 	}
 }
+
+func listPRsResponse(page domain.PullRequestsPage) ListPRsResponse {
+	prs := make([]PRResponse, 0, len(page.Items))
+	for _, pr := range page.Items {
+		prs = append(prs, pullRequestResponse(pr))
+	}
+
+	return ListPRsResponse{
+		PullRequests: prs,
+		NextCursor:   page.NextCursor,
+	}
+}
+
+func prHistoryResponse(id domain.PrId, events domain.AssignmentEvents) PRHistoryResponse {
+	entries := make([]AssignmentEventEntry, 0, len(events))
+	for _, e := range events {
+		entries = append(entries, AssignmentEventEntry{
+			Type:          string(e.Type),
+			OldReviewerID: e.OldMemberId.String(),
+			NewReviewerID: e.NewMemberId.String(),
+			Actor:         e.Actor,
+			Reason:        e.Reason,
+			CreatedAt:     e.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return PRHistoryResponse{
+		PullRequestID: id.String(),
+		Events:        entries,
+	}
+}
@@ -22,9 +22,242 @@ func (_m *PullRequestService) EXPECT() *PullRequestService_Expecter {
 	return &PullRequestService_Expecter{mock: &_m.Mock}
 }
 
-// Merge provides a mock function with given fields: id
-func (_m *PullRequestService) Merge(id domain.PrId) (domain.PullRequest, error) {
-	ret := _m.Called(id)
+// Close provides a mock function with given fields: ctx, id, version, audit
+func (_m *PullRequestService) Close(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id, version, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)); ok {
+		return rf(ctx, id, version, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) domain.PullRequest); ok {
+		r0 = rf(ctx, id, version, audit)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) error); ok {
+		r1 = rf(ctx, id, version, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type PullRequestService_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+//   - version int
+//   - audit domain.AssignmentAudit
+func (_e *PullRequestService_Expecter) Close(ctx interface{}, id interface{}, version interface{}, audit interface{}) *PullRequestService_Close_Call {
+	return &PullRequestService_Close_Call{Call: _e.mock.On("Close", ctx, id, version, audit)}
+}
+
+func (_c *PullRequestService_Close_Call) Run(run func(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit)) *PullRequestService_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(int), args[3].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_Close_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_Close_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_Close_Call) RunAndReturn(run func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)) *PullRequestService_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *PullRequestService) Get(ctx context.Context, id domain.PrId) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) (domain.PullRequest, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) domain.PullRequest); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type PullRequestService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+func (_e *PullRequestService_Expecter) Get(ctx interface{}, id interface{}) *PullRequestService_Get_Call {
+	return &PullRequestService_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *PullRequestService_Get_Call) Run(run func(ctx context.Context, id domain.PrId)) *PullRequestService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_Get_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_Get_Call) RunAndReturn(run func(context.Context, domain.PrId) (domain.PullRequest, error)) *PullRequestService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// History provides a mock function with given fields: ctx, id
+func (_m *PullRequestService) History(ctx context.Context, id domain.PrId) (domain.AssignmentEvents, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 domain.AssignmentEvents
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) (domain.AssignmentEvents, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId) domain.AssignmentEvents); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.AssignmentEvents)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type PullRequestService_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+func (_e *PullRequestService_Expecter) History(ctx interface{}, id interface{}) *PullRequestService_History_Call {
+	return &PullRequestService_History_Call{Call: _e.mock.On("History", ctx, id)}
+}
+
+func (_c *PullRequestService_History_Call) Run(run func(ctx context.Context, id domain.PrId)) *PullRequestService_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_History_Call) Return(_a0 domain.AssignmentEvents, _a1 error) *PullRequestService_History_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_History_Call) RunAndReturn(run func(context.Context, domain.PrId) (domain.AssignmentEvents, error)) *PullRequestService_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, filter, page
+func (_m *PullRequestService) List(ctx context.Context, filter domain.PrFilter, page domain.PageRequest) (domain.PullRequestsPage, error) {
+	ret := _m.Called(ctx, filter, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 domain.PullRequestsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrFilter, domain.PageRequest) (domain.PullRequestsPage, error)); ok {
+		return rf(ctx, filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrFilter, domain.PageRequest) domain.PullRequestsPage); ok {
+		r0 = rf(ctx, filter, page)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequestsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrFilter, domain.PageRequest) error); ok {
+		r1 = rf(ctx, filter, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type PullRequestService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.PrFilter
+//   - page domain.PageRequest
+func (_e *PullRequestService_Expecter) List(ctx interface{}, filter interface{}, page interface{}) *PullRequestService_List_Call {
+	return &PullRequestService_List_Call{Call: _e.mock.On("List", ctx, filter, page)}
+}
+
+func (_c *PullRequestService_List_Call) Run(run func(ctx context.Context, filter domain.PrFilter, page domain.PageRequest)) *PullRequestService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrFilter), args[2].(domain.PageRequest))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_List_Call) Return(_a0 domain.PullRequestsPage, _a1 error) *PullRequestService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_List_Call) RunAndReturn(run func(context.Context, domain.PrFilter, domain.PageRequest) (domain.PullRequestsPage, error)) *PullRequestService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Merge provides a mock function with given fields: ctx, id, version
+func (_m *PullRequestService) Merge(ctx context.Context, id domain.PrId, version int) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Merge")
@@ -32,17 +265,17 @@ func (_m *PullRequestService) Merge(id domain.PrId) (domain.PullRequest, error)
 
 	var r0 domain.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.PrId) (domain.PullRequest, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int) (domain.PullRequest, error)); ok {
+		return rf(ctx, id, version)
 	}
-	if rf, ok := ret.Get(0).(func(domain.PrId) domain.PullRequest); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int) domain.PullRequest); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Get(0).(domain.PullRequest)
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.PrId) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, int) error); ok {
+		r1 = rf(ctx, id, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,14 +289,16 @@ type PullRequestService_Merge_Call struct {
 }
 
 // Merge is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id domain.PrId
-func (_e *PullRequestService_Expecter) Merge(id interface{}) *PullRequestService_Merge_Call {
-	return &PullRequestService_Merge_Call{Call: _e.mock.On("Merge", id)}
+//   - version int
+func (_e *PullRequestService_Expecter) Merge(ctx interface{}, id interface{}, version interface{}) *PullRequestService_Merge_Call {
+	return &PullRequestService_Merge_Call{Call: _e.mock.On("Merge", ctx, id, version)}
 }
 
-func (_c *PullRequestService_Merge_Call) Run(run func(id domain.PrId)) *PullRequestService_Merge_Call {
+func (_c *PullRequestService_Merge_Call) Run(run func(ctx context.Context, id domain.PrId, version int)) *PullRequestService_Merge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.PrId))
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(int))
 	})
 	return _c
 }
@@ -73,14 +308,14 @@ func (_c *PullRequestService_Merge_Call) Return(_a0 domain.PullRequest, _a1 erro
 	return _c
 }
 
-func (_c *PullRequestService_Merge_Call) RunAndReturn(run func(domain.PrId) (domain.PullRequest, error)) *PullRequestService_Merge_Call {
+func (_c *PullRequestService_Merge_Call) RunAndReturn(run func(context.Context, domain.PrId, int) (domain.PullRequest, error)) *PullRequestService_Merge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewPullRequest provides a mock function with given fields: basePR
-func (_m *PullRequestService) NewPullRequest(basePR domain.PullRequestShort) (domain.PullRequest, error) {
-	ret := _m.Called(basePR)
+// NewPullRequest provides a mock function with given fields: ctx, basePR
+func (_m *PullRequestService) NewPullRequest(ctx context.Context, basePR domain.PullRequestShort) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, basePR)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewPullRequest")
@@ -88,17 +323,17 @@ func (_m *PullRequestService) NewPullRequest(basePR domain.PullRequestShort) (do
 
 	var r0 domain.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(domain.PullRequestShort) (domain.PullRequest, error)); ok {
-		return rf(basePR)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PullRequestShort) (domain.PullRequest, error)); ok {
+		return rf(ctx, basePR)
 	}
-	if rf, ok := ret.Get(0).(func(domain.PullRequestShort) domain.PullRequest); ok {
-		r0 = rf(basePR)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PullRequestShort) domain.PullRequest); ok {
+		r0 = rf(ctx, basePR)
 	} else {
 		r0 = ret.Get(0).(domain.PullRequest)
 	}
 
-	if rf, ok := ret.Get(1).(func(domain.PullRequestShort) error); ok {
-		r1 = rf(basePR)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PullRequestShort) error); ok {
+		r1 = rf(ctx, basePR)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,14 +347,15 @@ type PullRequestService_NewPullRequest_Call struct {
 }
 
 // NewPullRequest is a helper method to define mock.On call
+//   - ctx context.Context
 //   - basePR domain.PullRequestShort
-func (_e *PullRequestService_Expecter) NewPullRequest(basePR interface{}) *PullRequestService_NewPullRequest_Call {
-	return &PullRequestService_NewPullRequest_Call{Call: _e.mock.On("NewPullRequest", basePR)}
+func (_e *PullRequestService_Expecter) NewPullRequest(ctx interface{}, basePR interface{}) *PullRequestService_NewPullRequest_Call {
+	return &PullRequestService_NewPullRequest_Call{Call: _e.mock.On("NewPullRequest", ctx, basePR)}
 }
 
-func (_c *PullRequestService_NewPullRequest_Call) Run(run func(basePR domain.PullRequestShort)) *PullRequestService_NewPullRequest_Call {
+func (_c *PullRequestService_NewPullRequest_Call) Run(run func(ctx context.Context, basePR domain.PullRequestShort)) *PullRequestService_NewPullRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(domain.PullRequestShort))
+		run(args[0].(context.Context), args[1].(domain.PullRequestShort))
 	})
 	return _c
 }
@@ -129,7 +365,66 @@ func (_c *PullRequestService_NewPullRequest_Call) Return(_a0 domain.PullRequest,
 	return _c
 }
 
-func (_c *PullRequestService_NewPullRequest_Call) RunAndReturn(run func(domain.PullRequestShort) (domain.PullRequest, error)) *PullRequestService_NewPullRequest_Call {
+func (_c *PullRequestService_NewPullRequest_Call) RunAndReturn(run func(context.Context, domain.PullRequestShort) (domain.PullRequest, error)) *PullRequestService_NewPullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ready provides a mock function with given fields: ctx, id, version, audit
+func (_m *PullRequestService) Ready(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id, version, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ready")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)); ok {
+		return rf(ctx, id, version, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) domain.PullRequest); ok {
+		r0 = rf(ctx, id, version, audit)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) error); ok {
+		r1 = rf(ctx, id, version, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_Ready_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ready'
+type PullRequestService_Ready_Call struct {
+	*mock.Call
+}
+
+// Ready is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+//   - version int
+//   - audit domain.AssignmentAudit
+func (_e *PullRequestService_Expecter) Ready(ctx interface{}, id interface{}, version interface{}, audit interface{}) *PullRequestService_Ready_Call {
+	return &PullRequestService_Ready_Call{Call: _e.mock.On("Ready", ctx, id, version, audit)}
+}
+
+func (_c *PullRequestService_Ready_Call) Run(run func(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit)) *PullRequestService_Ready_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(int), args[3].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_Ready_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_Ready_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_Ready_Call) RunAndReturn(run func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)) *PullRequestService_Ready_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -191,6 +486,236 @@ func (_c *PullRequestService_Reasign_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// Reopen provides a mock function with given fields: ctx, id, version, audit
+func (_m *PullRequestService) Reopen(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, id, version, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reopen")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)); ok {
+		return rf(ctx, id, version, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) domain.PullRequest); ok {
+		r0 = rf(ctx, id, version, audit)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrId, int, domain.AssignmentAudit) error); ok {
+		r1 = rf(ctx, id, version, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_Reopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reopen'
+type PullRequestService_Reopen_Call struct {
+	*mock.Call
+}
+
+// Reopen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.PrId
+//   - version int
+//   - audit domain.AssignmentAudit
+func (_e *PullRequestService_Expecter) Reopen(ctx interface{}, id interface{}, version interface{}, audit interface{}) *PullRequestService_Reopen_Call {
+	return &PullRequestService_Reopen_Call{Call: _e.mock.On("Reopen", ctx, id, version, audit)}
+}
+
+func (_c *PullRequestService_Reopen_Call) Run(run func(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit)) *PullRequestService_Reopen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrId), args[2].(int), args[3].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_Reopen_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_Reopen_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_Reopen_Call) RunAndReturn(run func(context.Context, domain.PrId, int, domain.AssignmentAudit) (domain.PullRequest, error)) *PullRequestService_Reopen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveMember provides a mock function with given fields: ctx, ref
+func (_m *PullRequestService) ResolveMember(ctx context.Context, ref domain.MemberRef) (domain.MemberId, error) {
+	ret := _m.Called(ctx, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveMember")
+	}
+
+	var r0 domain.MemberId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberRef) (domain.MemberId, error)); ok {
+		return rf(ctx, ref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberRef) domain.MemberId); ok {
+		r0 = rf(ctx, ref)
+	} else {
+		r0 = ret.Get(0).(domain.MemberId)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MemberRef) error); ok {
+		r1 = rf(ctx, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_ResolveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveMember'
+type PullRequestService_ResolveMember_Call struct {
+	*mock.Call
+}
+
+// ResolveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ref domain.MemberRef
+func (_e *PullRequestService_Expecter) ResolveMember(ctx interface{}, ref interface{}) *PullRequestService_ResolveMember_Call {
+	return &PullRequestService_ResolveMember_Call{Call: _e.mock.On("ResolveMember", ctx, ref)}
+}
+
+func (_c *PullRequestService_ResolveMember_Call) Run(run func(ctx context.Context, ref domain.MemberRef)) *PullRequestService_ResolveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberRef))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_ResolveMember_Call) Return(_a0 domain.MemberId, _a1 error) *PullRequestService_ResolveMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_ResolveMember_Call) RunAndReturn(run func(context.Context, domain.MemberRef) (domain.MemberId, error)) *PullRequestService_ResolveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolvePullRequest provides a mock function with given fields: ctx, ref
+func (_m *PullRequestService) ResolvePullRequest(ctx context.Context, ref domain.PrRef) (domain.PrId, error) {
+	ret := _m.Called(ctx, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolvePullRequest")
+	}
+
+	var r0 domain.PrId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrRef) (domain.PrId, error)); ok {
+		return rf(ctx, ref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PrRef) domain.PrId); ok {
+		r0 = rf(ctx, ref)
+	} else {
+		r0 = ret.Get(0).(domain.PrId)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PrRef) error); ok {
+		r1 = rf(ctx, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_ResolvePullRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolvePullRequest'
+type PullRequestService_ResolvePullRequest_Call struct {
+	*mock.Call
+}
+
+// ResolvePullRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ref domain.PrRef
+func (_e *PullRequestService_Expecter) ResolvePullRequest(ctx interface{}, ref interface{}) *PullRequestService_ResolvePullRequest_Call {
+	return &PullRequestService_ResolvePullRequest_Call{Call: _e.mock.On("ResolvePullRequest", ctx, ref)}
+}
+
+func (_c *PullRequestService_ResolvePullRequest_Call) Run(run func(ctx context.Context, ref domain.PrRef)) *PullRequestService_ResolvePullRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PrRef))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_ResolvePullRequest_Call) Return(_a0 domain.PrId, _a1 error) *PullRequestService_ResolvePullRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_ResolvePullRequest_Call) RunAndReturn(run func(context.Context, domain.PrRef) (domain.PrId, error)) *PullRequestService_ResolvePullRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitReview provides a mock function with given fields: review, version
+func (_m *PullRequestService) SubmitReview(review domain.Review, version int) (domain.PullRequest, error) {
+	ret := _m.Called(review, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitReview")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.Review, int) (domain.PullRequest, error)); ok {
+		return rf(review, version)
+	}
+	if rf, ok := ret.Get(0).(func(domain.Review, int) domain.PullRequest); ok {
+		r0 = rf(review, version)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.Review, int) error); ok {
+		r1 = rf(review, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PullRequestService_SubmitReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitReview'
+type PullRequestService_SubmitReview_Call struct {
+	*mock.Call
+}
+
+// SubmitReview is a helper method to define mock.On call
+//   - review domain.Review
+//   - version int
+func (_e *PullRequestService_Expecter) SubmitReview(review interface{}, version interface{}) *PullRequestService_SubmitReview_Call {
+	return &PullRequestService_SubmitReview_Call{Call: _e.mock.On("SubmitReview", review, version)}
+}
+
+func (_c *PullRequestService_SubmitReview_Call) Run(run func(review domain.Review, version int)) *PullRequestService_SubmitReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.Review), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PullRequestService_SubmitReview_Call) Return(_a0 domain.PullRequest, _a1 error) *PullRequestService_SubmitReview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PullRequestService_SubmitReview_Call) RunAndReturn(run func(domain.Review, int) (domain.PullRequest, error)) *PullRequestService_SubmitReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPullRequestService creates a new instance of PullRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPullRequestService(t interface {
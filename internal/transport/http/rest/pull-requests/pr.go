@@ -3,7 +3,11 @@ package restpullrequests
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	"github.com/eragon-mdi/pr-reviewer-service/pkg/validator"
@@ -15,10 +19,24 @@ var (
 	ErrBadReqBody  = echo.NewHTTPError(http.StatusBadRequest, "bad req body")
 )
 
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
 type PullRequestService interface {
-	Merge(id domain.PrId) (domain.PullRequest, error)
-	NewPullRequest(basePR domain.PullRequestShort) (domain.PullRequest, error)
+	Merge(ctx context.Context, id domain.PrId, version int) (domain.PullRequest, error)
+	NewPullRequest(ctx context.Context, basePR domain.PullRequestShort) (domain.PullRequest, error)
 	Reasign(ctx context.Context, prReasMem domain.PrReasignMember) (domain.PrWithReasignMember, error)
+	SubmitReview(review domain.Review, version int) (domain.PullRequest, error)
+	History(ctx context.Context, id domain.PrId) (domain.AssignmentEvents, error)
+	Get(ctx context.Context, id domain.PrId) (domain.PullRequest, error)
+	List(ctx context.Context, filter domain.PrFilter, page domain.PageRequest) (domain.PullRequestsPage, error)
+	Ready(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error)
+	Close(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error)
+	Reopen(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error)
+	ResolveMember(ctx context.Context, ref domain.MemberRef) (domain.MemberId, error)
+	ResolvePullRequest(ctx context.Context, ref domain.PrRef) (domain.PrId, error)
 }
 
 func (prt *RestPullRequests) CreatePullRequest(c echo.Context) error {
@@ -37,22 +55,41 @@ func (prt *RestPullRequests) CreatePullRequest(c echo.Context) error {
 		return ErrBadReqBody
 	}
 
-	pr, err := prt.s.NewPullRequest(req.domain())
+	if !domain.PrRef(req.PullRequestID).Valid() {
+		l.Errorf("invalid pull_request_id: %s", req.PullRequestID)
+		return ErrBadReqBody
+	}
+
+	authorID, err := prt.resolveMember(c, req.AuthorID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+	req.AuthorID = authorID.String()
+
+	pr, err := prt.s.NewPullRequest(c.Request().Context(), req.domain())
 	if err != nil {
 		l.Errorf("failed to create pull request: %v", err)
 
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
 		if errors.Is(err, domain.ErrDuplicate) {
 			return domain.HttpErrPRExists()
 		}
 		if errors.Is(err, domain.ErrNotFound) {
 			return domain.HttpErrNotFound()
 		}
+		if errors.Is(err, domain.ErrCapacityExceeded) {
+			return domain.HttpErrNoCapacity()
+		}
 		return domain.ErrInternal
 	}
 
 	l = l.With("pr_id", pr.Id.String())
 	l.Infof("pull request created successfully")
 
+	setETag(c, pr)
 	return c.JSON(http.StatusCreated, echo.Map{
 		"pr": pullRequestResponse(pr),
 	})
@@ -74,22 +111,47 @@ func (prt *RestPullRequests) MergePullRequest(c echo.Context) error {
 		return ErrBadReqBody
 	}
 
-	pr, err := prt.s.Merge(domain.PrId(req.PullRequestID))
+	prID, err := prt.resolvePullRequest(c, req.PullRequestID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve pull request: %v", err)
+		return err
+	}
+	req.PullRequestID = prID.String()
+
+	version, err := ifMatch(c)
+	if err != nil {
+		l.Errorf("failed to read If-Match: %v", err)
+		return domain.HttpErrPRVersion()
+	}
+
+	pr, err := prt.s.Merge(c.Request().Context(), domain.PrId(req.PullRequestID), version)
 	if err != nil {
 		l.Errorf("failed to merge pull request: %v", err)
 
+		var blocked *domain.MergeBlockedError
+		if errors.As(err, &blocked) {
+			return domain.HttpErrMergeBlocked(blocked.Unmet)
+		}
+
 		if errors.Is(err, domain.ErrNotFound) {
 			return domain.HttpErrNotFound()
 		}
+		if errors.Is(err, domain.ErrVersionMismatch) {
+			return domain.HttpErrPRVersion()
+		}
 		if errors.Is(err, domain.ErrConflict) {
 			return domain.HttpErrPRMerged()
 		}
+		if errors.Is(err, domain.ErrInvalidPrState) {
+			return domain.HttpErrPRState()
+		}
 		return domain.ErrInternal
 	}
 
 	l = l.With("pr_id", pr.Id.String())
 	l.Infof("pull request merged successfully")
 
+	setETag(c, pr)
 	return c.JSON(http.StatusOK, echo.Map{
 		"pr": pullRequestResponse(pr),
 	})
@@ -111,9 +173,31 @@ func (prt *RestPullRequests) ReassignUserForPullRequest(c echo.Context) error {
 		return ErrBadReqBody
 	}
 
+	prID, err := prt.resolvePullRequest(c, req.PullRequestID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve pull request: %v", err)
+		return err
+	}
+	req.PullRequestID = prID.String()
+
+	oldUserID, err := prt.resolveMember(c, req.OldUserID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+	req.OldUserID = oldUserID.String()
+
+	version, err := ifMatch(c)
+	if err != nil {
+		l.Errorf("failed to read If-Match: %v", err)
+		return domain.HttpErrPRVersion()
+	}
+
 	prReasMem := domain.PrReasignMember{
 		PrId:     domain.PrId(req.PullRequestID),
 		MemberId: domain.MemberId(req.OldUserID),
+		Audit:    domain.AssignmentAudit{Actor: req.Actor, Reason: req.Reason},
+		Version:  version,
 	}
 
 	prWithNewMember, err := prt.s.Reasign(c.Request().Context(), prReasMem)
@@ -123,9 +207,18 @@ func (prt *RestPullRequests) ReassignUserForPullRequest(c echo.Context) error {
 		if errors.Is(err, domain.ErrNotFound) {
 			return domain.HttpErrNotFound()
 		}
+		if errors.Is(err, domain.ErrVersionMismatch) {
+			return domain.HttpErrPRVersion()
+		}
 		if errors.Is(err, domain.ErrConflict) {
 			return domain.HttpErrPRMerged()
 		}
+		if errors.Is(err, domain.ErrInvalidPrState) {
+			return domain.HttpErrPRState()
+		}
+		if errors.Is(err, domain.ErrCapacityExceeded) {
+			return domain.HttpErrNoCapacity()
+		}
 		if errors.Is(err, domain.ErrForbidden) {
 			if errors.Is(err, domain.ErrNoContent) {
 				return domain.HttpErrNoCandidate()
@@ -139,9 +232,364 @@ func (prt *RestPullRequests) ReassignUserForPullRequest(c echo.Context) error {
 		"replaced_by", prWithNewMember.MemberId.String())
 	l.Infof("pull request reassigned successfully")
 
+	setETag(c, prWithNewMember.PullRequest)
 	return c.JSON(http.StatusOK, reassignPRResponse(prWithNewMember))
 }
 
+func (prt *RestPullRequests) ReviewPullRequest(c echo.Context) error {
+	var req = &ReviewPRRequest{}
+
+	l := prt.l.With("req", req)
+	l.Infof("ReviewPullRequest called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	prID, err := prt.resolvePullRequest(c, req.PullRequestID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve pull request: %v", err)
+		return err
+	}
+	req.PullRequestID = prID.String()
+
+	userID, err := prt.resolveMember(c, req.UserID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+	req.UserID = userID.String()
+
+	version, err := ifMatch(c)
+	if err != nil {
+		l.Errorf("failed to read If-Match: %v", err)
+		return domain.HttpErrPRVersion()
+	}
+
+	pr, err := prt.s.SubmitReview(req.domain(), version)
+	if err != nil {
+		l.Errorf("failed to submit review: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		if errors.Is(err, domain.ErrVersionMismatch) {
+			return domain.HttpErrPRVersion()
+		}
+		if errors.Is(err, domain.ErrConflict) {
+			return domain.HttpErrReviewOnMerged()
+		}
+		if errors.Is(err, domain.ErrInvalidPrState) {
+			return domain.HttpErrPRState()
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return domain.HttpErrNotAssigned()
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("pr_id", pr.Id.String())
+	l.Infof("review submitted successfully")
+
+	setETag(c, pr)
+	return c.JSON(http.StatusOK, echo.Map{
+		"pr": pullRequestResponse(pr),
+	})
+}
+
+func (prt *RestPullRequests) ReadyPullRequest(c echo.Context) error {
+	return prt.changeStatus(c, "ReadyPullRequest", prt.s.Ready)
+}
+
+func (prt *RestPullRequests) ClosePullRequest(c echo.Context) error {
+	return prt.changeStatus(c, "ClosePullRequest", prt.s.Close)
+}
+
+func (prt *RestPullRequests) ReopenPullRequest(c echo.Context) error {
+	return prt.changeStatus(c, "ReopenPullRequest", prt.s.Reopen)
+}
+
+type statusChange func(ctx context.Context, id domain.PrId, version int, audit domain.AssignmentAudit) (domain.PullRequest, error)
+
+func (prt *RestPullRequests) changeStatus(c echo.Context, handler string, change statusChange) error {
+	var req = &PRStatusRequest{}
+
+	l := prt.l.With("req", req)
+	l.Infof("%s called", handler)
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	prID, err := prt.resolvePullRequest(c, req.PullRequestID, ErrBadReqBody)
+	if err != nil {
+		l.Errorf("failed to resolve pull request: %v", err)
+		return err
+	}
+	req.PullRequestID = prID.String()
+
+	version, err := ifMatch(c)
+	if err != nil {
+		l.Errorf("failed to read If-Match: %v", err)
+		return domain.HttpErrPRVersion()
+	}
+
+	pr, err := change(c.Request().Context(), domain.PrId(req.PullRequestID), version, req.audit())
+	if err != nil {
+		l.Errorf("failed to change pull request status: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		if errors.Is(err, domain.ErrVersionMismatch) {
+			return domain.HttpErrPRVersion()
+		}
+		if errors.Is(err, domain.ErrInvalidPrState) {
+			return domain.HttpErrPRState()
+		}
+		if errors.Is(err, domain.ErrCapacityExceeded) {
+			return domain.HttpErrNoCapacity()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("pr_id", pr.Id.String(), "status", pr.Status.String())
+	l.Infof("pull request status changed successfully")
+
+	setETag(c, pr)
+	return c.JSON(http.StatusOK, echo.Map{
+		"pr": pullRequestResponse(pr),
+	})
+}
+
+func (prt *RestPullRequests) GetPullRequestHistory(c echo.Context) error {
+	var req = &PRHistoryRequest{}
+
+	l := prt.l.With("req", req)
+	l.Infof("GetPullRequestHistory called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := unescapeParam(&req.PullRequestID); err != nil {
+		l.Errorf("failed to unescape id: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	prID, err := prt.resolvePullRequest(c, req.PullRequestID, ErrBadReqParam)
+	if err != nil {
+		l.Errorf("failed to resolve pull request: %v", err)
+		return err
+	}
+	req.PullRequestID = prID.String()
+
+	events, err := prt.s.History(c.Request().Context(), domain.PrId(req.PullRequestID))
+	if err != nil {
+		l.Errorf("failed to get pull request history: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("pr_id", req.PullRequestID, "events", len(events))
+	l.Infof("pull request history fetched successfully")
+
+	return c.JSON(http.StatusOK, prHistoryResponse(domain.PrId(req.PullRequestID), events))
+}
+
+func (prt *RestPullRequests) GetPullRequest(c echo.Context) error {
+	var req = &GetPRRequest{}
+
+	l := prt.l.With("req", req)
+	l.Infof("GetPullRequest called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := unescapeParam(&req.PullRequestID); err != nil {
+		l.Errorf("failed to unescape id: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	prID, err := prt.resolvePullRequest(c, req.PullRequestID, ErrBadReqParam)
+	if err != nil {
+		l.Errorf("failed to resolve pull request: %v", err)
+		return err
+	}
+	req.PullRequestID = prID.String()
+
+	pr, err := prt.s.Get(c.Request().Context(), domain.PrId(req.PullRequestID))
+	if err != nil {
+		l.Errorf("failed to get pull request: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("pr_id", pr.Id.String())
+	l.Infof("pull request fetched successfully")
+
+	setETag(c, pr)
+	return c.JSON(http.StatusOK, echo.Map{
+		"pr": pullRequestResponse(pr),
+	})
+}
+
+func (prt *RestPullRequests) ListPullRequests(c echo.Context) error {
+	var req = &ListPRsRequest{}
+
+	l := prt.l.With("req", req)
+	l.Infof("ListPullRequests called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	for _, ref := range []*string{&req.AuthorID, &req.ReviewerID} {
+		if *ref == "" {
+			continue
+		}
+		id, err := prt.resolveMember(c, *ref, ErrBadReqParam)
+		if err != nil {
+			l.Errorf("failed to resolve user: %v", err)
+			return err
+		}
+		*ref = id.String()
+	}
+
+	filter, page, err := req.domain()
+	if err != nil {
+		l.Errorf("invalid list query: %v", err)
+		return ErrBadReqParam
+	}
+
+	prs, err := prt.s.List(c.Request().Context(), filter, page)
+	if err != nil {
+		l.Errorf("failed to list pull requests: %v", err)
+
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqParam
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("count", len(prs.Items))
+	l.Infof("pull requests listed successfully")
+
+	return c.JSON(http.StatusOK, listPRsResponse(prs))
+}
+
+// ifMatch reads the expected PR version from If-Match, no header or * matches any version.
+// A weak or malformed tag never matches, as If-Match compares strong tags only.
+func ifMatch(c echo.Context) (int, error) {
+	tag := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	if tag == "" || tag == "*" {
+		return domain.AnyVersion, nil
+	}
+
+	version, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(tag, `"`), `"`))
+	if err != nil || version <= domain.AnyVersion {
+		return 0, fmt.Errorf("%w: bad If-Match %q", domain.ErrVersionMismatch, tag)
+	}
+	return version, nil
+}
+
+func setETag(c echo.Context, pr domain.PullRequest) {
+	c.Response().Header().Set(headerETag, strconv.Quote(strconv.Itoa(pr.Version)))
+}
+
+// resolveMember turns a validated user reference into the member id, UUIDs are taken as is
+// and an unknown provider:external_id is 404.
+func (prt *RestPullRequests) resolveMember(c echo.Context, ref string, badReq error) (domain.MemberId, error) {
+	if id, ok := domain.MemberRef(ref).MemberId(); ok {
+		return id, nil
+	}
+
+	id, err := prt.s.ResolveMember(c.Request().Context(), domain.MemberRef(ref))
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return "", badReq
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return "", domain.HttpErrNotFound()
+		}
+		return "", domain.ErrInternal
+	}
+
+	return id, nil
+}
+
+// resolvePullRequest turns a validated PR reference into the PR id, UUIDs are taken as is
+// and an unknown provider:repository#number is 404.
+func (prt *RestPullRequests) resolvePullRequest(c echo.Context, ref string, badReq error) (domain.PrId, error) {
+	if id, ok := domain.PrRef(ref).PrId(); ok {
+		return id, nil
+	}
+
+	id, err := prt.s.ResolvePullRequest(c.Request().Context(), domain.PrRef(ref))
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return "", badReq
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return "", domain.HttpErrNotFound()
+		}
+		return "", domain.ErrInternal
+	}
+
+	return id, nil
+}
+
+// unescapeParam decodes a path param, echo keeps them escaped and an external key
+// comes with its / and # encoded, e.g. github:acme%2Fapi%2342.
+func unescapeParam(param *string) error {
+	unescaped, err := url.PathUnescape(*param)
+	if err != nil {
+		return err
+	}
+	*param = unescaped
+	return nil
+}
+
 func validate(c echo.Context, structure any) error {
 	return validator.Validate(c.Request().Context(), structure)
 }
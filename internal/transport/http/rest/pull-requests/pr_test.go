@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -42,6 +43,7 @@ func TestRestPullRequests_CreatePullRequest(t *testing.T) {
 			serviceSetup: func(mockService *mocks.PullRequestService, req restpullrequests.CreatePRRequest) {
 				mockService.On(
 					"NewPullRequest",
+					mock.Anything,
 					mock.MatchedBy(func(pr domain.PullRequestShort) bool { return true }),
 				).Return(domain.PullRequest{
 					Id:        domain.PrId(req.PullRequestID),
@@ -63,6 +65,7 @@ func TestRestPullRequests_CreatePullRequest(t *testing.T) {
 			serviceSetup: func(mockService *mocks.PullRequestService, req restpullrequests.CreatePRRequest) {
 				mockService.On(
 					"NewPullRequest",
+					mock.Anything,
 					mock.MatchedBy(func(pr domain.PullRequestShort) bool { return true }),
 				).Return(domain.PullRequest{}, domain.ErrDuplicate)
 			},
@@ -138,8 +141,10 @@ func TestRestPullRequests_MergePullRequest(t *testing.T) {
 	tests := []struct {
 		name         string
 		requestBody  interface{}
+		ifMatch      string
 		serviceSetup func(*mocks.PullRequestService, string)
 		wantStatus   int
+		wantETag     string
 		wantErr      error
 	}{
 		{
@@ -148,7 +153,7 @@ func TestRestPullRequests_MergePullRequest(t *testing.T) {
 				PullRequestID: uuid.New().String(),
 			},
 			serviceSetup: func(mockService *mocks.PullRequestService, prID string) {
-				mockService.On("Merge", domain.PrId(prID)).
+				mockService.On("Merge", mock.Anything, domain.PrId(prID), domain.AnyVersion).
 					Return(domain.PullRequest{
 						Id:       domain.PrId(prID),
 						Status:   domain.PrStatusMerged,
@@ -157,17 +162,79 @@ func TestRestPullRequests_MergePullRequest(t *testing.T) {
 			},
 			wantStatus: http.StatusOK,
 		},
+		{
+			name: "merge by external key",
+			requestBody: restpullrequests.MergePRRequest{
+				PullRequestID: "github:acme/api#42",
+			},
+			serviceSetup: func(mockService *mocks.PullRequestService, _ string) {
+				prID := domain.ExternalPrId(domain.ProviderGithub, "acme/api", 42)
+				mockService.On("ResolvePullRequest", mock.Anything, domain.PrRef("github:acme/api#42")).Return(prID, nil)
+				mockService.On("Merge", mock.Anything, prID, domain.AnyVersion).
+					Return(domain.PullRequest{Id: prID, Status: domain.PrStatusMerged}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "merge with If-Match",
+			requestBody: restpullrequests.MergePRRequest{
+				PullRequestID: uuid.New().String(),
+			},
+			ifMatch: `"3"`,
+			serviceSetup: func(mockService *mocks.PullRequestService, prID string) {
+				mockService.On("Merge", mock.Anything, domain.PrId(prID), 3).
+					Return(domain.PullRequest{
+						Id:      domain.PrId(prID),
+						Status:  domain.PrStatusMerged,
+						Version: 4,
+					}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantETag:   `"4"`,
+		},
+		{
+			name: "stale If-Match",
+			requestBody: restpullrequests.MergePRRequest{
+				PullRequestID: uuid.New().String(),
+			},
+			ifMatch: `"3"`,
+			serviceSetup: func(mockService *mocks.PullRequestService, prID string) {
+				mockService.On("Merge", mock.Anything, domain.PrId(prID), 3).
+					Return(domain.PullRequest{}, domain.ErrVersionMismatch)
+			},
+			wantErr: domain.HttpErrPRVersion(),
+		},
+		{
+			name: "malformed If-Match",
+			requestBody: restpullrequests.MergePRRequest{
+				PullRequestID: uuid.New().String(),
+			},
+			ifMatch:      `W/"abc"`,
+			serviceSetup: func(mockService *mocks.PullRequestService, prID string) {},
+			wantErr:      domain.HttpErrPRVersion(),
+		},
 		{
 			name: "pr not found",
 			requestBody: restpullrequests.MergePRRequest{
 				PullRequestID: uuid.New().String(),
 			},
 			serviceSetup: func(mockService *mocks.PullRequestService, prID string) {
-				mockService.On("Merge", domain.PrId(prID)).
+				mockService.On("Merge", mock.Anything, domain.PrId(prID), domain.AnyVersion).
 					Return(domain.PullRequest{}, domain.ErrNotFound)
 			},
 			wantErr: domain.HttpErrNotFound(),
 		},
+		{
+			name: "merge blocked by policy",
+			requestBody: restpullrequests.MergePRRequest{
+				PullRequestID: uuid.New().String(),
+			},
+			serviceSetup: func(mockService *mocks.PullRequestService, prID string) {
+				mockService.On("Merge", mock.Anything, domain.PrId(prID), domain.AnyVersion).
+					Return(domain.PullRequest{}, &domain.MergeBlockedError{Unmet: []string{"approvals: 0 of 1 required"}})
+			},
+			wantErr: domain.HttpErrMergeBlocked([]string{"approvals: 0 of 1 required"}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -187,6 +254,9 @@ func TestRestPullRequests_MergePullRequest(t *testing.T) {
 
 			req := httptest.NewRequest(http.MethodPost, "/pullRequest/merge", bytes.NewReader(bodyBytes))
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 
@@ -207,6 +277,7 @@ func TestRestPullRequests_MergePullRequest(t *testing.T) {
 					if errors.As(err, &got) {
 						assert.Equal(t, want.HttpCode, got.HttpCode)
 						assert.Equal(t, want.Code, got.Code)
+						assert.Equal(t, want.Details, got.Details)
 					} else {
 						t.Fatalf("expected CustomHttpError, got %v", err)
 					}
@@ -216,6 +287,9 @@ func TestRestPullRequests_MergePullRequest(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.wantStatus, rec.Code)
+				if tt.wantETag != "" {
+					assert.Equal(t, tt.wantETag, rec.Header().Get("ETag"))
+				}
 			}
 		})
 	}
@@ -234,6 +308,8 @@ func TestRestPullRequests_ReassignUserForPullRequest(t *testing.T) {
 			requestBody: restpullrequests.ReassignPRRequest{
 				PullRequestID: uuid.New().String(),
 				OldUserID:     uuid.New().String(),
+				Actor:         "alice",
+				Reason:        "vacation",
 			},
 			serviceSetup: func(mockService *mocks.PullRequestService, req restpullrequests.ReassignPRRequest) {
 				mockService.On(
@@ -241,7 +317,8 @@ func TestRestPullRequests_ReassignUserForPullRequest(t *testing.T) {
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
 					mock.MatchedBy(func(prReasMem domain.PrReasignMember) bool {
 						return string(prReasMem.PrId) == req.PullRequestID &&
-							string(prReasMem.MemberId) == req.OldUserID
+							string(prReasMem.MemberId) == req.OldUserID &&
+							prReasMem.Audit == domain.AssignmentAudit{Actor: "alice", Reason: "vacation"}
 					}),
 				).Return(domain.PrWithReasignMember{
 					PullRequest: domain.PullRequest{
@@ -271,6 +348,54 @@ func TestRestPullRequests_ReassignUserForPullRequest(t *testing.T) {
 			},
 			wantErr: domain.HttpErrNotFound(),
 		},
+		{
+			name: "pr merged",
+			requestBody: restpullrequests.ReassignPRRequest{
+				PullRequestID: uuid.New().String(),
+				OldUserID:     uuid.New().String(),
+			},
+			serviceSetup: func(mockService *mocks.PullRequestService, req restpullrequests.ReassignPRRequest) {
+				mockService.On("Reasign", mock.Anything, mock.Anything).
+					Return(domain.PrWithReasignMember{}, domain.ErrConflict)
+			},
+			wantErr: domain.HttpErrPRMerged(),
+		},
+		{
+			name: "reviewer not assigned",
+			requestBody: restpullrequests.ReassignPRRequest{
+				PullRequestID: uuid.New().String(),
+				OldUserID:     uuid.New().String(),
+			},
+			serviceSetup: func(mockService *mocks.PullRequestService, req restpullrequests.ReassignPRRequest) {
+				mockService.On("Reasign", mock.Anything, mock.Anything).
+					Return(domain.PrWithReasignMember{}, domain.ErrForbidden)
+			},
+			wantErr: domain.HttpErrNotAssigned(),
+		},
+		{
+			name: "no candidate",
+			requestBody: restpullrequests.ReassignPRRequest{
+				PullRequestID: uuid.New().String(),
+				OldUserID:     uuid.New().String(),
+			},
+			serviceSetup: func(mockService *mocks.PullRequestService, req restpullrequests.ReassignPRRequest) {
+				mockService.On("Reasign", mock.Anything, mock.Anything).
+					Return(domain.PrWithReasignMember{}, fmt.Errorf("%w: %w", domain.ErrForbidden, domain.ErrNoContent))
+			},
+			wantErr: domain.HttpErrNoCandidate(),
+		},
+		{
+			name: "version mismatch",
+			requestBody: restpullrequests.ReassignPRRequest{
+				PullRequestID: uuid.New().String(),
+				OldUserID:     uuid.New().String(),
+			},
+			serviceSetup: func(mockService *mocks.PullRequestService, req restpullrequests.ReassignPRRequest) {
+				mockService.On("Reasign", mock.Anything, mock.Anything).
+					Return(domain.PrWithReasignMember{}, domain.ErrVersionMismatch)
+			},
+			wantErr: domain.HttpErrPRVersion(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -323,3 +448,707 @@ func TestRestPullRequests_ReassignUserForPullRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestRestPullRequests_CreatePullRequest_CandidatesLoad(t *testing.T) {
+	e := setupEcho()
+	mockService := mocks.NewPullRequestService(t)
+
+	reqBody := restpullrequests.CreatePRRequest{
+		PullRequestID:   uuid.New().String(),
+		PullRequestName: "Test PR",
+		AuthorID:        uuid.New().String(),
+	}
+	mockService.On("NewPullRequest", mock.Anything, mock.Anything).Return(domain.PullRequest{
+		Id:              domain.PrId(reqBody.PullRequestID),
+		AuthorId:        domain.MemberId(reqBody.AuthorID),
+		Status:          domain.PrStatusOpen,
+		AssignedReviews: domain.Members{{Id: "rev-1"}},
+		Candidates: domain.MembersHistories{
+			domain.NewMemberHistory("rev-1", domain.MemberStatusActive, domain.MemberRoleDefault, false).WithLoad(0),
+			domain.NewMemberHistory("rev-2", domain.MemberStatusActive, domain.MemberRoleDefault, false).WithLoad(3),
+		},
+	}, nil)
+
+	handler := restpullrequests.New(mockService, zap.NewNop().Sugar())
+
+	bodyBytes, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", bytes.NewReader(bodyBytes))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	err := handler.CreatePullRequest(e.NewContext(req, rec))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	var resp struct {
+		PR restpullrequests.PRResponse `json:"pr"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, []restpullrequests.CandidateLoad{
+		{UserID: "rev-1", OpenReviews: 0},
+		{UserID: "rev-2", OpenReviews: 3},
+	}, resp.PR.CandidatesLoad)
+}
+
+func TestRestPullRequests_CreatePullRequest_ExternalKey(t *testing.T) {
+	key := domain.NewExternalPrKey(domain.ProviderGithub, "acme/api", 42)
+	authorID := uuid.New().String()
+
+	t.Run("created under the derived id", func(t *testing.T) {
+		e := setupEcho()
+		mockService := mocks.NewPullRequestService(t)
+		mockService.On("NewPullRequest", mock.Anything, mock.MatchedBy(func(pr domain.PullRequestShort) bool {
+			return pr.Id == key.PrId() && pr.External == key
+		})).Return(domain.PullRequest{
+			Id:       key.PrId(),
+			External: key,
+			AuthorId: domain.MemberId(authorID),
+			Status:   domain.PrStatusOpen,
+		}, nil)
+
+		handler := restpullrequests.New(mockService, zap.NewNop().Sugar())
+
+		bodyBytes, _ := json.Marshal(restpullrequests.CreatePRRequest{
+			PullRequestID:   "github:acme/api#42",
+			PullRequestName: "Test PR",
+			AuthorID:        authorID,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", bytes.NewReader(bodyBytes))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		err := handler.CreatePullRequest(e.NewContext(req, rec))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp struct {
+			PR restpullrequests.PRResponse `json:"pr"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, key.PrId().String(), resp.PR.PullRequestID)
+		assert.Equal(t, "github:acme/api#42", resp.PR.ExternalID)
+	})
+
+	t.Run("zero number", func(t *testing.T) {
+		e := setupEcho()
+		handler := restpullrequests.New(mocks.NewPullRequestService(t), zap.NewNop().Sugar())
+
+		bodyBytes, _ := json.Marshal(restpullrequests.CreatePRRequest{
+			PullRequestID:   "github:acme/api#0",
+			PullRequestName: "Test PR",
+			AuthorID:        authorID,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", bytes.NewReader(bodyBytes))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		err := handler.CreatePullRequest(e.NewContext(req, httptest.NewRecorder()))
+
+		var got *echo.HTTPError
+		if assert.True(t, errors.As(err, &got)) {
+			assert.Equal(t, http.StatusBadRequest, got.Code)
+		}
+	})
+}
+
+func TestRestPullRequests_ReviewPullRequest(t *testing.T) {
+	prID := uuid.New().String()
+	userID := uuid.New().String()
+	reviewedAt := time.Date(2025, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.PullRequestService)
+		wantStatus   int
+		wantReviews  []restpullrequests.ReviewResponse
+		wantErr      error
+	}{
+		{
+			name:        "approve",
+			requestBody: restpullrequests.ReviewPRRequest{PullRequestID: prID, UserID: userID, State: "APPROVED"},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("SubmitReview", domain.Review{
+					PrId:     domain.PrId(prID),
+					MemberId: domain.MemberId(userID),
+					State:    domain.ReviewStateApproved,
+				}, domain.AnyVersion).Return(domain.PullRequest{
+					Id:     domain.PrId(prID),
+					Status: domain.PrStatusOpen,
+					Reviews: domain.Reviews{
+						{MemberId: domain.MemberId(userID), State: domain.ReviewStateApproved, ReviewedAt: reviewedAt},
+						{MemberId: "rev-2", State: domain.ReviewStatePending},
+					},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantReviews: []restpullrequests.ReviewResponse{
+				{UserID: userID, State: "APPROVED", ReviewedAt: ptr(reviewedAt.Format(time.RFC3339))},
+				{UserID: "rev-2", State: "PENDING"},
+			},
+		},
+		{
+			name:        "reviewer referenced by identity",
+			requestBody: restpullrequests.ReviewPRRequest{PullRequestID: prID, UserID: "github:octocat", State: "APPROVED"},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("github:octocat")).
+					Return(domain.MemberId(userID), nil)
+				mockService.On("SubmitReview", domain.Review{
+					PrId:     domain.PrId(prID),
+					MemberId: domain.MemberId(userID),
+					State:    domain.ReviewStateApproved,
+				}, domain.AnyVersion).Return(domain.PullRequest{
+					Id:      domain.PrId(prID),
+					Status:  domain.PrStatusOpen,
+					Reviews: domain.Reviews{{MemberId: domain.MemberId(userID), State: domain.ReviewStateApproved, ReviewedAt: reviewedAt}},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantReviews: []restpullrequests.ReviewResponse{
+				{UserID: userID, State: "APPROVED", ReviewedAt: ptr(reviewedAt.Format(time.RFC3339))},
+			},
+		},
+		{
+			name:        "unknown identity",
+			requestBody: restpullrequests.ReviewPRRequest{PullRequestID: prID, UserID: "github:ghost", State: "APPROVED"},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("github:ghost")).
+					Return(domain.MemberId(""), domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:         "unknown state",
+			requestBody:  restpullrequests.ReviewPRRequest{PullRequestID: prID, UserID: userID, State: "PENDING"},
+			serviceSetup: func(mockService *mocks.PullRequestService) {},
+			wantErr:      restpullrequests.ErrBadReqBody,
+		},
+		{
+			name:        "pr not found",
+			requestBody: restpullrequests.ReviewPRRequest{PullRequestID: prID, UserID: userID, State: "COMMENTED"},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("SubmitReview", mock.Anything, domain.AnyVersion).Return(domain.PullRequest{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:        "pr merged",
+			requestBody: restpullrequests.ReviewPRRequest{PullRequestID: prID, UserID: userID, State: "CHANGES_REQUESTED"},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("SubmitReview", mock.Anything, domain.AnyVersion).Return(domain.PullRequest{}, domain.ErrConflict)
+			},
+			wantErr: domain.HttpErrReviewOnMerged(),
+		},
+		{
+			name:        "reviewer not assigned",
+			requestBody: restpullrequests.ReviewPRRequest{PullRequestID: prID, UserID: userID, State: "APPROVED"},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("SubmitReview", mock.Anything, domain.AnyVersion).Return(domain.PullRequest{}, domain.ErrForbidden)
+			},
+			wantErr: domain.HttpErrNotAssigned(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewPullRequestService(t)
+			tt.serviceSetup(mockService)
+
+			handler := restpullrequests.New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/review", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err := handler.ReviewPullRequest(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				switch want := tt.wantErr.(type) {
+				case *echo.HTTPError:
+					var got *echo.HTTPError
+					if assert.True(t, errors.As(err, &got)) {
+						assert.Equal(t, want.Code, got.Code)
+					}
+				case *domain.CustomHttpError:
+					var got *domain.CustomHttpError
+					if assert.True(t, errors.As(err, &got)) {
+						assert.Equal(t, want.HttpCode, got.HttpCode)
+						assert.Equal(t, want.Code, got.Code)
+						assert.Equal(t, want.Message, got.Message)
+					}
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp struct {
+				PR restpullrequests.PRResponse `json:"pr"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantReviews, resp.PR.Reviews)
+		})
+	}
+}
+
+func TestRestPullRequests_ChangeStatus(t *testing.T) {
+	prID := uuid.New().String()
+	audit := domain.AssignmentAudit{Actor: "alice", Reason: "abandoned"}
+
+	tests := []struct {
+		name         string
+		handler      func(*restpullrequests.RestPullRequests, echo.Context) error
+		requestBody  interface{}
+		serviceSetup func(*mocks.PullRequestService)
+		wantStatus   string
+		wantErr      error
+	}{
+		{
+			name:        "ready",
+			handler:     (*restpullrequests.RestPullRequests).ReadyPullRequest,
+			requestBody: restpullrequests.PRStatusRequest{PullRequestID: prID},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("Ready", mock.Anything, domain.PrId(prID), domain.AnyVersion, domain.AssignmentAudit{}).
+					Return(domain.PullRequest{Id: domain.PrId(prID), Status: domain.PrStatusOpen}, nil)
+			},
+			wantStatus: "OPEN",
+		},
+		{
+			name:        "close with audit",
+			handler:     (*restpullrequests.RestPullRequests).ClosePullRequest,
+			requestBody: restpullrequests.PRStatusRequest{PullRequestID: prID, Actor: audit.Actor, Reason: audit.Reason},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("Close", mock.Anything, domain.PrId(prID), domain.AnyVersion, audit).
+					Return(domain.PullRequest{Id: domain.PrId(prID), Status: domain.PrStatusClosed}, nil)
+			},
+			wantStatus: "CLOSED",
+		},
+		{
+			name:        "reopen merged pr",
+			handler:     (*restpullrequests.RestPullRequests).ReopenPullRequest,
+			requestBody: restpullrequests.PRStatusRequest{PullRequestID: prID},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("Reopen", mock.Anything, domain.PrId(prID), domain.AnyVersion, domain.AssignmentAudit{}).
+					Return(domain.PullRequest{}, fmt.Errorf("%w: cannot reopen MERGED pull request", domain.ErrInvalidPrState))
+			},
+			wantErr: domain.HttpErrPRState(),
+		},
+		{
+			name:        "ready without capacity",
+			handler:     (*restpullrequests.RestPullRequests).ReadyPullRequest,
+			requestBody: restpullrequests.PRStatusRequest{PullRequestID: prID},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("Ready", mock.Anything, domain.PrId(prID), domain.AnyVersion, domain.AssignmentAudit{}).
+					Return(domain.PullRequest{}, domain.ErrCapacityExceeded)
+			},
+			wantErr: domain.HttpErrNoCapacity(),
+		},
+		{
+			name:        "pr not found",
+			handler:     (*restpullrequests.RestPullRequests).ClosePullRequest,
+			requestBody: restpullrequests.PRStatusRequest{PullRequestID: prID},
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("Close", mock.Anything, domain.PrId(prID), domain.AnyVersion, domain.AssignmentAudit{}).
+					Return(domain.PullRequest{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:         "invalid id",
+			handler:      (*restpullrequests.RestPullRequests).ReopenPullRequest,
+			requestBody:  restpullrequests.PRStatusRequest{PullRequestID: "not-a-uuid"},
+			serviceSetup: func(mockService *mocks.PullRequestService) {},
+			wantErr:      restpullrequests.ErrBadReqBody,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewPullRequestService(t)
+			tt.serviceSetup(mockService)
+
+			handler := restpullrequests.New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/status", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err := tt.handler(handler, e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				switch want := tt.wantErr.(type) {
+				case *echo.HTTPError:
+					var got *echo.HTTPError
+					if assert.True(t, errors.As(err, &got)) {
+						assert.Equal(t, want.Code, got.Code)
+					}
+				case *domain.CustomHttpError:
+					var got *domain.CustomHttpError
+					if assert.True(t, errors.As(err, &got)) {
+						assert.Equal(t, want.HttpCode, got.HttpCode)
+						assert.Equal(t, want.Code, got.Code)
+					}
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp struct {
+				PR restpullrequests.PRResponse `json:"pr"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantStatus, resp.PR.Status)
+		})
+	}
+}
+
+func TestRestPullRequests_GetPullRequestHistory(t *testing.T) {
+	prID := uuid.New().String()
+	oldID := uuid.New().String()
+	newID := uuid.New().String()
+	at := time.Date(2025, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		prID         string
+		serviceSetup func(*mocks.PullRequestService)
+		wantStatus   int
+		wantBody     restpullrequests.PRHistoryResponse
+		wantErr      error
+	}{
+		{
+			name: "success",
+			prID: prID,
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("History", mock.Anything, domain.PrId(prID)).Return(domain.AssignmentEvents{
+					{
+						Type:            domain.AssignmentEventAssigned,
+						NewMemberId:     domain.MemberId(oldID),
+						AssignmentAudit: domain.SystemAudit(domain.AssignmentReasonPrCreated),
+						CreatedAt:       at,
+					},
+					{
+						Type:            domain.AssignmentEventReassigned,
+						OldMemberId:     domain.MemberId(oldID),
+						NewMemberId:     domain.MemberId(newID),
+						AssignmentAudit: domain.AssignmentAudit{Actor: "alice", Reason: "vacation"},
+						CreatedAt:       at.Add(time.Hour),
+					},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: restpullrequests.PRHistoryResponse{
+				PullRequestID: prID,
+				Events: []restpullrequests.AssignmentEventEntry{
+					{Type: "assigned", NewReviewerID: oldID, Actor: "system", Reason: "pr_created", CreatedAt: at.Format(time.RFC3339)},
+					{Type: "reassigned", OldReviewerID: oldID, NewReviewerID: newID, Actor: "alice", Reason: "vacation", CreatedAt: at.Add(time.Hour).Format(time.RFC3339)},
+				},
+			},
+		},
+		{
+			name:         "invalid id",
+			prID:         "not-a-uuid",
+			serviceSetup: func(mockService *mocks.PullRequestService) {},
+			wantErr:      restpullrequests.ErrBadReqParam,
+		},
+		{
+			name: "pr not found",
+			prID: prID,
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("History", mock.Anything, domain.PrId(prID)).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewPullRequestService(t)
+			tt.serviceSetup(mockService)
+
+			handler := restpullrequests.New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodGet, "/pullRequest/"+tt.prID+"/history", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.prID)
+
+			err := handler.GetPullRequestHistory(c)
+
+			if tt.wantErr != nil {
+				switch want := tt.wantErr.(type) {
+				case *echo.HTTPError:
+					var got *echo.HTTPError
+					if assert.True(t, errors.As(err, &got)) {
+						assert.Equal(t, want.Code, got.Code)
+					}
+				case *domain.CustomHttpError:
+					var got *domain.CustomHttpError
+					if assert.True(t, errors.As(err, &got)) {
+						assert.Equal(t, want.HttpCode, got.HttpCode)
+						assert.Equal(t, want.Code, got.Code)
+					}
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp restpullrequests.PRHistoryResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantBody, resp)
+		})
+	}
+}
+
+func TestRestPullRequests_GetPullRequest(t *testing.T) {
+	prID := uuid.New().String()
+	authorID := uuid.New().String()
+
+	tests := []struct {
+		name         string
+		prID         string
+		serviceSetup func(*mocks.PullRequestService)
+		wantStatus   int
+		wantErr      error
+	}{
+		{
+			name: "success",
+			prID: prID,
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("Get", mock.Anything, domain.PrId(prID)).Return(domain.PullRequest{
+					Id:       domain.PrId(prID),
+					Name:     domain.PrName("Test PR"),
+					AuthorId: domain.MemberId(authorID),
+					Status:   domain.PrStatusOpen,
+					Version:  2,
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "by escaped external key",
+			prID: "github:acme%2Fapi%2342",
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("ResolvePullRequest", mock.Anything, domain.PrRef("github:acme/api#42")).
+					Return(domain.PrId(prID), nil)
+				mockService.On("Get", mock.Anything, domain.PrId(prID)).Return(domain.PullRequest{
+					Id:       domain.PrId(prID),
+					External: domain.NewExternalPrKey(domain.ProviderGithub, "acme/api", 42),
+					Name:     domain.PrName("Test PR"),
+					AuthorId: domain.MemberId(authorID),
+					Status:   domain.PrStatusOpen,
+					Version:  2,
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "unknown external key",
+			prID: "github:acme%2Fapi%2343",
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("ResolvePullRequest", mock.Anything, domain.PrRef("github:acme/api#43")).
+					Return(domain.PrId(""), domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:         "invalid id",
+			prID:         "not-a-uuid",
+			serviceSetup: func(mockService *mocks.PullRequestService) {},
+			wantErr:      restpullrequests.ErrBadReqParam,
+		},
+		{
+			name: "pr not found",
+			prID: prID,
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("Get", mock.Anything, domain.PrId(prID)).Return(domain.PullRequest{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewPullRequestService(t)
+			tt.serviceSetup(mockService)
+
+			handler := restpullrequests.New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodGet, "/pullRequest/"+tt.prID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.prID)
+
+			err := handler.GetPullRequest(c)
+
+			if tt.wantErr != nil {
+				switch want := tt.wantErr.(type) {
+				case *echo.HTTPError:
+					var got *echo.HTTPError
+					if assert.True(t, errors.As(err, &got)) {
+						assert.Equal(t, want.Code, got.Code)
+					}
+				case *domain.CustomHttpError:
+					var got *domain.CustomHttpError
+					if assert.True(t, errors.As(err, &got)) {
+						assert.Equal(t, want.HttpCode, got.HttpCode)
+						assert.Equal(t, want.Code, got.Code)
+					}
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp struct {
+				PR restpullrequests.PRResponse `json:"pr"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, prID, resp.PR.PullRequestID)
+			assert.Equal(t, authorID, resp.PR.AuthorID)
+			assert.Equal(t, "OPEN", resp.PR.Status)
+			assert.Equal(t, 2, resp.PR.Version)
+			assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		})
+	}
+}
+
+func TestRestPullRequests_ListPullRequests(t *testing.T) {
+	prID := uuid.New().String()
+	reviewerID := uuid.New().String()
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		query        string
+		serviceSetup func(*mocks.PullRequestService)
+		wantPRs      []string
+		wantNext     string
+		wantErr      error
+	}{
+		{
+			name:  "filters and page",
+			query: "?status=open,draft&reviewer_id=" + reviewerID + "&team_name=backend&from=" + from.Format(time.RFC3339) + "&limit=1",
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				filter := domain.PrFilter{
+					Statuses:   []domain.PrStatus{domain.PrStatusOpen, domain.PrStatusDraft},
+					ReviewerId: domain.MemberId(reviewerID),
+					Team:       domain.TeamName("backend"),
+					From:       from,
+				}
+				mockService.On("List", mock.Anything, filter, domain.PageRequest{Limit: 1}).Return(domain.PullRequestsPage{
+					Items:      []domain.PullRequest{{Id: domain.PrId(prID), Status: domain.PrStatusOpen}},
+					NextCursor: "next-page",
+				}, nil)
+			},
+			wantPRs:  []string{prID},
+			wantNext: "next-page",
+		},
+		{
+			name:  "author referenced by identity",
+			query: "?author_id=gitlab:octo",
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("gitlab:octo")).
+					Return(domain.MemberId(reviewerID), nil)
+				mockService.On("List", mock.Anything, domain.PrFilter{AuthorId: domain.MemberId(reviewerID)},
+					domain.PageRequest{Limit: domain.DefaultPageLimit}).Return(domain.PullRequestsPage{}, nil)
+			},
+			wantPRs: []string{},
+		},
+		{
+			name:  "no filters",
+			query: "",
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("List", mock.Anything, domain.PrFilter{}, domain.PageRequest{Limit: domain.DefaultPageLimit}).
+					Return(domain.PullRequestsPage{}, nil)
+			},
+			wantPRs: []string{},
+		},
+		{
+			name:         "unknown status",
+			query:        "?status=ABANDONED",
+			serviceSetup: func(mockService *mocks.PullRequestService) {},
+			wantErr:      restpullrequests.ErrBadReqParam,
+		},
+		{
+			name:         "invalid author id",
+			query:        "?author_id=not-a-uuid",
+			serviceSetup: func(mockService *mocks.PullRequestService) {},
+			wantErr:      restpullrequests.ErrBadReqParam,
+		},
+		{
+			name:         "invalid from",
+			query:        "?from=yesterday",
+			serviceSetup: func(mockService *mocks.PullRequestService) {},
+			wantErr:      restpullrequests.ErrBadReqParam,
+		},
+		{
+			name:         "malformed cursor",
+			query:        "?cursor=not-a-cursor",
+			serviceSetup: func(mockService *mocks.PullRequestService) {},
+			wantErr:      restpullrequests.ErrBadReqParam,
+		},
+		{
+			name:  "empty created range",
+			query: "?from=" + from.Format(time.RFC3339) + "&to=" + from.Format(time.RFC3339),
+			serviceSetup: func(mockService *mocks.PullRequestService) {
+				mockService.On("List", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.PullRequestsPage{}, domain.ErrValidation)
+			},
+			wantErr: restpullrequests.ErrBadReqParam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewPullRequestService(t)
+			tt.serviceSetup(mockService)
+
+			handler := restpullrequests.New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodGet, "/pullRequests"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := handler.ListPullRequests(c)
+
+			if tt.wantErr != nil {
+				var got *echo.HTTPError
+				if assert.True(t, errors.As(err, &got)) {
+					assert.Equal(t, http.StatusBadRequest, got.Code)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp restpullrequests.ListPRsResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			ids := make([]string, 0, len(resp.PullRequests))
+			for _, pr := range resp.PullRequests {
+				ids = append(ids, pr.PullRequestID)
+			}
+			assert.Equal(t, tt.wantPRs, ids)
+			assert.Equal(t, tt.wantNext, resp.NextCursor)
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
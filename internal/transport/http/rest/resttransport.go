@@ -4,7 +4,10 @@ import (
 	"github.com/eragon-mdi/pr-reviewer-service/internal/common/api"
 	restmembers "github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/members"
 	restpullrequests "github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/pull-requests"
+	reststats "github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/stats"
+	restsubscriptions "github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/subscriptions"
 	restteams "github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/teams"
+	restwebhooks "github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/webhooks"
 	"go.uber.org/zap"
 )
 
@@ -12,19 +15,28 @@ type RestTransport interface {
 	api.TeamTransport
 	api.UserTransport
 	api.PullRequestTransport
+	api.StatsTransport
+	api.WebhookTransport
+	api.SubscriptionTransport
 }
 
 type restTransport struct {
 	*restteams.RestTeams
 	*restmembers.RestMembers
 	*restpullrequests.RestPullRequests
+	*reststats.RestStats
+	*restwebhooks.RestWebhooks
+	*restsubscriptions.RestSubscriptions
 }
 
 func New(s Service, l *zap.SugaredLogger) RestTransport {
 	return &restTransport{
-		RestTeams:        restteams.New(s, l),
-		RestMembers:      restmembers.New(s, l),
-		RestPullRequests: restpullrequests.New(s, l),
+		RestTeams:         restteams.New(s, l),
+		RestMembers:       restmembers.New(s, l),
+		RestPullRequests:  restpullrequests.New(s, l),
+		RestStats:         reststats.New(s, l),
+		RestWebhooks:      restwebhooks.New(s, l),
+		RestSubscriptions: restsubscriptions.New(s, l),
 	}
 }
 
@@ -32,4 +44,7 @@ type Service interface {
 	restteams.TeamsService
 	restmembers.MembersService
 	restpullrequests.PullRequestService
+	reststats.StatsService
+	restwebhooks.WebhooksService
+	restsubscriptions.SubscriptionsService
 }
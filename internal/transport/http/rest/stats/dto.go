@@ -0,0 +1,106 @@
+package reststats
+
+import (
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
+
+// AssignmentStatsRequest bounds are RFC3339 timestamps, the range is [from, to).
+// Users and pull requests are paged by their own cursors, limit applies to both lists.
+type AssignmentStatsRequest struct {
+	TeamName           string `query:"team_name"`
+	From               string `query:"from"`
+	To                 string `query:"to"`
+	UsersCursor        string `query:"users_cursor"`
+	PullRequestsCursor string `query:"pull_requests_cursor"`
+	Limit              int    `query:"limit"`
+}
+
+type AssignmentStatsResponse struct {
+	Users                  []UserStatsResponse        `json:"users"`
+	PullRequests           []PullRequestStatsResponse `json:"pull_requests"`
+	NextUsersCursor        string                     `json:"next_users_cursor,omitempty"`
+	NextPullRequestsCursor string                     `json:"next_pull_requests_cursor,omitempty"`
+}
+
+type UserStatsResponse struct {
+	UserID         string `json:"user_id"`
+	Username       string `json:"username"`
+	TotalReviews   int    `json:"total_reviews"`
+	OpenReviews    int    `json:"open_reviews"`
+	MergedReviews  int    `json:"merged_reviews"`
+	ReassignedAway int    `json:"reassigned_away"`
+}
+
+type PullRequestStatsResponse struct {
+	PullRequestID   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+	AuthorID        string `json:"author_id"`
+	TeamName        string `json:"team_name,omitempty"`
+	Status          string `json:"status"`
+	CreatedAt       string `json:"createdAt"`
+	Reviewers       int    `json:"reviewers"`
+	Reassignments   int    `json:"reassignments"`
+}
+
+func (req *AssignmentStatsRequest) domain() (domain.StatsFilter, domain.StatsPageRequest, error) {
+	f := domain.StatsFilter{Team: domain.TeamName(req.TeamName)}
+
+	var err error
+	if req.From != "" {
+		if f.From, err = time.Parse(time.RFC3339, req.From); err != nil {
+			return domain.StatsFilter{}, domain.StatsPageRequest{}, err
+		}
+	}
+	if req.To != "" {
+		if f.To, err = time.Parse(time.RFC3339, req.To); err != nil {
+			return domain.StatsFilter{}, domain.StatsPageRequest{}, err
+		}
+	}
+
+	var page domain.StatsPageRequest
+	if page.Members, err = domain.NewMemberPageRequest(req.UsersCursor, req.Limit); err != nil {
+		return domain.StatsFilter{}, domain.StatsPageRequest{}, err
+	}
+	if page.PullRequests, err = domain.NewPageRequest(req.PullRequestsCursor, req.Limit); err != nil {
+		return domain.StatsFilter{}, domain.StatsPageRequest{}, err
+	}
+
+	return f, page, nil
+}
+
+func assignmentStatsResponse(s domain.AssignmentStats) AssignmentStatsResponse {
+	users := make([]UserStatsResponse, 0, len(s.Members))
+	for _, m := range s.Members {
+		users = append(users, UserStatsResponse{
+			UserID:         m.MemberId.String(),
+			Username:       m.Name,
+			TotalReviews:   m.Total,
+			OpenReviews:    m.Open,
+			MergedReviews:  m.Merged,
+			ReassignedAway: m.ReassignedAway,
+		})
+	}
+
+	prs := make([]PullRequestStatsResponse, 0, len(s.PullRequests))
+	for _, pr := range s.PullRequests {
+		prs = append(prs, PullRequestStatsResponse{
+			PullRequestID:   pr.PrId.String(),
+			PullRequestName: pr.Name.String(),
+			AuthorID:        pr.AuthorId.String(),
+			TeamName:        pr.Team.String(),
+			Status:          pr.Status.String(),
+			CreatedAt:       pr.CreatedAt.Format(time.RFC3339),
+			Reviewers:       pr.Reviewers,
+			Reassignments:   pr.Reassignments,
+		})
+	}
+
+	return AssignmentStatsResponse{
+		Users:                  users,
+		PullRequests:           prs,
+		NextUsersCursor:        s.NextMembersCursor,
+		NextPullRequestsCursor: s.NextPullRequestsCursor,
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatsService is an autogenerated mock type for the StatsService type
+type StatsService struct {
+	mock.Mock
+}
+
+type StatsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatsService) EXPECT() *StatsService_Expecter {
+	return &StatsService_Expecter{mock: &_m.Mock}
+}
+
+// AssignmentStats provides a mock function with given fields: ctx, f, page
+func (_m *StatsService) AssignmentStats(ctx context.Context, f domain.StatsFilter, page domain.StatsPageRequest) (domain.AssignmentStats, error) {
+	ret := _m.Called(ctx, f, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignmentStats")
+	}
+
+	var r0 domain.AssignmentStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsFilter, domain.StatsPageRequest) (domain.AssignmentStats, error)); ok {
+		return rf(ctx, f, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsFilter, domain.StatsPageRequest) domain.AssignmentStats); ok {
+		r0 = rf(ctx, f, page)
+	} else {
+		r0 = ret.Get(0).(domain.AssignmentStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.StatsFilter, domain.StatsPageRequest) error); ok {
+		r1 = rf(ctx, f, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatsService_AssignmentStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignmentStats'
+type StatsService_AssignmentStats_Call struct {
+	*mock.Call
+}
+
+// AssignmentStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f domain.StatsFilter
+//   - page domain.StatsPageRequest
+func (_e *StatsService_Expecter) AssignmentStats(ctx interface{}, f interface{}, page interface{}) *StatsService_AssignmentStats_Call {
+	return &StatsService_AssignmentStats_Call{Call: _e.mock.On("AssignmentStats", ctx, f, page)}
+}
+
+func (_c *StatsService_AssignmentStats_Call) Run(run func(ctx context.Context, f domain.StatsFilter, page domain.StatsPageRequest)) *StatsService_AssignmentStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.StatsFilter), args[2].(domain.StatsPageRequest))
+	})
+	return _c
+}
+
+func (_c *StatsService_AssignmentStats_Call) Return(_a0 domain.AssignmentStats, _a1 error) *StatsService_AssignmentStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatsService_AssignmentStats_Call) RunAndReturn(run func(context.Context, domain.StatsFilter, domain.StatsPageRequest) (domain.AssignmentStats, error)) *StatsService_AssignmentStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatsService creates a new instance of StatsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatsService {
+	mock := &StatsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,19 @@
+package reststats
+
+import "go.uber.org/zap"
+
+type RestStats struct {
+	s Service
+	l *zap.SugaredLogger
+}
+
+func New(s Service, l *zap.SugaredLogger) *RestStats {
+	return &RestStats{
+		s: s,
+		l: l,
+	}
+}
+
+type Service interface {
+	StatsService
+}
@@ -0,0 +1,51 @@
+package reststats
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrBadReqParam = echo.NewHTTPError(http.StatusBadRequest, "bad req param")
+)
+
+type StatsService interface {
+	AssignmentStats(ctx context.Context, f domain.StatsFilter, page domain.StatsPageRequest) (domain.AssignmentStats, error)
+}
+
+func (st *RestStats) GetAssignmentStats(c echo.Context) error {
+	var req = &AssignmentStatsRequest{}
+
+	l := st.l.With("req", req)
+	l.Infof("GetAssignmentStats called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	filter, page, err := req.domain()
+	if err != nil {
+		l.Errorf("failed parse filter: %v", err)
+		return ErrBadReqParam
+	}
+
+	stats, err := st.s.AssignmentStats(c.Request().Context(), filter, page)
+	if err != nil {
+		l.Errorf("failed to get assignment stats: %v", err)
+
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqParam
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("members", len(stats.Members), "pull_requests", len(stats.PullRequests))
+	l.Infof("assignment stats fetched successfully")
+
+	return c.JSON(http.StatusOK, assignmentStatsResponse(stats))
+}
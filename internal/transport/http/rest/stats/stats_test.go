@@ -0,0 +1,158 @@
+package reststats
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/stats/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestRestStats_GetAssignmentStats(t *testing.T) {
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	created := time.Date(2025, 11, 15, 12, 0, 0, 0, time.UTC)
+	firstPage := domain.StatsPageRequest{
+		Members:      domain.MemberPageRequest{Limit: domain.DefaultPageLimit},
+		PullRequests: domain.PageRequest{Limit: domain.DefaultPageLimit},
+	}
+	prCursor := domain.PageCursor{CreatedAt: created, Id: "3f1c2b7e-5d4a-4e8b-9a6f-1c2d3e4f5a6b"}
+	userCursor := domain.MemberCursor{Name: "Alice", Id: "9b8a7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"}
+
+	tests := []struct {
+		name         string
+		query        string
+		serviceSetup func(*mocks.StatsService)
+		wantStatus   int
+		wantResp     AssignmentStatsResponse
+		wantErr      error
+	}{
+		{
+			name:  "stats with filters",
+			query: "?team_name=backend&from=2025-11-01T00:00:00Z&to=2025-12-01T00:00:00Z",
+			serviceSetup: func(mockService *mocks.StatsService) {
+				filter := domain.StatsFilter{Team: "backend", From: from, To: to}
+				mockService.On("AssignmentStats", mock.Anything, filter, firstPage).Return(domain.AssignmentStats{
+					Members: []domain.MemberAssignmentStats{
+						{MemberId: "u1", Name: "Alice", Total: 3, Open: 1, Merged: 2, ReassignedAway: 1},
+					},
+					PullRequests: []domain.PrAssignmentStats{
+						{PrId: "pr-1", Name: "Feature", AuthorId: "u2", Status: domain.PrStatusMerged, Team: "backend", CreatedAt: created, Reviewers: 2, Reassignments: 1},
+					},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: AssignmentStatsResponse{
+				Users: []UserStatsResponse{
+					{UserID: "u1", Username: "Alice", TotalReviews: 3, OpenReviews: 1, MergedReviews: 2, ReassignedAway: 1},
+				},
+				PullRequests: []PullRequestStatsResponse{
+					{PullRequestID: "pr-1", PullRequestName: "Feature", AuthorID: "u2", TeamName: "backend", Status: "MERGED", CreatedAt: "2025-11-15T12:00:00Z", Reviewers: 2, Reassignments: 1},
+				},
+			},
+		},
+		{
+			name:  "no filters",
+			query: "",
+			serviceSetup: func(mockService *mocks.StatsService) {
+				mockService.On("AssignmentStats", mock.Anything, domain.StatsFilter{}, firstPage).
+					Return(domain.AssignmentStats{}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: AssignmentStatsResponse{
+				Users:        []UserStatsResponse{},
+				PullRequests: []PullRequestStatsResponse{},
+			},
+		},
+		{
+			name:  "next pages",
+			query: "?limit=1&users_cursor=" + userCursor.String() + "&pull_requests_cursor=" + prCursor.String(),
+			serviceSetup: func(mockService *mocks.StatsService) {
+				page := domain.StatsPageRequest{
+					Members:      domain.MemberPageRequest{After: &userCursor, Limit: 1},
+					PullRequests: domain.PageRequest{After: &prCursor, Limit: 1},
+				}
+				mockService.On("AssignmentStats", mock.Anything, domain.StatsFilter{}, page).
+					Return(domain.AssignmentStats{NextMembersCursor: "users-next", NextPullRequestsCursor: "prs-next"}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: AssignmentStatsResponse{
+				Users:                  []UserStatsResponse{},
+				PullRequests:           []PullRequestStatsResponse{},
+				NextUsersCursor:        "users-next",
+				NextPullRequestsCursor: "prs-next",
+			},
+		},
+		{
+			name:         "malformed cursor",
+			query:        "?pull_requests_cursor=not-a-cursor",
+			serviceSetup: func(mockService *mocks.StatsService) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name:         "limit too large",
+			query:        "?limit=1000",
+			serviceSetup: func(mockService *mocks.StatsService) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name:         "malformed date",
+			query:        "?from=yesterday",
+			serviceSetup: func(mockService *mocks.StatsService) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name:  "inverted range",
+			query: "?from=2025-12-01T00:00:00Z&to=2025-11-01T00:00:00Z",
+			serviceSetup: func(mockService *mocks.StatsService) {
+				mockService.On("AssignmentStats", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.AssignmentStats{}, domain.ErrValidation)
+			},
+			wantErr: ErrBadReqParam,
+		},
+		{
+			name:  "internal error",
+			query: "",
+			serviceSetup: func(mockService *mocks.StatsService) {
+				mockService.On("AssignmentStats", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.AssignmentStats{}, domain.ErrInternal)
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockService := mocks.NewStatsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodGet, "/stats/assignments"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			err := handler.GetAssignmentStats(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp AssignmentStatsResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
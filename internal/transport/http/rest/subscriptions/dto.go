@@ -0,0 +1,129 @@
+package restsubscriptions
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
+
+const defaultDeliveriesLimit = 50
+
+// CreateSubscriptionRequest secret signs the deliveries, one is generated when omitted.
+type CreateSubscriptionRequest struct {
+	URL    string   `json:"url" validate:"required,url"`
+	Secret string   `json:"secret,omitempty" validate:"max=255"`
+	Events []string `json:"events" validate:"required,min=1,dive,required"`
+}
+
+type SubscriptionIdRequest struct {
+	ID string `param:"id" validate:"required,uuid"`
+}
+
+type DeliveriesRequest struct {
+	ID    string `param:"id" validate:"required,uuid"`
+	Limit int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type DeliveryIdRequest struct {
+	ID string `param:"id" validate:"required,uuid"`
+}
+
+// SubscriptionResponse secret is returned on creation only.
+type SubscriptionResponse struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Secret    string   `json:"secret,omitempty"`
+	Events    []string `json:"events"`
+	CreatedAt string   `json:"createdAt"`
+}
+
+type DeliveryResponse struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	Event          string          `json:"event"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseCode   int             `json:"response_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	NextAttemptAt  string          `json:"next_attempt_at,omitempty"`
+	Payload        json.RawMessage `json:"payload"`
+	CreatedAt      string          `json:"createdAt"`
+	UpdatedAt      string          `json:"updatedAt"`
+}
+
+func (req *CreateSubscriptionRequest) domain() domain.Subscription {
+	events := make([]domain.EventType, 0, len(req.Events))
+	for _, e := range req.Events {
+		events = append(events, domain.EventType(e))
+	}
+
+	return domain.Subscription{
+		Url:    req.URL,
+		Secret: req.Secret,
+		Events: events,
+	}
+}
+
+func (req *DeliveriesRequest) limit() int {
+	if req.Limit == 0 {
+		return defaultDeliveriesLimit
+	}
+	return req.Limit
+}
+
+func subscriptionResponse(sub domain.Subscription, withSecret bool) SubscriptionResponse {
+	events := make([]string, 0, len(sub.Events))
+	for _, e := range sub.Events {
+		events = append(events, e.String())
+	}
+
+	resp := SubscriptionResponse{
+		ID:        sub.Id.String(),
+		URL:       sub.Url,
+		Events:    events,
+		CreatedAt: sub.CreatedAt.Format(time.RFC3339),
+	}
+	if withSecret {
+		resp.Secret = sub.Secret
+	}
+	return resp
+}
+
+func subscriptionsResponse(subs []domain.Subscription) []SubscriptionResponse {
+	resp := make([]SubscriptionResponse, 0, len(subs))
+	for _, sub := range subs {
+		resp = append(resp, subscriptionResponse(sub, false))
+	}
+	return resp
+}
+
+// deliveryResponse shows the time of the next attempt for pending deliveries only.
+func deliveryResponse(d domain.Delivery) DeliveryResponse {
+	resp := DeliveryResponse{
+		ID:             d.Id.String(),
+		SubscriptionID: d.SubscriptionId.String(),
+		EventID:        string(d.EventId),
+		Event:          d.EventType.String(),
+		Status:         d.Status.String(),
+		Attempts:       d.Attempts,
+		ResponseCode:   d.ResponseCode,
+		LastError:      d.LastError,
+		Payload:        json.RawMessage(d.Payload),
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      d.UpdatedAt.Format(time.RFC3339),
+	}
+	if d.Status == domain.DeliveryPending {
+		resp.NextAttemptAt = d.NextAttemptAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func deliveriesResponse(ds []domain.Delivery) []DeliveryResponse {
+	resp := make([]DeliveryResponse, 0, len(ds))
+	for _, d := range ds {
+		resp = append(resp, deliveryResponse(d))
+	}
+	return resp
+}
@@ -0,0 +1,316 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SubscriptionsService is an autogenerated mock type for the SubscriptionsService type
+type SubscriptionsService struct {
+	mock.Mock
+}
+
+type SubscriptionsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubscriptionsService) EXPECT() *SubscriptionsService_Expecter {
+	return &SubscriptionsService_Expecter{mock: &_m.Mock}
+}
+
+// Deliveries provides a mock function with given fields: ctx, id, limit
+func (_m *SubscriptionsService) Deliveries(ctx context.Context, id domain.SubscriptionId, limit int) ([]domain.Delivery, error) {
+	ret := _m.Called(ctx, id, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deliveries")
+	}
+
+	var r0 []domain.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionId, int) ([]domain.Delivery, error)); ok {
+		return rf(ctx, id, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionId, int) []domain.Delivery); ok {
+		r0 = rf(ctx, id, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SubscriptionId, int) error); ok {
+		r1 = rf(ctx, id, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsService_Deliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deliveries'
+type SubscriptionsService_Deliveries_Call struct {
+	*mock.Call
+}
+
+// Deliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.SubscriptionId
+//   - limit int
+func (_e *SubscriptionsService_Expecter) Deliveries(ctx interface{}, id interface{}, limit interface{}) *SubscriptionsService_Deliveries_Call {
+	return &SubscriptionsService_Deliveries_Call{Call: _e.mock.On("Deliveries", ctx, id, limit)}
+}
+
+func (_c *SubscriptionsService_Deliveries_Call) Run(run func(ctx context.Context, id domain.SubscriptionId, limit int)) *SubscriptionsService_Deliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SubscriptionId), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsService_Deliveries_Call) Return(_a0 []domain.Delivery, _a1 error) *SubscriptionsService_Deliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsService_Deliveries_Call) RunAndReturn(run func(context.Context, domain.SubscriptionId, int) ([]domain.Delivery, error)) *SubscriptionsService_Deliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redeliver provides a mock function with given fields: _a0, _a1
+func (_m *SubscriptionsService) Redeliver(_a0 context.Context, _a1 domain.DeliveryId) (domain.Delivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeliver")
+	}
+
+	var r0 domain.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeliveryId) (domain.Delivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeliveryId) domain.Delivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Delivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DeliveryId) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsService_Redeliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeliver'
+type SubscriptionsService_Redeliver_Call struct {
+	*mock.Call
+}
+
+// Redeliver is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.DeliveryId
+func (_e *SubscriptionsService_Expecter) Redeliver(_a0 interface{}, _a1 interface{}) *SubscriptionsService_Redeliver_Call {
+	return &SubscriptionsService_Redeliver_Call{Call: _e.mock.On("Redeliver", _a0, _a1)}
+}
+
+func (_c *SubscriptionsService_Redeliver_Call) Run(run func(_a0 context.Context, _a1 domain.DeliveryId)) *SubscriptionsService_Redeliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeliveryId))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsService_Redeliver_Call) Return(_a0 domain.Delivery, _a1 error) *SubscriptionsService_Redeliver_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsService_Redeliver_Call) RunAndReturn(run func(context.Context, domain.DeliveryId) (domain.Delivery, error)) *SubscriptionsService_Redeliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: _a0, _a1
+func (_m *SubscriptionsService) Subscribe(_a0 context.Context, _a1 domain.Subscription) (domain.Subscription, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 domain.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Subscription) (domain.Subscription, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Subscription) domain.Subscription); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(domain.Subscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Subscription) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type SubscriptionsService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.Subscription
+func (_e *SubscriptionsService_Expecter) Subscribe(_a0 interface{}, _a1 interface{}) *SubscriptionsService_Subscribe_Call {
+	return &SubscriptionsService_Subscribe_Call{Call: _e.mock.On("Subscribe", _a0, _a1)}
+}
+
+func (_c *SubscriptionsService_Subscribe_Call) Run(run func(_a0 context.Context, _a1 domain.Subscription)) *SubscriptionsService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Subscription))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsService_Subscribe_Call) Return(_a0 domain.Subscription, _a1 error) *SubscriptionsService_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsService_Subscribe_Call) RunAndReturn(run func(context.Context, domain.Subscription) (domain.Subscription, error)) *SubscriptionsService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscriptions provides a mock function with given fields: _a0
+func (_m *SubscriptionsService) Subscriptions(_a0 context.Context) ([]domain.Subscription, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscriptions")
+	}
+
+	var r0 []domain.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Subscription, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Subscription); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionsService_Subscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscriptions'
+type SubscriptionsService_Subscriptions_Call struct {
+	*mock.Call
+}
+
+// Subscriptions is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *SubscriptionsService_Expecter) Subscriptions(_a0 interface{}) *SubscriptionsService_Subscriptions_Call {
+	return &SubscriptionsService_Subscriptions_Call{Call: _e.mock.On("Subscriptions", _a0)}
+}
+
+func (_c *SubscriptionsService_Subscriptions_Call) Run(run func(_a0 context.Context)) *SubscriptionsService_Subscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsService_Subscriptions_Call) Return(_a0 []domain.Subscription, _a1 error) *SubscriptionsService_Subscriptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionsService_Subscriptions_Call) RunAndReturn(run func(context.Context) ([]domain.Subscription, error)) *SubscriptionsService_Subscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function with given fields: _a0, _a1
+func (_m *SubscriptionsService) Unsubscribe(_a0 context.Context, _a1 domain.SubscriptionId) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unsubscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SubscriptionId) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionsService_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type SubscriptionsService_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 domain.SubscriptionId
+func (_e *SubscriptionsService_Expecter) Unsubscribe(_a0 interface{}, _a1 interface{}) *SubscriptionsService_Unsubscribe_Call {
+	return &SubscriptionsService_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", _a0, _a1)}
+}
+
+func (_c *SubscriptionsService_Unsubscribe_Call) Run(run func(_a0 context.Context, _a1 domain.SubscriptionId)) *SubscriptionsService_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SubscriptionId))
+	})
+	return _c
+}
+
+func (_c *SubscriptionsService_Unsubscribe_Call) Return(_a0 error) *SubscriptionsService_Unsubscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionsService_Unsubscribe_Call) RunAndReturn(run func(context.Context, domain.SubscriptionId) error) *SubscriptionsService_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubscriptionsService creates a new instance of SubscriptionsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscriptionsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubscriptionsService {
+	mock := &SubscriptionsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
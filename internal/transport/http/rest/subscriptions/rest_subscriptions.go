@@ -0,0 +1,19 @@
+package restsubscriptions
+
+import "go.uber.org/zap"
+
+type RestSubscriptions struct {
+	s Service
+	l *zap.SugaredLogger
+}
+
+func New(s Service, l *zap.SugaredLogger) *RestSubscriptions {
+	return &RestSubscriptions{
+		s: s,
+		l: l,
+	}
+}
+
+type Service interface {
+	SubscriptionsService
+}
@@ -0,0 +1,187 @@
+package restsubscriptions
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/pkg/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrBadReqParam = echo.NewHTTPError(http.StatusBadRequest, "bad req param")
+	ErrBadReqBody  = echo.NewHTTPError(http.StatusBadRequest, "bad req body")
+)
+
+type SubscriptionsService interface {
+	Subscribe(context.Context, domain.Subscription) (domain.Subscription, error)
+	Subscriptions(context.Context) ([]domain.Subscription, error)
+	Unsubscribe(context.Context, domain.SubscriptionId) error
+	Deliveries(ctx context.Context, id domain.SubscriptionId, limit int) ([]domain.Delivery, error)
+	Redeliver(context.Context, domain.DeliveryId) (domain.Delivery, error)
+}
+
+func (st *RestSubscriptions) CreateSubscription(c echo.Context) error {
+	var req = &CreateSubscriptionRequest{}
+
+	// the request is not logged as a whole, it carries the secret
+	l := st.l
+	l.Infof("CreateSubscription called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+	l = l.With("url", req.URL, "events", req.Events)
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	sub, err := st.s.Subscribe(ctx(c), req.domain())
+	if err != nil {
+		l.Errorf("failed to create subscription: %v", err)
+
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("subscription_id", sub.Id.String())
+	l.Infof("subscription created successfully")
+
+	return c.JSON(http.StatusCreated, echo.Map{
+		"subscription": subscriptionResponse(sub, true),
+	})
+}
+
+func (st *RestSubscriptions) ListSubscriptions(c echo.Context) error {
+	l := st.l
+	l.Infof("ListSubscriptions called")
+
+	subs, err := st.s.Subscriptions(ctx(c))
+	if err != nil {
+		l.Errorf("failed to list subscriptions: %v", err)
+		return domain.ErrInternal
+	}
+
+	l = l.With("subscriptions", len(subs))
+	l.Infof("subscriptions listed successfully")
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"subscriptions": subscriptionsResponse(subs),
+	})
+}
+
+// DeleteSubscription removes the subscription together with its delivery log.
+func (st *RestSubscriptions) DeleteSubscription(c echo.Context) error {
+	var req = &SubscriptionIdRequest{}
+
+	l := st.l.With("req", req)
+	l.Infof("DeleteSubscription called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := st.s.Unsubscribe(ctx(c), domain.SubscriptionId(req.ID)); err != nil {
+		l.Errorf("failed to delete subscription: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l.Infof("subscription deleted successfully")
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetSubscriptionDeliveries returns the delivery log of the subscription, newest first.
+func (st *RestSubscriptions) GetSubscriptionDeliveries(c echo.Context) error {
+	var req = &DeliveriesRequest{}
+
+	l := st.l.With("req", req)
+	l.Infof("GetSubscriptionDeliveries called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	deliveries, err := st.s.Deliveries(ctx(c), domain.SubscriptionId(req.ID), req.limit())
+	if err != nil {
+		l.Errorf("failed to get deliveries: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("deliveries", len(deliveries))
+	l.Infof("deliveries fetched successfully")
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"deliveries": deliveriesResponse(deliveries),
+	})
+}
+
+// RedeliverWebhook sends a logged delivery once more and returns the new delivery with its outcome.
+func (st *RestSubscriptions) RedeliverWebhook(c echo.Context) error {
+	var req = &DeliveryIdRequest{}
+
+	l := st.l.With("req", req)
+	l.Infof("RedeliverWebhook called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	delivery, err := st.s.Redeliver(ctx(c), domain.DeliveryId(req.ID))
+	if err != nil {
+		l.Errorf("failed to redeliver: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("delivery_id", delivery.Id.String(), "status", delivery.Status.String())
+	l.Infof("redelivery finished")
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"delivery": deliveryResponse(delivery),
+	})
+}
+
+func validate(c echo.Context, structure any) error {
+	return validator.Validate(ctx(c), structure)
+}
+
+func ctx(c echo.Context) context.Context {
+	return c.Request().Context()
+}
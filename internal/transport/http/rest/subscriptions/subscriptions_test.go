@@ -0,0 +1,398 @@
+package restsubscriptions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/subscriptions/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+const (
+	subID      = "11111111-1111-1111-1111-111111111111"
+	deliveryID = "22222222-2222-2222-2222-222222222222"
+)
+
+var created = time.Date(2025, 11, 20, 12, 0, 0, 0, time.UTC)
+
+func TestRestSubscriptions_CreateSubscription(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		serviceSetup func(*mocks.SubscriptionsService)
+		wantStatus   int
+		wantResp     SubscriptionResponse
+		wantErr      error
+	}{
+		{
+			name: "created with the secret",
+			body: `{"url":"https://hooks.example.com/reviews","events":["pr.created","reviewer.assigned"]}`,
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Subscribe", mock.Anything, domain.Subscription{
+					Url:    "https://hooks.example.com/reviews",
+					Events: []domain.EventType{domain.EventPrCreated, domain.EventReviewerAssigned},
+				}).Return(domain.Subscription{
+					Id:        subID,
+					Url:       "https://hooks.example.com/reviews",
+					Secret:    "generated",
+					Events:    []domain.EventType{domain.EventPrCreated, domain.EventReviewerAssigned},
+					CreatedAt: created,
+				}, nil)
+			},
+			wantStatus: http.StatusCreated,
+			wantResp: SubscriptionResponse{
+				ID:        subID,
+				URL:       "https://hooks.example.com/reviews",
+				Secret:    "generated",
+				Events:    []string{"pr.created", "reviewer.assigned"},
+				CreatedAt: "2025-11-20T12:00:00Z",
+			},
+		},
+		{
+			name:         "no events",
+			body:         `{"url":"https://hooks.example.com","events":[]}`,
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:         "not a url",
+			body:         `{"url":"hooks","events":["pr.merged"]}`,
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name: "unknown event",
+			body: `{"url":"https://hooks.example.com","events":["pr.closed"]}`,
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Subscribe", mock.Anything, mock.Anything).Return(domain.Subscription{}, domain.ErrValidation)
+			},
+			wantErr: ErrBadReqBody,
+		},
+		{
+			name: "internal error",
+			body: `{"url":"https://hooks.example.com","events":["pr.merged"]}`,
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Subscribe", mock.Anything, mock.Anything).Return(domain.Subscription{}, domain.ErrInternal)
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockService := mocks.NewSubscriptionsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/subscriptions", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err := handler.CreateSubscription(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp struct {
+				Subscription SubscriptionResponse `json:"subscription"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp.Subscription)
+		})
+	}
+}
+
+func TestRestSubscriptions_ListSubscriptions(t *testing.T) {
+	e := echo.New()
+	mockService := mocks.NewSubscriptionsService(t)
+	mockService.On("Subscriptions", mock.Anything).Return([]domain.Subscription{
+		{Id: subID, Url: "https://hooks.example.com", Secret: "s3cret", Events: []domain.EventType{domain.EventPrMerged}, CreatedAt: created},
+	}, nil)
+
+	handler := New(mockService, zap.NewNop().Sugar())
+
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/subscriptions", nil)
+	rec := httptest.NewRecorder()
+
+	assert.NoError(t, handler.ListSubscriptions(e.NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "s3cret")
+
+	var resp struct {
+		Subscriptions []SubscriptionResponse `json:"subscriptions"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, []SubscriptionResponse{
+		{ID: subID, URL: "https://hooks.example.com", Events: []string{"pr.merged"}, CreatedAt: "2025-11-20T12:00:00Z"},
+	}, resp.Subscriptions)
+}
+
+func TestRestSubscriptions_DeleteSubscription(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		serviceSetup func(*mocks.SubscriptionsService)
+		wantErr      error
+	}{
+		{
+			name: "deleted",
+			id:   subID,
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Unsubscribe", mock.Anything, domain.SubscriptionId(subID)).Return(nil)
+			},
+		},
+		{
+			name: "unknown subscription",
+			id:   subID,
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Unsubscribe", mock.Anything, domain.SubscriptionId(subID)).Return(domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:         "not a uuid",
+			id:           "sub-1",
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {},
+			wantErr:      ErrBadReqParam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockService := mocks.NewSubscriptionsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodDelete, "/webhooks/subscriptions/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.id)
+
+			err := handler.DeleteSubscription(c)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		})
+	}
+}
+
+func TestRestSubscriptions_GetSubscriptionDeliveries(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        string
+		serviceSetup func(*mocks.SubscriptionsService)
+		wantResp     []DeliveryResponse
+		wantErr      error
+	}{
+		{
+			name: "default limit",
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Deliveries", mock.Anything, domain.SubscriptionId(subID), defaultDeliveriesLimit).Return([]domain.Delivery{
+					{
+						Id:             deliveryID,
+						SubscriptionId: subID,
+						EventId:        "event-1",
+						EventType:      domain.EventPrMerged,
+						Payload:        []byte(`{"id":"event-1"}`),
+						Status:         domain.DeliveryFailed,
+						Attempts:       5,
+						ResponseCode:   http.StatusBadGateway,
+						LastError:      "502 Bad Gateway",
+						NextAttemptAt:  created,
+						CreatedAt:      created,
+						UpdatedAt:      created,
+					},
+					{
+						Id:             "55555555-5555-5555-5555-555555555555",
+						SubscriptionId: subID,
+						EventId:        "event-2",
+						EventType:      domain.EventPrMerged,
+						Payload:        []byte(`{"id":"event-2"}`),
+						Status:         domain.DeliveryPending,
+						Attempts:       1,
+						LastError:      "connection refused",
+						NextAttemptAt:  created.Add(time.Minute),
+						CreatedAt:      created,
+						UpdatedAt:      created,
+					},
+				}, nil)
+			},
+			wantResp: []DeliveryResponse{
+				{
+					ID:             deliveryID,
+					SubscriptionID: subID,
+					EventID:        "event-1",
+					Event:          "pr.merged",
+					Status:         "failed",
+					Attempts:       5,
+					ResponseCode:   http.StatusBadGateway,
+					LastError:      "502 Bad Gateway",
+					Payload:        json.RawMessage(`{"id":"event-1"}`),
+					CreatedAt:      "2025-11-20T12:00:00Z",
+					UpdatedAt:      "2025-11-20T12:00:00Z",
+				},
+				{
+					ID:             "55555555-5555-5555-5555-555555555555",
+					SubscriptionID: subID,
+					EventID:        "event-2",
+					Event:          "pr.merged",
+					Status:         "pending",
+					Attempts:       1,
+					LastError:      "connection refused",
+					NextAttemptAt:  "2025-11-20T12:01:00Z",
+					Payload:        json.RawMessage(`{"id":"event-2"}`),
+					CreatedAt:      "2025-11-20T12:00:00Z",
+					UpdatedAt:      "2025-11-20T12:00:00Z",
+				},
+			},
+		},
+		{
+			name:  "empty log",
+			query: "?limit=10",
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Deliveries", mock.Anything, domain.SubscriptionId(subID), 10).Return([]domain.Delivery{}, nil)
+			},
+			wantResp: []DeliveryResponse{},
+		},
+		{
+			name:         "limit too large",
+			query:        "?limit=1000",
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name: "unknown subscription",
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Deliveries", mock.Anything, mock.Anything, mock.Anything).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockService := mocks.NewSubscriptionsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodGet, "/webhooks/subscriptions/"+subID+"/deliveries"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(subID)
+
+			err := handler.GetSubscriptionDeliveries(c)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp struct {
+				Deliveries []DeliveryResponse `json:"deliveries"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp.Deliveries)
+		})
+	}
+}
+
+func TestRestSubscriptions_RedeliverWebhook(t *testing.T) {
+	tests := []struct {
+		name         string
+		serviceSetup func(*mocks.SubscriptionsService)
+		wantStatus   string
+		wantErr      error
+	}{
+		{
+			name: "redelivered",
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Redeliver", mock.Anything, domain.DeliveryId(deliveryID)).Return(domain.Delivery{
+					Id:             "33333333-3333-3333-3333-333333333333",
+					SubscriptionId: subID,
+					EventType:      domain.EventPrMerged,
+					Payload:        []byte(`{}`),
+					Status:         domain.DeliverySucceeded,
+					Attempts:       1,
+					ResponseCode:   http.StatusOK,
+				}, nil)
+			},
+			wantStatus: "succeeded",
+		},
+		{
+			name: "unknown delivery",
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Redeliver", mock.Anything, domain.DeliveryId(deliveryID)).Return(domain.Delivery{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name: "internal error",
+			serviceSetup: func(mockService *mocks.SubscriptionsService) {
+				mockService.On("Redeliver", mock.Anything, domain.DeliveryId(deliveryID)).Return(domain.Delivery{}, domain.ErrInternal)
+			},
+			wantErr: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockService := mocks.NewSubscriptionsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/deliveries/"+deliveryID+"/redeliver", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(deliveryID)
+
+			err := handler.RedeliverWebhook(c)
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp struct {
+				Delivery DeliveryResponse `json:"delivery"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantStatus, resp.Delivery.Status)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package restteams
 
-import "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+import (
+	"time"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
 
 type TeamMember struct {
 	UserID   string `json:"user_id" validate:"required,uuid"`
@@ -18,6 +22,154 @@ type TeamResponse struct {
 	Members  []TeamMember `json:"members" validate:"required,dive,required"`
 }
 
+type SetTeamReviewCapacityRequest struct {
+	TeamName              string `json:"team_name" validate:"required"`
+	DefaultReviewCapacity *int   `json:"default_review_capacity" validate:"omitempty,gte=0"`
+}
+
+type SetTeamRequiredReviewersRequest struct {
+	TeamName          string `json:"team_name" validate:"required"`
+	RequiredReviewers *int   `json:"required_reviewers" validate:"required,gte=0"`
+}
+
+type SetTeamMergePolicyRequest struct {
+	TeamName                string `json:"team_name" validate:"required"`
+	MinApprovals            *int   `json:"min_approvals" validate:"omitempty,gte=0"`
+	BlockOnChangesRequested *bool  `json:"block_on_changes_requested"`
+	RequireActiveReviewers  *bool  `json:"require_active_reviewers"`
+}
+
+type DeactivateTeamRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+}
+
+type DeactivationReportResponse struct {
+	TeamName         string                 `json:"team_name"`
+	DeactivatedUsers []string               `json:"deactivated_user_ids"`
+	Reassigned       []ReassignmentResponse `json:"reassigned"`
+	Unfilled         []ReassignmentResponse `json:"unfilled"`
+}
+
+type ReassignmentResponse struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+}
+
+type AddTeamMembersRequest struct {
+	TeamName string       `json:"team_name" validate:"required"`
+	Members  []TeamMember `json:"members" validate:"required,min=1,dive,required"`
+}
+
+// RemoveTeamMemberRequest open_reviews defaults to keep.
+type RemoveTeamMemberRequest struct {
+	TeamName    string `json:"team_name" validate:"required"`
+	UserID      string `json:"user_id" validate:"required,member_ref"`
+	OpenReviews string `json:"open_reviews" validate:"omitempty,oneof=keep reassign reject"`
+	Actor       string `json:"actor,omitempty" validate:"max=255"`
+	Reason      string `json:"reason,omitempty" validate:"max=255"`
+}
+
+type RenameTeamRequest struct {
+	TeamName    string `json:"team_name" validate:"required"`
+	NewTeamName string `json:"new_team_name" validate:"required"`
+}
+
+// DeleteTeamRequest open_reviews defaults to reject.
+type DeleteTeamRequest struct {
+	TeamName    string `json:"team_name" validate:"required"`
+	OpenReviews string `json:"open_reviews" validate:"omitempty,oneof=keep reassign reject"`
+	Actor       string `json:"actor,omitempty" validate:"max=255"`
+	Reason      string `json:"reason,omitempty" validate:"max=255"`
+}
+
+type TeamRemovalReportResponse struct {
+	TeamName     string                 `json:"team_name"`
+	RemovedUsers []string               `json:"removed_user_ids"`
+	Reassigned   []ReassignmentResponse `json:"reassigned"`
+	Unfilled     []ReassignmentResponse `json:"unfilled"`
+}
+
+// ReconcileRequest is the full desired state of the managed teams, the plan is only applied when apply is set.
+type ReconcileRequest struct {
+	Teams []ReconcileTeam `json:"teams" validate:"dive"`
+	Apply bool            `json:"apply"`
+}
+
+type ReconcileTeam struct {
+	TeamName string            `json:"team_name" validate:"required"`
+	Members  []ReconcileMember `json:"members" validate:"dive"`
+}
+
+// ReconcileMember is_active defaults to true.
+type ReconcileMember struct {
+	UserID   string `json:"user_id" validate:"required,uuid"`
+	Username string `json:"username" validate:"required"`
+	IsActive *bool  `json:"is_active"`
+}
+
+type ReconcilePlanResponse struct {
+	Applied              bool                       `json:"applied"`
+	CreateTeams          []string                   `json:"create_teams"`
+	CreateUsers          []string                   `json:"create_user_ids"`
+	AddMembers           []MembershipChangeResponse `json:"add_members"`
+	RemoveMembers        []MembershipChangeResponse `json:"remove_members"`
+	ActivateUsers        []string                   `json:"activate_user_ids"`
+	DeactivateUsers      []string                   `json:"deactivate_user_ids"`
+	RenameUsers          []string                   `json:"rename_user_ids"`
+	AffectedPullRequests []AffectedReviewResponse   `json:"affected_pull_requests"`
+	Reassigned           []ReassignmentResponse     `json:"reassigned"`
+	Unfilled             []ReassignmentResponse     `json:"unfilled"`
+}
+
+type MembershipChangeResponse struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+}
+
+type AffectedReviewResponse struct {
+	PullRequestID string `json:"pull_request_id"`
+	TeamName      string `json:"team_name"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+// TeamFairnessRequest window_days defaults to BUSSINES_LOGIC_FAIRNESS_WINDOW_DAYS.
+type TeamFairnessRequest struct {
+	TeamName   string `param:"team_name" validate:"required"`
+	WindowDays *int   `query:"window_days" validate:"omitempty,gte=1"`
+}
+
+type FairnessReportResponse struct {
+	TeamName string               `json:"team_name"`
+	Since    string               `json:"since"`
+	MinLoad  int                  `json:"min_load"`
+	MaxLoad  int                  `json:"max_load"`
+	MeanLoad float64              `json:"mean_load"`
+	StdDev   float64              `json:"stddev"`
+	Gini     float64              `json:"gini"`
+	Members  []MemberLoadResponse `json:"members"`
+}
+
+type MemberLoadResponse struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Load     int    `json:"load"`
+	Flag     string `json:"flag,omitempty"`
+}
+
+type TeamSettingsResponse struct {
+	TeamName              string              `json:"team_name"`
+	RequiredReviewers     int                 `json:"required_reviewers"`
+	DefaultReviewCapacity *int                `json:"default_review_capacity"`
+	MergePolicy           MergePolicyResponse `json:"merge_policy"`
+}
+
+type MergePolicyResponse struct {
+	MinApprovals            *int  `json:"min_approvals"`
+	BlockOnChangesRequested *bool `json:"block_on_changes_requested"`
+	RequireActiveReviewers  *bool `json:"require_active_reviewers"`
+}
+
 func (tr *TeamRequest) domain() domain.Team {
 	mems := make([]domain.Member, 0, len(tr.Members))
 	for _, m := range tr.Members {
@@ -33,6 +185,47 @@ func (tr *TeamMember) domain() domain.Member {
 		Build()
 }
 
+func (req *ReconcileRequest) domain() domain.OrgSpec {
+	teams := make([]domain.Team, 0, len(req.Teams))
+	for _, t := range req.Teams {
+		mems := make([]domain.Member, 0, len(t.Members))
+		for _, m := range t.Members {
+			active := m.IsActive == nil || *m.IsActive
+			mems = append(mems, domain.MemberBuilder(domain.MemberId(m.UserID)).
+				Name(m.Username).
+				Status(domain.MemberStatusIsActiveByBool(active)).
+				Build())
+		}
+		teams = append(teams, domain.NewTeam(domain.TeamName(t.TeamName), mems...))
+	}
+	return domain.OrgSpec{Teams: teams}
+}
+
+func (req *SetTeamReviewCapacityRequest) capacity() domain.ReviewCapacity {
+	if req.DefaultReviewCapacity == nil {
+		return domain.UnlimitedReviewCapacity()
+	}
+	return domain.NewReviewCapacity(*req.DefaultReviewCapacity)
+}
+
+func teamSettingsResponse(t domain.Team) TeamSettingsResponse {
+	var capacity *int
+	if limit, ok := t.Settings.DefaultReviewCapacity.Limit(); ok {
+		capacity = &limit
+	}
+
+	return TeamSettingsResponse{
+		TeamName:              t.Name.String(),
+		RequiredReviewers:     t.Settings.RequiredReviewers,
+		DefaultReviewCapacity: capacity,
+		MergePolicy: MergePolicyResponse{
+			MinApprovals:            t.Settings.MergePolicy.MinApprovals,
+			BlockOnChangesRequested: t.Settings.MergePolicy.BlockOnChangesRequested,
+			RequireActiveReviewers:  t.Settings.MergePolicy.RequireActiveReviewers,
+		},
+	}
+}
+
 func teamResponse(t domain.Team) TeamResponse {
 	return TeamResponse{
 		TeamName: t.Name.String(),
@@ -55,3 +248,161 @@ func teamMembers(ms []domain.Member) []TeamMember {
 	}
 	return res
 }
+
+func (req *SetTeamMergePolicyRequest) domain() domain.MergePolicyOverride {
+	return domain.MergePolicyOverride{
+		MinApprovals:            req.MinApprovals,
+		BlockOnChangesRequested: req.BlockOnChangesRequested,
+		RequireActiveReviewers:  req.RequireActiveReviewers,
+	}
+}
+
+func deactivationReportResponse(r domain.DeactivationReport) DeactivationReportResponse {
+	users := make([]string, 0, len(r.Deactivated))
+	for _, id := range r.Deactivated {
+		users = append(users, id.String())
+	}
+
+	return DeactivationReportResponse{
+		TeamName:         r.Team.String(),
+		DeactivatedUsers: users,
+		Reassigned:       reassignmentsResponse(r.Reassigned),
+		Unfilled:         reassignmentsResponse(r.Unfilled),
+	}
+}
+
+func (req *AddTeamMembersRequest) members() domain.Members {
+	mems := make([]domain.Member, 0, len(req.Members))
+	for _, m := range req.Members {
+		mems = append(mems, m.domain())
+	}
+	return domain.Members(mems)
+}
+
+func (req *RemoveTeamMemberRequest) policy() domain.OpenReviewsPolicy {
+	return openReviewsPolicy(req.OpenReviews, domain.OpenReviewsKeep)
+}
+
+func (req *RemoveTeamMemberRequest) audit() domain.AssignmentAudit {
+	return domain.AssignmentAudit{Actor: req.Actor, Reason: req.Reason}
+}
+
+func (req *DeleteTeamRequest) policy() domain.OpenReviewsPolicy {
+	return openReviewsPolicy(req.OpenReviews, domain.OpenReviewsReject)
+}
+
+func (req *DeleteTeamRequest) audit() domain.AssignmentAudit {
+	return domain.AssignmentAudit{Actor: req.Actor, Reason: req.Reason}
+}
+
+func openReviewsPolicy(s string, def domain.OpenReviewsPolicy) domain.OpenReviewsPolicy {
+	if s == "" {
+		return def
+	}
+	return domain.OpenReviewsPolicy(s)
+}
+
+func teamRemovalReportResponse(r domain.TeamRemovalReport) TeamRemovalReportResponse {
+	users := make([]string, 0, len(r.Removed))
+	for _, id := range r.Removed {
+		users = append(users, id.String())
+	}
+
+	return TeamRemovalReportResponse{
+		TeamName:     r.Team.String(),
+		RemovedUsers: users,
+		Reassigned:   reassignmentsResponse(r.Reassigned),
+		Unfilled:     reassignmentsResponse(r.Unfilled),
+	}
+}
+
+func reassignmentsResponse(rs []domain.Reassignment) []ReassignmentResponse {
+	res := make([]ReassignmentResponse, 0, len(rs))
+	for _, r := range rs {
+		res = append(res, ReassignmentResponse{
+			PullRequestID: r.PrId.String(),
+			OldReviewerID: r.OldMemberId.String(),
+			NewReviewerID: r.NewMemberId.String(),
+		})
+	}
+	return res
+}
+
+func (req *TeamFairnessRequest) window() time.Duration {
+	if req.WindowDays == nil {
+		return 0
+	}
+	return time.Duration(*req.WindowDays) * 24 * time.Hour
+}
+
+func fairnessReportResponse(r domain.FairnessReport) FairnessReportResponse {
+	members := make([]MemberLoadResponse, 0, len(r.Members))
+	for _, m := range r.Members {
+		members = append(members, MemberLoadResponse{
+			UserID:   m.MemberId.String(),
+			Username: m.Name,
+			Load:     m.Load,
+			Flag:     string(m.Flag),
+		})
+	}
+
+	return FairnessReportResponse{
+		TeamName: r.Team.String(),
+		Since:    r.Since.Format(time.RFC3339),
+		MinLoad:  r.Min,
+		MaxLoad:  r.Max,
+		MeanLoad: r.Mean,
+		StdDev:   r.StdDev,
+		Gini:     r.Gini,
+		Members:  members,
+	}
+}
+
+func reconcilePlanResponse(p domain.ReconcilePlan) ReconcilePlanResponse {
+	affected := make([]AffectedReviewResponse, 0, len(p.Affected))
+	for _, a := range p.Affected {
+		affected = append(affected, AffectedReviewResponse{
+			PullRequestID: a.PrId.String(),
+			TeamName:      a.Team.String(),
+			ReviewerID:    a.MemberId.String(),
+		})
+	}
+
+	return ReconcilePlanResponse{
+		Applied:              p.Applied,
+		CreateTeams:          teamNames(p.CreateTeams),
+		CreateUsers:          memberIds(p.CreateMembers),
+		AddMembers:           membershipChanges(p.AddMembers),
+		RemoveMembers:        membershipChanges(p.RemoveMembers),
+		ActivateUsers:        memberIds(p.Activate),
+		DeactivateUsers:      memberIds(p.Deactivate),
+		RenameUsers:          memberIds(p.Rename),
+		AffectedPullRequests: affected,
+		Reassigned:           reassignmentsResponse(p.Reassigned),
+		Unfilled:             reassignmentsResponse(p.Unfilled),
+	}
+}
+
+func membershipChanges(mc domain.MembershipChanges) []MembershipChangeResponse {
+	res := make([]MembershipChangeResponse, 0, len(mc))
+	for _, c := range mc {
+		res = append(res, MembershipChangeResponse{TeamName: c.Team.String(), UserID: c.MemberId.String()})
+	}
+	return res
+}
+
+func teamNames(names []domain.TeamName) []string {
+	res := make([]string, 0, len(names))
+	for _, n := range names {
+		res = append(res, n.String())
+	}
+	return res
+}
+
+func memberIds(ids []domain.MemberId) []string {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, id.String())
+	}
+	return res
+}
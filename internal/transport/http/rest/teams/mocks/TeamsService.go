@@ -3,8 +3,12 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // TeamsService is an autogenerated mock type for the TeamsService type
@@ -20,6 +24,179 @@ func (_m *TeamsService) EXPECT() *TeamsService_Expecter {
 	return &TeamsService_Expecter{mock: &_m.Mock}
 }
 
+// AddTeamMembers provides a mock function with given fields: tName, members
+func (_m *TeamsService) AddTeamMembers(tName domain.TeamName, members domain.Members) (domain.Team, error) {
+	ret := _m.Called(tName, members)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTeamMembers")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.Members) (domain.Team, error)); ok {
+		return rf(tName, members)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.Members) domain.Team); ok {
+		r0 = rf(tName, members)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, domain.Members) error); ok {
+		r1 = rf(tName, members)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_AddTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMembers'
+type TeamsService_AddTeamMembers_Call struct {
+	*mock.Call
+}
+
+// AddTeamMembers is a helper method to define mock.On call
+//   - tName domain.TeamName
+//   - members domain.Members
+func (_e *TeamsService_Expecter) AddTeamMembers(tName interface{}, members interface{}) *TeamsService_AddTeamMembers_Call {
+	return &TeamsService_AddTeamMembers_Call{Call: _e.mock.On("AddTeamMembers", tName, members)}
+}
+
+func (_c *TeamsService_AddTeamMembers_Call) Run(run func(tName domain.TeamName, members domain.Members)) *TeamsService_AddTeamMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(domain.Members))
+	})
+	return _c
+}
+
+func (_c *TeamsService_AddTeamMembers_Call) Return(_a0 domain.Team, _a1 error) *TeamsService_AddTeamMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_AddTeamMembers_Call) RunAndReturn(run func(domain.TeamName, domain.Members) (domain.Team, error)) *TeamsService_AddTeamMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateTeam provides a mock function with given fields: ctx, tName
+func (_m *TeamsService) DeactivateTeam(ctx context.Context, tName domain.TeamName) (domain.DeactivationReport, error) {
+	ret := _m.Called(ctx, tName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateTeam")
+	}
+
+	var r0 domain.DeactivationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName) (domain.DeactivationReport, error)); ok {
+		return rf(ctx, tName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName) domain.DeactivationReport); ok {
+		r0 = rf(ctx, tName)
+	} else {
+		r0 = ret.Get(0).(domain.DeactivationReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName) error); ok {
+		r1 = rf(ctx, tName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_DeactivateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateTeam'
+type TeamsService_DeactivateTeam_Call struct {
+	*mock.Call
+}
+
+// DeactivateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tName domain.TeamName
+func (_e *TeamsService_Expecter) DeactivateTeam(ctx interface{}, tName interface{}) *TeamsService_DeactivateTeam_Call {
+	return &TeamsService_DeactivateTeam_Call{Call: _e.mock.On("DeactivateTeam", ctx, tName)}
+}
+
+func (_c *TeamsService_DeactivateTeam_Call) Run(run func(ctx context.Context, tName domain.TeamName)) *TeamsService_DeactivateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *TeamsService_DeactivateTeam_Call) Return(_a0 domain.DeactivationReport, _a1 error) *TeamsService_DeactivateTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_DeactivateTeam_Call) RunAndReturn(run func(context.Context, domain.TeamName) (domain.DeactivationReport, error)) *TeamsService_DeactivateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeam provides a mock function with given fields: ctx, tName, policy, audit
+func (_m *TeamsService) DeleteTeam(ctx context.Context, tName domain.TeamName, policy domain.OpenReviewsPolicy, audit domain.AssignmentAudit) (domain.TeamRemovalReport, error) {
+	ret := _m.Called(ctx, tName, policy, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 domain.TeamRemovalReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, domain.OpenReviewsPolicy, domain.AssignmentAudit) (domain.TeamRemovalReport, error)); ok {
+		return rf(ctx, tName, policy, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, domain.OpenReviewsPolicy, domain.AssignmentAudit) domain.TeamRemovalReport); ok {
+		r0 = rf(ctx, tName, policy, audit)
+	} else {
+		r0 = ret.Get(0).(domain.TeamRemovalReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName, domain.OpenReviewsPolicy, domain.AssignmentAudit) error); ok {
+		r1 = rf(ctx, tName, policy, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type TeamsService_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tName domain.TeamName
+//   - policy domain.OpenReviewsPolicy
+//   - audit domain.AssignmentAudit
+func (_e *TeamsService_Expecter) DeleteTeam(ctx interface{}, tName interface{}, policy interface{}, audit interface{}) *TeamsService_DeleteTeam_Call {
+	return &TeamsService_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, tName, policy, audit)}
+}
+
+func (_c *TeamsService_DeleteTeam_Call) Run(run func(ctx context.Context, tName domain.TeamName, policy domain.OpenReviewsPolicy, audit domain.AssignmentAudit)) *TeamsService_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].(domain.OpenReviewsPolicy), args[3].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *TeamsService_DeleteTeam_Call) Return(_a0 domain.TeamRemovalReport, _a1 error) *TeamsService_DeleteTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_DeleteTeam_Call) RunAndReturn(run func(context.Context, domain.TeamName, domain.OpenReviewsPolicy, domain.AssignmentAudit) (domain.TeamRemovalReport, error)) *TeamsService_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTeam provides a mock function with given fields: team
 func (_m *TeamsService) NewTeam(team domain.Team) (domain.Team, error) {
 	ret := _m.Called(team)
@@ -76,6 +253,467 @@ func (_c *TeamsService_NewTeam_Call) RunAndReturn(run func(domain.Team) (domain.
 	return _c
 }
 
+// ReconcileTeams provides a mock function with given fields: ctx, spec, apply
+func (_m *TeamsService) ReconcileTeams(ctx context.Context, spec domain.OrgSpec, apply bool) (domain.ReconcilePlan, error) {
+	ret := _m.Called(ctx, spec, apply)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileTeams")
+	}
+
+	var r0 domain.ReconcilePlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OrgSpec, bool) (domain.ReconcilePlan, error)); ok {
+		return rf(ctx, spec, apply)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.OrgSpec, bool) domain.ReconcilePlan); ok {
+		r0 = rf(ctx, spec, apply)
+	} else {
+		r0 = ret.Get(0).(domain.ReconcilePlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.OrgSpec, bool) error); ok {
+		r1 = rf(ctx, spec, apply)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_ReconcileTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileTeams'
+type TeamsService_ReconcileTeams_Call struct {
+	*mock.Call
+}
+
+// ReconcileTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec domain.OrgSpec
+//   - apply bool
+func (_e *TeamsService_Expecter) ReconcileTeams(ctx interface{}, spec interface{}, apply interface{}) *TeamsService_ReconcileTeams_Call {
+	return &TeamsService_ReconcileTeams_Call{Call: _e.mock.On("ReconcileTeams", ctx, spec, apply)}
+}
+
+func (_c *TeamsService_ReconcileTeams_Call) Run(run func(ctx context.Context, spec domain.OrgSpec, apply bool)) *TeamsService_ReconcileTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.OrgSpec), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *TeamsService_ReconcileTeams_Call) Return(_a0 domain.ReconcilePlan, _a1 error) *TeamsService_ReconcileTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_ReconcileTeams_Call) RunAndReturn(run func(context.Context, domain.OrgSpec, bool) (domain.ReconcilePlan, error)) *TeamsService_ReconcileTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMember provides a mock function with given fields: ctx, tName, id, policy, audit
+func (_m *TeamsService) RemoveTeamMember(ctx context.Context, tName domain.TeamName, id domain.MemberId, policy domain.OpenReviewsPolicy, audit domain.AssignmentAudit) (domain.TeamRemovalReport, error) {
+	ret := _m.Called(ctx, tName, id, policy, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMember")
+	}
+
+	var r0 domain.TeamRemovalReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, domain.MemberId, domain.OpenReviewsPolicy, domain.AssignmentAudit) (domain.TeamRemovalReport, error)); ok {
+		return rf(ctx, tName, id, policy, audit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, domain.MemberId, domain.OpenReviewsPolicy, domain.AssignmentAudit) domain.TeamRemovalReport); ok {
+		r0 = rf(ctx, tName, id, policy, audit)
+	} else {
+		r0 = ret.Get(0).(domain.TeamRemovalReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName, domain.MemberId, domain.OpenReviewsPolicy, domain.AssignmentAudit) error); ok {
+		r1 = rf(ctx, tName, id, policy, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_RemoveTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMember'
+type TeamsService_RemoveTeamMember_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tName domain.TeamName
+//   - id domain.MemberId
+//   - policy domain.OpenReviewsPolicy
+//   - audit domain.AssignmentAudit
+func (_e *TeamsService_Expecter) RemoveTeamMember(ctx interface{}, tName interface{}, id interface{}, policy interface{}, audit interface{}) *TeamsService_RemoveTeamMember_Call {
+	return &TeamsService_RemoveTeamMember_Call{Call: _e.mock.On("RemoveTeamMember", ctx, tName, id, policy, audit)}
+}
+
+func (_c *TeamsService_RemoveTeamMember_Call) Run(run func(ctx context.Context, tName domain.TeamName, id domain.MemberId, policy domain.OpenReviewsPolicy, audit domain.AssignmentAudit)) *TeamsService_RemoveTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].(domain.MemberId), args[3].(domain.OpenReviewsPolicy), args[4].(domain.AssignmentAudit))
+	})
+	return _c
+}
+
+func (_c *TeamsService_RemoveTeamMember_Call) Return(_a0 domain.TeamRemovalReport, _a1 error) *TeamsService_RemoveTeamMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_RemoveTeamMember_Call) RunAndReturn(run func(context.Context, domain.TeamName, domain.MemberId, domain.OpenReviewsPolicy, domain.AssignmentAudit) (domain.TeamRemovalReport, error)) *TeamsService_RemoveTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTeam provides a mock function with given fields: tName, newName
+func (_m *TeamsService) RenameTeam(tName domain.TeamName, newName domain.TeamName) (domain.Team, error) {
+	ret := _m.Called(tName, newName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTeam")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.TeamName) (domain.Team, error)); ok {
+		return rf(tName, newName)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.TeamName) domain.Team); ok {
+		r0 = rf(tName, newName)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, domain.TeamName) error); ok {
+		r1 = rf(tName, newName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_RenameTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTeam'
+type TeamsService_RenameTeam_Call struct {
+	*mock.Call
+}
+
+// RenameTeam is a helper method to define mock.On call
+//   - tName domain.TeamName
+//   - newName domain.TeamName
+func (_e *TeamsService_Expecter) RenameTeam(tName interface{}, newName interface{}) *TeamsService_RenameTeam_Call {
+	return &TeamsService_RenameTeam_Call{Call: _e.mock.On("RenameTeam", tName, newName)}
+}
+
+func (_c *TeamsService_RenameTeam_Call) Run(run func(tName domain.TeamName, newName domain.TeamName)) *TeamsService_RenameTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(domain.TeamName))
+	})
+	return _c
+}
+
+func (_c *TeamsService_RenameTeam_Call) Return(_a0 domain.Team, _a1 error) *TeamsService_RenameTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_RenameTeam_Call) RunAndReturn(run func(domain.TeamName, domain.TeamName) (domain.Team, error)) *TeamsService_RenameTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveMember provides a mock function with given fields: ctx, ref
+func (_m *TeamsService) ResolveMember(ctx context.Context, ref domain.MemberRef) (domain.MemberId, error) {
+	ret := _m.Called(ctx, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveMember")
+	}
+
+	var r0 domain.MemberId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberRef) (domain.MemberId, error)); ok {
+		return rf(ctx, ref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MemberRef) domain.MemberId); ok {
+		r0 = rf(ctx, ref)
+	} else {
+		r0 = ret.Get(0).(domain.MemberId)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MemberRef) error); ok {
+		r1 = rf(ctx, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_ResolveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveMember'
+type TeamsService_ResolveMember_Call struct {
+	*mock.Call
+}
+
+// ResolveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ref domain.MemberRef
+func (_e *TeamsService_Expecter) ResolveMember(ctx interface{}, ref interface{}) *TeamsService_ResolveMember_Call {
+	return &TeamsService_ResolveMember_Call{Call: _e.mock.On("ResolveMember", ctx, ref)}
+}
+
+func (_c *TeamsService_ResolveMember_Call) Run(run func(ctx context.Context, ref domain.MemberRef)) *TeamsService_ResolveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MemberRef))
+	})
+	return _c
+}
+
+func (_c *TeamsService_ResolveMember_Call) Return(_a0 domain.MemberId, _a1 error) *TeamsService_ResolveMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_ResolveMember_Call) RunAndReturn(run func(context.Context, domain.MemberRef) (domain.MemberId, error)) *TeamsService_ResolveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTeamMergePolicy provides a mock function with given fields: tName, p
+func (_m *TeamsService) SetTeamMergePolicy(tName domain.TeamName, p domain.MergePolicyOverride) (domain.Team, error) {
+	ret := _m.Called(tName, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamMergePolicy")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.MergePolicyOverride) (domain.Team, error)); ok {
+		return rf(tName, p)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.MergePolicyOverride) domain.Team); ok {
+		r0 = rf(tName, p)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, domain.MergePolicyOverride) error); ok {
+		r1 = rf(tName, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_SetTeamMergePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamMergePolicy'
+type TeamsService_SetTeamMergePolicy_Call struct {
+	*mock.Call
+}
+
+// SetTeamMergePolicy is a helper method to define mock.On call
+//   - tName domain.TeamName
+//   - p domain.MergePolicyOverride
+func (_e *TeamsService_Expecter) SetTeamMergePolicy(tName interface{}, p interface{}) *TeamsService_SetTeamMergePolicy_Call {
+	return &TeamsService_SetTeamMergePolicy_Call{Call: _e.mock.On("SetTeamMergePolicy", tName, p)}
+}
+
+func (_c *TeamsService_SetTeamMergePolicy_Call) Run(run func(tName domain.TeamName, p domain.MergePolicyOverride)) *TeamsService_SetTeamMergePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(domain.MergePolicyOverride))
+	})
+	return _c
+}
+
+func (_c *TeamsService_SetTeamMergePolicy_Call) Return(_a0 domain.Team, _a1 error) *TeamsService_SetTeamMergePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_SetTeamMergePolicy_Call) RunAndReturn(run func(domain.TeamName, domain.MergePolicyOverride) (domain.Team, error)) *TeamsService_SetTeamMergePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTeamRequiredReviewers provides a mock function with given fields: tName, required
+func (_m *TeamsService) SetTeamRequiredReviewers(tName domain.TeamName, required int) (domain.Team, error) {
+	ret := _m.Called(tName, required)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamRequiredReviewers")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, int) (domain.Team, error)); ok {
+		return rf(tName, required)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, int) domain.Team); ok {
+		r0 = rf(tName, required)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, int) error); ok {
+		r1 = rf(tName, required)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_SetTeamRequiredReviewers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamRequiredReviewers'
+type TeamsService_SetTeamRequiredReviewers_Call struct {
+	*mock.Call
+}
+
+// SetTeamRequiredReviewers is a helper method to define mock.On call
+//   - tName domain.TeamName
+//   - required int
+func (_e *TeamsService_Expecter) SetTeamRequiredReviewers(tName interface{}, required interface{}) *TeamsService_SetTeamRequiredReviewers_Call {
+	return &TeamsService_SetTeamRequiredReviewers_Call{Call: _e.mock.On("SetTeamRequiredReviewers", tName, required)}
+}
+
+func (_c *TeamsService_SetTeamRequiredReviewers_Call) Run(run func(tName domain.TeamName, required int)) *TeamsService_SetTeamRequiredReviewers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TeamsService_SetTeamRequiredReviewers_Call) Return(_a0 domain.Team, _a1 error) *TeamsService_SetTeamRequiredReviewers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_SetTeamRequiredReviewers_Call) RunAndReturn(run func(domain.TeamName, int) (domain.Team, error)) *TeamsService_SetTeamRequiredReviewers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTeamReviewCapacity provides a mock function with given fields: tName, c
+func (_m *TeamsService) SetTeamReviewCapacity(tName domain.TeamName, c domain.ReviewCapacity) (domain.Team, error) {
+	ret := _m.Called(tName, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamReviewCapacity")
+	}
+
+	var r0 domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.ReviewCapacity) (domain.Team, error)); ok {
+		return rf(tName, c)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TeamName, domain.ReviewCapacity) domain.Team); ok {
+		r0 = rf(tName, c)
+	} else {
+		r0 = ret.Get(0).(domain.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TeamName, domain.ReviewCapacity) error); ok {
+		r1 = rf(tName, c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_SetTeamReviewCapacity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamReviewCapacity'
+type TeamsService_SetTeamReviewCapacity_Call struct {
+	*mock.Call
+}
+
+// SetTeamReviewCapacity is a helper method to define mock.On call
+//   - tName domain.TeamName
+//   - c domain.ReviewCapacity
+func (_e *TeamsService_Expecter) SetTeamReviewCapacity(tName interface{}, c interface{}) *TeamsService_SetTeamReviewCapacity_Call {
+	return &TeamsService_SetTeamReviewCapacity_Call{Call: _e.mock.On("SetTeamReviewCapacity", tName, c)}
+}
+
+func (_c *TeamsService_SetTeamReviewCapacity_Call) Run(run func(tName domain.TeamName, c domain.ReviewCapacity)) *TeamsService_SetTeamReviewCapacity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.TeamName), args[1].(domain.ReviewCapacity))
+	})
+	return _c
+}
+
+func (_c *TeamsService_SetTeamReviewCapacity_Call) Return(_a0 domain.Team, _a1 error) *TeamsService_SetTeamReviewCapacity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_SetTeamReviewCapacity_Call) RunAndReturn(run func(domain.TeamName, domain.ReviewCapacity) (domain.Team, error)) *TeamsService_SetTeamReviewCapacity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TeamFairness provides a mock function with given fields: ctx, tName, window
+func (_m *TeamsService) TeamFairness(ctx context.Context, tName domain.TeamName, window time.Duration) (domain.FairnessReport, error) {
+	ret := _m.Called(ctx, tName, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TeamFairness")
+	}
+
+	var r0 domain.FairnessReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, time.Duration) (domain.FairnessReport, error)); ok {
+		return rf(ctx, tName, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamName, time.Duration) domain.FairnessReport); ok {
+		r0 = rf(ctx, tName, window)
+	} else {
+		r0 = ret.Get(0).(domain.FairnessReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamName, time.Duration) error); ok {
+		r1 = rf(ctx, tName, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamsService_TeamFairness_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TeamFairness'
+type TeamsService_TeamFairness_Call struct {
+	*mock.Call
+}
+
+// TeamFairness is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tName domain.TeamName
+//   - window time.Duration
+func (_e *TeamsService_Expecter) TeamFairness(ctx interface{}, tName interface{}, window interface{}) *TeamsService_TeamFairness_Call {
+	return &TeamsService_TeamFairness_Call{Call: _e.mock.On("TeamFairness", ctx, tName, window)}
+}
+
+func (_c *TeamsService_TeamFairness_Call) Run(run func(ctx context.Context, tName domain.TeamName, window time.Duration)) *TeamsService_TeamFairness_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamName), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *TeamsService_TeamFairness_Call) Return(_a0 domain.FairnessReport, _a1 error) *TeamsService_TeamFairness_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamsService_TeamFairness_Call) RunAndReturn(run func(context.Context, domain.TeamName, time.Duration) (domain.FairnessReport, error)) *TeamsService_TeamFairness_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TeamWithMembers provides a mock function with given fields: tName
 func (_m *TeamsService) TeamWithMembers(tName domain.TeamName) (domain.Team, error) {
 	ret := _m.Called(tName)
@@ -1,8 +1,10 @@
 package restteams
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	"github.com/eragon-mdi/pr-reviewer-service/pkg/validator"
@@ -17,6 +19,17 @@ var (
 type TeamsService interface {
 	NewTeam(team domain.Team) (domain.Team, error)
 	TeamWithMembers(tName domain.TeamName) (domain.Team, error)
+	SetTeamReviewCapacity(tName domain.TeamName, c domain.ReviewCapacity) (domain.Team, error)
+	SetTeamRequiredReviewers(tName domain.TeamName, required int) (domain.Team, error)
+	SetTeamMergePolicy(tName domain.TeamName, p domain.MergePolicyOverride) (domain.Team, error)
+	DeactivateTeam(ctx context.Context, tName domain.TeamName) (domain.DeactivationReport, error)
+	TeamFairness(ctx context.Context, tName domain.TeamName, window time.Duration) (domain.FairnessReport, error)
+	AddTeamMembers(tName domain.TeamName, members domain.Members) (domain.Team, error)
+	RemoveTeamMember(ctx context.Context, tName domain.TeamName, id domain.MemberId, policy domain.OpenReviewsPolicy, audit domain.AssignmentAudit) (domain.TeamRemovalReport, error)
+	RenameTeam(tName, newName domain.TeamName) (domain.Team, error)
+	DeleteTeam(ctx context.Context, tName domain.TeamName, policy domain.OpenReviewsPolicy, audit domain.AssignmentAudit) (domain.TeamRemovalReport, error)
+	ReconcileTeams(ctx context.Context, spec domain.OrgSpec, apply bool) (domain.ReconcilePlan, error)
+	ResolveMember(ctx context.Context, ref domain.MemberRef) (domain.MemberId, error)
 }
 
 func (ts *RestTeams) AddTeam(c echo.Context) error {
@@ -77,6 +90,378 @@ func (ts *RestTeams) GetTeamByName(c echo.Context) error {
 	return c.JSON(http.StatusOK, teamResponse(team))
 }
 
+func (ts *RestTeams) SetTeamReviewCapacity(c echo.Context) error {
+	var req = &SetTeamReviewCapacityRequest{}
+
+	l := ts.l.With("req", req)
+	l.Infof("SetTeamReviewCapacity called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	team, err := ts.s.SetTeamReviewCapacity(domain.TeamName(req.TeamName), req.capacity())
+	if err != nil {
+		l.Errorf("failed to set team review capacity: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("team", team.Name.String())
+	l.Infof("team review capacity updated successfully")
+
+	return c.JSON(http.StatusOK, teamSettingsResponse(team))
+}
+
+func (ts *RestTeams) SetTeamRequiredReviewers(c echo.Context) error {
+	var req = &SetTeamRequiredReviewersRequest{}
+
+	l := ts.l.With("req", req)
+	l.Infof("SetTeamRequiredReviewers called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	team, err := ts.s.SetTeamRequiredReviewers(domain.TeamName(req.TeamName), *req.RequiredReviewers)
+	if err != nil {
+		l.Errorf("failed to set team required reviewers: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("team", team.Name.String())
+	l.Infof("team required reviewers updated successfully")
+
+	return c.JSON(http.StatusOK, teamSettingsResponse(team))
+}
+
+func (ts *RestTeams) SetTeamMergePolicy(c echo.Context) error {
+	var req = &SetTeamMergePolicyRequest{}
+
+	l := ts.l.With("req", req)
+	l.Infof("SetTeamMergePolicy called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	team, err := ts.s.SetTeamMergePolicy(domain.TeamName(req.TeamName), req.domain())
+	if err != nil {
+		l.Errorf("failed to set team merge policy: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("team", team.Name.String())
+	l.Infof("team merge policy updated successfully")
+
+	return c.JSON(http.StatusOK, teamSettingsResponse(team))
+}
+
+func (ts *RestTeams) DeactivateTeam(c echo.Context) error {
+	var req = &DeactivateTeamRequest{}
+
+	l := ts.l.With("req", req)
+	l.Infof("DeactivateTeam called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	report, err := ts.s.DeactivateTeam(c.Request().Context(), domain.TeamName(req.TeamName))
+	if err != nil {
+		l.Errorf("failed to deactivate team: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("team", report.Team.String(), "reassigned", len(report.Reassigned), "unfilled", len(report.Unfilled))
+	l.Infof("team deactivated successfully")
+
+	return c.JSON(http.StatusOK, deactivationReportResponse(report))
+}
+
+func (ts *RestTeams) GetTeamFairness(c echo.Context) error {
+	var req = &TeamFairnessRequest{}
+
+	l := ts.l.With("req", req)
+	l.Infof("GetTeamFairness called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqParam
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqParam
+	}
+
+	report, err := ts.s.TeamFairness(c.Request().Context(), domain.TeamName(req.TeamName), req.window())
+	if err != nil {
+		l.Errorf("failed to get team fairness: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqParam
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("team", report.Team.String(), "gini", report.Gini)
+	l.Infof("team fairness fetched successfully")
+
+	return c.JSON(http.StatusOK, fairnessReportResponse(report))
+}
+
+func (ts *RestTeams) AddTeamMembers(c echo.Context) error {
+	var req = &AddTeamMembersRequest{}
+
+	l := ts.l.With("req", req)
+	l.Infof("AddTeamMembers called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	team, err := ts.s.AddTeamMembers(domain.TeamName(req.TeamName), req.members())
+	if err != nil {
+		l.Errorf("failed to add team members: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("team", team.Name.String(), "members", len(team.Members))
+	l.Infof("team members added successfully")
+
+	return c.JSON(http.StatusOK, teamResponse(team))
+}
+
+func (ts *RestTeams) RemoveTeamMember(c echo.Context) error {
+	var req = &RemoveTeamMemberRequest{}
+
+	l := ts.l.With("req", req)
+	l.Infof("RemoveTeamMember called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	id, err := ts.resolveMember(c, req.UserID)
+	if err != nil {
+		l.Errorf("failed to resolve user: %v", err)
+		return err
+	}
+
+	report, err := ts.s.RemoveTeamMember(c.Request().Context(),
+		domain.TeamName(req.TeamName), id, req.policy(), req.audit())
+	if err != nil {
+		l.Errorf("failed to remove team member: %v", err)
+		return teamRemovalError(err)
+	}
+
+	l = l.With("team", report.Team.String(), "reassigned", len(report.Reassigned), "unfilled", len(report.Unfilled))
+	l.Infof("team member removed successfully")
+
+	return c.JSON(http.StatusOK, teamRemovalReportResponse(report))
+}
+
+func (ts *RestTeams) RenameTeam(c echo.Context) error {
+	var req = &RenameTeamRequest{}
+
+	l := ts.l.With("req", req)
+	l.Infof("RenameTeam called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	team, err := ts.s.RenameTeam(domain.TeamName(req.TeamName), domain.TeamName(req.NewTeamName))
+	if err != nil {
+		l.Errorf("failed to rename team: %v", err)
+
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.HttpErrNotFound()
+		}
+		if errors.Is(err, domain.ErrDuplicate) {
+			return domain.HttpErrTeamExists()
+		}
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("team", team.Name.String())
+	l.Infof("team renamed successfully")
+
+	return c.JSON(http.StatusOK, teamSettingsResponse(team))
+}
+
+func (ts *RestTeams) DeleteTeam(c echo.Context) error {
+	var req = &DeleteTeamRequest{}
+
+	l := ts.l.With("req", req)
+	l.Infof("DeleteTeam called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	report, err := ts.s.DeleteTeam(c.Request().Context(), domain.TeamName(req.TeamName), req.policy(), req.audit())
+	if err != nil {
+		l.Errorf("failed to delete team: %v", err)
+		return teamRemovalError(err)
+	}
+
+	l = l.With("team", report.Team.String(), "reassigned", len(report.Reassigned), "unfilled", len(report.Unfilled))
+	l.Infof("team deleted successfully")
+
+	return c.JSON(http.StatusOK, teamRemovalReportResponse(report))
+}
+
+// ReconcileTeams returns the plan to bring the listed teams to the requested state, apply runs it.
+func (ts *RestTeams) ReconcileTeams(c echo.Context) error {
+	var req = &ReconcileRequest{}
+
+	l := ts.l
+	l.Infof("ReconcileTeams called")
+
+	if err := c.Bind(req); err != nil {
+		l.Errorf("failed to bind request: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := validate(c, req); err != nil {
+		l.Errorf("failed validate: %v", err)
+		return ErrBadReqBody
+	}
+
+	plan, err := ts.s.ReconcileTeams(c.Request().Context(), req.domain(), req.Apply)
+	if err != nil {
+		l.Errorf("failed to reconcile teams: %v", err)
+
+		if errors.Is(err, domain.ErrValidation) {
+			return ErrBadReqBody
+		}
+		return domain.ErrInternal
+	}
+
+	l = l.With("teams", len(req.Teams), "apply", req.Apply, "applied", plan.Applied, "affected", len(plan.Affected), "reassigned", len(plan.Reassigned), "unfilled", len(plan.Unfilled))
+	l.Infof("teams reconciled successfully")
+
+	return c.JSON(http.StatusOK, reconcilePlanResponse(plan))
+}
+
+func teamRemovalError(err error) error {
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.HttpErrNotFound()
+	}
+	if errors.Is(err, domain.ErrOpenReviews) {
+		return domain.HttpErrOpenReviews()
+	}
+	if errors.Is(err, domain.ErrValidation) {
+		return ErrBadReqBody
+	}
+	return domain.ErrInternal
+}
+
+// resolveMember turns a validated user reference into the member id, UUIDs are taken as is
+// and an unknown provider:external_id is 404.
+func (ts *RestTeams) resolveMember(c echo.Context, ref string) (domain.MemberId, error) {
+	if id, ok := domain.MemberRef(ref).MemberId(); ok {
+		return id, nil
+	}
+
+	id, err := ts.s.ResolveMember(c.Request().Context(), domain.MemberRef(ref))
+	if err != nil {
+		if errors.Is(err, domain.ErrValidation) {
+			return "", ErrBadReqBody
+		}
+		if errors.Is(err, domain.ErrNotFound) {
+			return "", domain.HttpErrNotFound()
+		}
+		return "", domain.ErrInternal
+	}
+
+	return id, nil
+}
+
 func validate(c echo.Context, structure any) error {
 	return validator.Validate(c.Request().Context(), structure)
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
 	"github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/teams/mocks"
@@ -219,3 +220,981 @@ func TestRestTeams_GetTeamByName(t *testing.T) {
 		})
 	}
 }
+
+func TestRestTeams_SetTeamReviewCapacity(t *testing.T) {
+	four := 4
+
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.TeamsService)
+		wantStatus   int
+		wantResp     TeamSettingsResponse
+		wantErr      error
+	}{
+		{
+			name:        "set default capacity",
+			requestBody: SetTeamReviewCapacityRequest{TeamName: "backend", DefaultReviewCapacity: &four},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				team := domain.NewTeam("backend")
+				team.Settings.DefaultReviewCapacity = domain.NewReviewCapacity(4)
+				mockService.On("SetTeamReviewCapacity", domain.TeamName("backend"), domain.NewReviewCapacity(4)).
+					Return(team, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   TeamSettingsResponse{TeamName: "backend", DefaultReviewCapacity: &four},
+		},
+		{
+			name:        "unset default capacity",
+			requestBody: SetTeamReviewCapacityRequest{TeamName: "backend"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("SetTeamReviewCapacity", domain.TeamName("backend"), domain.UnlimitedReviewCapacity()).
+					Return(domain.NewTeam("backend"), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   TeamSettingsResponse{TeamName: "backend"},
+		},
+		{
+			name:         "missing team name",
+			requestBody:  SetTeamReviewCapacityRequest{DefaultReviewCapacity: &four},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:        "team not found",
+			requestBody: SetTeamReviewCapacityRequest{TeamName: "ghost", DefaultReviewCapacity: &four},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("SetTeamReviewCapacity", mock.Anything, mock.Anything).
+					Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/teams/setReviewCapacity", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.SetTeamReviewCapacity(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp TeamSettingsResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestTeams_SetTeamRequiredReviewers(t *testing.T) {
+	one := 1
+	negative := -1
+
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.TeamsService)
+		wantStatus   int
+		wantResp     TeamSettingsResponse
+		wantErr      error
+	}{
+		{
+			name:        "set required reviewers",
+			requestBody: SetTeamRequiredReviewersRequest{TeamName: "backend", RequiredReviewers: &one},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				team := domain.NewTeam("backend")
+				team.Settings.RequiredReviewers = 1
+				mockService.On("SetTeamRequiredReviewers", domain.TeamName("backend"), 1).Return(team, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   TeamSettingsResponse{TeamName: "backend", RequiredReviewers: 1},
+		},
+		{
+			name:         "missing count",
+			requestBody:  SetTeamRequiredReviewersRequest{TeamName: "backend"},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:         "negative count",
+			requestBody:  SetTeamRequiredReviewersRequest{TeamName: "backend", RequiredReviewers: &negative},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:        "team not found",
+			requestBody: SetTeamRequiredReviewersRequest{TeamName: "ghost", RequiredReviewers: &one},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("SetTeamRequiredReviewers", mock.Anything, mock.Anything).
+					Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/teams/setRequiredReviewers", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.SetTeamRequiredReviewers(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp TeamSettingsResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestTeams_SetTeamMergePolicy(t *testing.T) {
+	two := 2
+	negative := -2
+	no := false
+
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.TeamsService)
+		wantStatus   int
+		wantResp     TeamSettingsResponse
+		wantErr      error
+	}{
+		{
+			name:        "set merge policy",
+			requestBody: SetTeamMergePolicyRequest{TeamName: "backend", MinApprovals: &two, BlockOnChangesRequested: &no},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				policy := domain.MergePolicyOverride{MinApprovals: &two, BlockOnChangesRequested: &no}
+				team := domain.NewTeam("backend")
+				team.Settings.MergePolicy = policy
+				mockService.On("SetTeamMergePolicy", domain.TeamName("backend"), policy).Return(team, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: TeamSettingsResponse{
+				TeamName:    "backend",
+				MergePolicy: MergePolicyResponse{MinApprovals: &two, BlockOnChangesRequested: &no},
+			},
+		},
+		{
+			name:         "negative approvals",
+			requestBody:  SetTeamMergePolicyRequest{TeamName: "backend", MinApprovals: &negative},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:         "missing team name",
+			requestBody:  SetTeamMergePolicyRequest{MinApprovals: &two},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:        "team not found",
+			requestBody: SetTeamMergePolicyRequest{TeamName: "ghost"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("SetTeamMergePolicy", mock.Anything, mock.Anything).
+					Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/teams/setMergePolicy", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.SetTeamMergePolicy(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp TeamSettingsResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestTeams_DeactivateTeam(t *testing.T) {
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.TeamsService)
+		wantStatus   int
+		wantResp     DeactivationReportResponse
+		wantErr      error
+	}{
+		{
+			name:        "deactivate team",
+			requestBody: DeactivateTeamRequest{TeamName: "backend"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("DeactivateTeam", mock.Anything, domain.TeamName("backend")).Return(domain.DeactivationReport{
+					Team:        "backend",
+					Deactivated: []domain.MemberId{"u1", "u2"},
+					Reassigned:  []domain.Reassignment{{PrId: "pr-1", OldMemberId: "u1", NewMemberId: "u3"}},
+					Unfilled:    []domain.Reassignment{{PrId: "pr-2", OldMemberId: "u2"}},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: DeactivationReportResponse{
+				TeamName:         "backend",
+				DeactivatedUsers: []string{"u1", "u2"},
+				Reassigned:       []ReassignmentResponse{{PullRequestID: "pr-1", OldReviewerID: "u1", NewReviewerID: "u3"}},
+				Unfilled:         []ReassignmentResponse{{PullRequestID: "pr-2", OldReviewerID: "u2"}},
+			},
+		},
+		{
+			name:         "missing team name",
+			requestBody:  DeactivateTeamRequest{},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:        "team not found",
+			requestBody: DeactivateTeamRequest{TeamName: "ghost"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("DeactivateTeam", mock.Anything, mock.Anything).
+					Return(domain.DeactivationReport{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/teams/deactivate", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.DeactivateTeam(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp DeactivationReportResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestTeams_GetTeamFairness(t *testing.T) {
+	since := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		teamName     string
+		query        string
+		serviceSetup func(*mocks.TeamsService)
+		wantStatus   int
+		wantResp     FairnessReportResponse
+		wantErr      error
+	}{
+		{
+			name:     "fairness with window",
+			teamName: "backend",
+			query:    "?window_days=7",
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("TeamFairness", mock.Anything, domain.TeamName("backend"), 7*24*time.Hour).
+					Return(domain.FairnessReport{
+						Team:  "backend",
+						Since: since,
+						Members: []domain.MemberLoad{
+							{MemberId: "u1", Name: "Alice", Load: 0, Flag: domain.LoadFlagUnder},
+							{MemberId: "u2", Name: "Bob", Load: 4, Flag: domain.LoadFlagOver},
+						},
+						Max:    4,
+						Mean:   2,
+						StdDev: 2,
+						Gini:   0.5,
+					}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: FairnessReportResponse{
+				TeamName: "backend",
+				Since:    "2025-11-01T00:00:00Z",
+				MaxLoad:  4,
+				MeanLoad: 2,
+				StdDev:   2,
+				Gini:     0.5,
+				Members: []MemberLoadResponse{
+					{UserID: "u1", Username: "Alice", Load: 0, Flag: "underloaded"},
+					{UserID: "u2", Username: "Bob", Load: 4, Flag: "overloaded"},
+				},
+			},
+		},
+		{
+			name:     "default window",
+			teamName: "backend",
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("TeamFairness", mock.Anything, domain.TeamName("backend"), time.Duration(0)).
+					Return(domain.FairnessReport{Team: "backend", Since: since}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: FairnessReportResponse{
+				TeamName: "backend",
+				Since:    "2025-11-01T00:00:00Z",
+				Members:  []MemberLoadResponse{},
+			},
+		},
+		{
+			name:         "invalid window",
+			teamName:     "backend",
+			query:        "?window_days=0",
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name:         "malformed window",
+			teamName:     "backend",
+			query:        "?window_days=week",
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqParam,
+		},
+		{
+			name:     "team not found",
+			teamName: "ghost",
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("TeamFairness", mock.Anything, domain.TeamName("ghost"), mock.Anything).
+					Return(domain.FairnessReport{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/"+tt.teamName+"/fairness"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/teams/:team_name/fairness")
+			c.SetParamNames("team_name")
+			c.SetParamValues(tt.teamName)
+
+			err := handler.GetTeamFairness(c)
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp FairnessReportResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func assertHTTPError(t *testing.T, want, got error) {
+	t.Helper()
+
+	switch want := want.(type) {
+	case *echo.HTTPError:
+		var httpErr *echo.HTTPError
+		if errors.As(got, &httpErr) {
+			assert.Equal(t, want.Code, httpErr.Code)
+			assert.Equal(t, want.Message, httpErr.Message)
+		} else {
+			t.Fatalf("expected echo.HTTPError, got %v", got)
+		}
+	case *domain.CustomHttpError:
+		var customErr *domain.CustomHttpError
+		if errors.As(got, &customErr) {
+			assert.Equal(t, want.HttpCode, customErr.HttpCode)
+			assert.Equal(t, want.Code, customErr.Code)
+		} else {
+			t.Fatalf("expected CustomHttpError, got %v", got)
+		}
+	default:
+		assert.ErrorIs(t, got, want)
+	}
+}
+
+func TestRestTeams_AddTeamMembers(t *testing.T) {
+	userID := uuid.New().String()
+	member := domain.MemberBuilder(domain.MemberId(userID)).Name("Alice").Status(domain.MemberStatusActive).Build()
+
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.TeamsService)
+		wantStatus   int
+		wantResp     TeamResponse
+		wantErr      error
+	}{
+		{
+			name: "members added",
+			requestBody: AddTeamMembersRequest{
+				TeamName: "backend",
+				Members:  []TeamMember{{UserID: userID, Username: "Alice", IsActive: true}},
+			},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("AddTeamMembers", domain.TeamName("backend"), domain.Members{member}).
+					Return(domain.NewTeam("backend", member), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: TeamResponse{
+				TeamName: "backend",
+				Members:  []TeamMember{{UserID: userID, Username: "Alice", IsActive: true}},
+			},
+		},
+		{
+			name:         "no members",
+			requestBody:  AddTeamMembersRequest{TeamName: "backend", Members: []TeamMember{}},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name: "team not found",
+			requestBody: AddTeamMembersRequest{
+				TeamName: "ghost",
+				Members:  []TeamMember{{UserID: userID, Username: "Alice", IsActive: true}},
+			},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("AddTeamMembers", mock.Anything, mock.Anything).Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/teams/addMembers", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.AddTeamMembers(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp TeamResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestTeams_RemoveTeamMember(t *testing.T) {
+	userID := uuid.New().String()
+	report := domain.TeamRemovalReport{
+		Team:       "backend",
+		Removed:    []domain.MemberId{domain.MemberId(userID)},
+		Reassigned: []domain.Reassignment{{PrId: "pr-1", OldMemberId: domain.MemberId(userID), NewMemberId: "u3"}},
+		Unfilled:   []domain.Reassignment{},
+	}
+
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.TeamsService)
+		wantStatus   int
+		wantResp     TeamRemovalReportResponse
+		wantErr      error
+	}{
+		{
+			name:        "keep is the default policy",
+			requestBody: RemoveTeamMemberRequest{TeamName: "backend", UserID: userID},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("RemoveTeamMember", mock.Anything, domain.TeamName("backend"), domain.MemberId(userID),
+					domain.OpenReviewsKeep, domain.AssignmentAudit{}).
+					Return(domain.TeamRemovalReport{
+						Team:       "backend",
+						Removed:    []domain.MemberId{domain.MemberId(userID)},
+						Reassigned: []domain.Reassignment{},
+						Unfilled:   []domain.Reassignment{{PrId: "pr-1", OldMemberId: domain.MemberId(userID)}},
+					}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: TeamRemovalReportResponse{
+				TeamName:     "backend",
+				RemovedUsers: []string{userID},
+				Reassigned:   []ReassignmentResponse{},
+				Unfilled:     []ReassignmentResponse{{PullRequestID: "pr-1", OldReviewerID: userID}},
+			},
+		},
+		{
+			name: "reassign with audit",
+			requestBody: RemoveTeamMemberRequest{
+				TeamName: "backend", UserID: userID, OpenReviews: "reassign", Actor: "alice", Reason: "left the team",
+			},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("RemoveTeamMember", mock.Anything, domain.TeamName("backend"), domain.MemberId(userID),
+					domain.OpenReviewsReassign, domain.AssignmentAudit{Actor: "alice", Reason: "left the team"}).
+					Return(report, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: TeamRemovalReportResponse{
+				TeamName:     "backend",
+				RemovedUsers: []string{userID},
+				Reassigned:   []ReassignmentResponse{{PullRequestID: "pr-1", OldReviewerID: userID, NewReviewerID: "u3"}},
+				Unfilled:     []ReassignmentResponse{},
+			},
+		},
+		{
+			name:        "rejected with open reviews",
+			requestBody: RemoveTeamMemberRequest{TeamName: "backend", UserID: userID, OpenReviews: "reject"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("RemoveTeamMember", mock.Anything, mock.Anything, mock.Anything, domain.OpenReviewsReject, mock.Anything).
+					Return(domain.TeamRemovalReport{}, domain.ErrOpenReviews)
+			},
+			wantErr: domain.HttpErrOpenReviews(),
+		},
+		{
+			name:         "unknown policy",
+			requestBody:  RemoveTeamMemberRequest{TeamName: "backend", UserID: userID, OpenReviews: "drop"},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:         "invalid user id",
+			requestBody:  RemoveTeamMemberRequest{TeamName: "backend", UserID: "not-a-uuid"},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:        "user referenced by identity",
+			requestBody: RemoveTeamMemberRequest{TeamName: "backend", UserID: "github:octocat", OpenReviews: "reassign"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("github:octocat")).
+					Return(domain.MemberId(userID), nil)
+				mockService.On("RemoveTeamMember", mock.Anything, domain.TeamName("backend"), domain.MemberId(userID),
+					domain.OpenReviewsReassign, domain.AssignmentAudit{}).
+					Return(report, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: TeamRemovalReportResponse{
+				TeamName:     "backend",
+				RemovedUsers: []string{userID},
+				Reassigned:   []ReassignmentResponse{{PullRequestID: "pr-1", OldReviewerID: userID, NewReviewerID: "u3"}},
+				Unfilled:     []ReassignmentResponse{},
+			},
+		},
+		{
+			name:        "unknown identity",
+			requestBody: RemoveTeamMemberRequest{TeamName: "backend", UserID: "github:ghost"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("ResolveMember", mock.Anything, domain.MemberRef("github:ghost")).
+					Return(domain.MemberId(""), domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:        "member not in team",
+			requestBody: RemoveTeamMemberRequest{TeamName: "backend", UserID: userID},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("RemoveTeamMember", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.TeamRemovalReport{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/teams/removeMember", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.RemoveTeamMember(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp TeamRemovalReportResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestTeams_RenameTeam(t *testing.T) {
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.TeamsService)
+		wantStatus   int
+		wantErr      error
+	}{
+		{
+			name:        "renamed",
+			requestBody: RenameTeamRequest{TeamName: "backend", NewTeamName: "platform"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("RenameTeam", domain.TeamName("backend"), domain.TeamName("platform")).
+					Return(domain.NewTeam("platform"), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "missing new name",
+			requestBody:  RenameTeamRequest{TeamName: "backend"},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name:        "name taken",
+			requestBody: RenameTeamRequest{TeamName: "backend", NewTeamName: "platform"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("RenameTeam", mock.Anything, mock.Anything).Return(domain.Team{}, domain.ErrDuplicate)
+			},
+			wantErr: domain.HttpErrTeamExists(),
+		},
+		{
+			name:        "team not found",
+			requestBody: RenameTeamRequest{TeamName: "ghost", NewTeamName: "platform"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("RenameTeam", mock.Anything, mock.Anything).Return(domain.Team{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/teams/rename", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.RenameTeam(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp TeamSettingsResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, "platform", resp.TeamName)
+		})
+	}
+}
+
+func TestRestTeams_DeleteTeam(t *testing.T) {
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.TeamsService)
+		wantStatus   int
+		wantResp     TeamRemovalReportResponse
+		wantErr      error
+	}{
+		{
+			name:        "reject is the default policy",
+			requestBody: DeleteTeamRequest{TeamName: "backend"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("DeleteTeam", mock.Anything, domain.TeamName("backend"), domain.OpenReviewsReject, domain.AssignmentAudit{}).
+					Return(domain.TeamRemovalReport{}, domain.ErrOpenReviews)
+			},
+			wantErr: domain.HttpErrOpenReviews(),
+		},
+		{
+			name:        "delete keeping reviews",
+			requestBody: DeleteTeamRequest{TeamName: "backend", OpenReviews: "keep"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("DeleteTeam", mock.Anything, domain.TeamName("backend"), domain.OpenReviewsKeep, domain.AssignmentAudit{}).
+					Return(domain.TeamRemovalReport{
+						Team:       "backend",
+						Removed:    []domain.MemberId{"u1"},
+						Reassigned: []domain.Reassignment{},
+						Unfilled:   []domain.Reassignment{{PrId: "pr-1", OldMemberId: "u1"}},
+					}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp: TeamRemovalReportResponse{
+				TeamName:     "backend",
+				RemovedUsers: []string{"u1"},
+				Reassigned:   []ReassignmentResponse{},
+				Unfilled:     []ReassignmentResponse{{PullRequestID: "pr-1", OldReviewerID: "u1"}},
+			},
+		},
+		{
+			name:        "team not found",
+			requestBody: DeleteTeamRequest{TeamName: "ghost", OpenReviews: "reassign"},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("DeleteTeam", mock.Anything, mock.Anything, domain.OpenReviewsReassign, mock.Anything).
+					Return(domain.TeamRemovalReport{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:         "missing team name",
+			requestBody:  DeleteTeamRequest{},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/teams/delete", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.DeleteTeam(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp TeamRemovalReportResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestTeams_ReconcileTeams(t *testing.T) {
+	userID := "8f5cbd8e-8f55-4a1c-9d35-1e7f0f0a0001"
+	inactive := false
+
+	tests := []struct {
+		name         string
+		requestBody  interface{}
+		serviceSetup func(*mocks.TeamsService)
+		wantResp     ReconcilePlanResponse
+		wantErr      error
+	}{
+		{
+			name: "dry run plan",
+			requestBody: ReconcileRequest{Teams: []ReconcileTeam{{
+				TeamName: "platform",
+				Members:  []ReconcileMember{{UserID: userID, Username: "Alice"}},
+			}}},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("ReconcileTeams", mock.Anything,
+					mock.MatchedBy(func(s domain.OrgSpec) bool {
+						return len(s.Teams) == 1 && s.Teams[0].Members[0].Status.IsActive()
+					}),
+					false,
+				).Return(domain.ReconcilePlan{
+					CreateTeams:   []domain.TeamName{"platform"},
+					AddMembers:    domain.MembershipChanges{{Team: "platform", MemberId: domain.MemberId(userID)}},
+					RemoveMembers: domain.MembershipChanges{{Team: "backend", MemberId: "u2"}},
+					Affected:      domain.ReviewAssignments{{PrId: "pr-1", Team: "backend", MemberId: "u2"}},
+				}, nil)
+			},
+			wantResp: ReconcilePlanResponse{
+				CreateTeams:          []string{"platform"},
+				CreateUsers:          []string{},
+				AddMembers:           []MembershipChangeResponse{{TeamName: "platform", UserID: userID}},
+				RemoveMembers:        []MembershipChangeResponse{{TeamName: "backend", UserID: "u2"}},
+				ActivateUsers:        []string{},
+				DeactivateUsers:      []string{},
+				RenameUsers:          []string{},
+				AffectedPullRequests: []AffectedReviewResponse{{PullRequestID: "pr-1", TeamName: "backend", ReviewerID: "u2"}},
+				Reassigned:           []ReassignmentResponse{},
+				Unfilled:             []ReassignmentResponse{},
+			},
+		},
+		{
+			name: "apply deactivation",
+			requestBody: ReconcileRequest{Apply: true, Teams: []ReconcileTeam{{
+				TeamName: "backend",
+				Members:  []ReconcileMember{{UserID: userID, Username: "Alice", IsActive: &inactive}},
+			}}},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("ReconcileTeams", mock.Anything,
+					mock.MatchedBy(func(s domain.OrgSpec) bool {
+						return !s.Teams[0].Members[0].Status.IsActive()
+					}),
+					true,
+				).Return(domain.ReconcilePlan{
+					Deactivate: []domain.MemberId{domain.MemberId(userID)},
+					Affected:   domain.ReviewAssignments{{PrId: "pr-2", Team: "backend", MemberId: domain.MemberId(userID)}},
+					Reassigned: []domain.Reassignment{{PrId: "pr-2", OldMemberId: domain.MemberId(userID), NewMemberId: "u3"}},
+					Applied:    true,
+				}, nil)
+			},
+			wantResp: ReconcilePlanResponse{
+				Applied:              true,
+				CreateTeams:          []string{},
+				CreateUsers:          []string{},
+				AddMembers:           []MembershipChangeResponse{},
+				RemoveMembers:        []MembershipChangeResponse{},
+				ActivateUsers:        []string{},
+				DeactivateUsers:      []string{userID},
+				RenameUsers:          []string{},
+				AffectedPullRequests: []AffectedReviewResponse{{PullRequestID: "pr-2", TeamName: "backend", ReviewerID: userID}},
+				Reassigned:           []ReassignmentResponse{{PullRequestID: "pr-2", OldReviewerID: userID, NewReviewerID: "u3"}},
+				Unfilled:             []ReassignmentResponse{},
+			},
+		},
+		{
+			name: "invalid member id",
+			requestBody: ReconcileRequest{Teams: []ReconcileTeam{{
+				TeamName: "backend",
+				Members:  []ReconcileMember{{UserID: "u1", Username: "Alice"}},
+			}}},
+			serviceSetup: func(mockService *mocks.TeamsService) {},
+			wantErr:      ErrBadReqBody,
+		},
+		{
+			name: "conflicting spec",
+			requestBody: ReconcileRequest{Teams: []ReconcileTeam{
+				{TeamName: "backend"},
+				{TeamName: "backend"},
+			}},
+			serviceSetup: func(mockService *mocks.TeamsService) {
+				mockService.On("ReconcileTeams", mock.Anything, mock.Anything, false).
+					Return(domain.ReconcilePlan{}, domain.ErrValidation)
+			},
+			wantErr: ErrBadReqBody,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := setupEcho()
+			mockService := mocks.NewTeamsService(t)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			bodyBytes, err := json.Marshal(tt.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/reconcile", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			err = handler.ReconcileTeams(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				assertHTTPError(t, tt.wantErr, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resp ReconcilePlanResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
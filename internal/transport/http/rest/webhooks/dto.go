@@ -0,0 +1,155 @@
+package restwebhooks
+
+import (
+	"strconv"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+)
+
+// GithubPullRequestEvent is the part of the GitHub pull_request payload the service reads.
+type GithubPullRequestEvent struct {
+	Action      string            `json:"action"`
+	Number      int               `json:"number"`
+	PullRequest GithubPullRequest `json:"pull_request"`
+	Repository  GithubRepository  `json:"repository"`
+	Sender      GithubUser        `json:"sender"`
+}
+
+type GithubPullRequest struct {
+	Title  string     `json:"title"`
+	Draft  bool       `json:"draft"`
+	Merged bool       `json:"merged"`
+	User   GithubUser `json:"user"`
+}
+
+type GithubRepository struct {
+	FullName string `json:"full_name"`
+}
+
+type GithubUser struct {
+	Login string `json:"login"`
+}
+
+// GitlabMergeRequestEvent is the part of the GitLab Merge Request Hook payload the service reads.
+// User is the one who triggered the event, for an opened MR it is the author.
+type GitlabMergeRequestEvent struct {
+	ObjectKind       string             `json:"object_kind"`
+	User             GitlabUser         `json:"user"`
+	Project          GitlabProject      `json:"project"`
+	ObjectAttributes GitlabMergeRequest `json:"object_attributes"`
+	Changes          GitlabChanges      `json:"changes"`
+}
+
+type GitlabUser struct {
+	Username string `json:"username"`
+}
+
+type GitlabProject struct {
+	Id                int    `json:"id"`
+	PathWithNamespace string `json:"path_with_namespace"`
+}
+
+// GitlabMergeRequest work_in_progress is the name of draft before GitLab 14.
+type GitlabMergeRequest struct {
+	Iid            int    `json:"iid"`
+	Title          string `json:"title"`
+	Action         string `json:"action"`
+	Draft          bool   `json:"draft"`
+	WorkInProgress bool   `json:"work_in_progress"`
+}
+
+type GitlabChanges struct {
+	Draft          *GitlabBoolChange `json:"draft"`
+	WorkInProgress *GitlabBoolChange `json:"work_in_progress"`
+}
+
+type GitlabBoolChange struct {
+	Previous bool `json:"previous"`
+	Current  bool `json:"current"`
+}
+
+// WebhookResponse status is processed, ignored or pong, the PR fields are set for processed events.
+type WebhookResponse struct {
+	Status            string `json:"status"`
+	PullRequestID     string `json:"pull_request_id,omitempty"`
+	PullRequestStatus string `json:"pull_request_status,omitempty"`
+}
+
+var githubActions = map[string]domain.PrEventAction{
+	"opened":           domain.PrEventOpened,
+	"ready_for_review": domain.PrEventReady,
+	"reopened":         domain.PrEventReopened,
+	"closed":           domain.PrEventClosed,
+}
+
+var gitlabActions = map[string]domain.PrEventAction{
+	"open":   domain.PrEventOpened,
+	"reopen": domain.PrEventReopened,
+	"close":  domain.PrEventClosed,
+	"merge":  domain.PrEventMerged,
+}
+
+// domain maps the payload onto the PR lifecycle, false means the action is not tracked.
+// GitHub reports a merge as closed with pull_request.merged set.
+func (p *GithubPullRequestEvent) domain() (domain.PullRequestEvent, bool) {
+	action, ok := githubActions[p.Action]
+	if !ok {
+		return domain.PullRequestEvent{}, false
+	}
+	if action == domain.PrEventClosed && p.PullRequest.Merged {
+		action = domain.PrEventMerged
+	}
+
+	return domain.PullRequestEvent{
+		Provider:   domain.ProviderGithub,
+		Action:     action,
+		Repository: p.Repository.FullName,
+		Number:     p.Number,
+		Title:      domain.PrName(p.PullRequest.Title),
+		Author:     p.PullRequest.User.Login,
+		Sender:     p.Sender.Login,
+		Draft:      p.PullRequest.Draft,
+	}, true
+}
+
+// domain maps the payload onto the PR lifecycle, false means the action is not tracked.
+// Of the update actions only taking the MR out of draft is, as it publishes the PR.
+// The PR is keyed by the project id and the MR iid, which survive renames and transfers.
+func (p *GitlabMergeRequestEvent) domain() (domain.PullRequestEvent, bool) {
+	mr := p.ObjectAttributes
+
+	action, ok := gitlabActions[mr.Action]
+	if !ok {
+		if mr.Action != "update" || !p.Changes.markedReady() {
+			return domain.PullRequestEvent{}, false
+		}
+		action = domain.PrEventReady
+	}
+
+	return domain.PullRequestEvent{
+		Provider:   domain.ProviderGitlab,
+		Action:     action,
+		Repository: strconv.Itoa(p.Project.Id),
+		Number:     mr.Iid,
+		Title:      domain.PrName(mr.Title),
+		Author:     p.User.Username,
+		Sender:     p.User.Username,
+		Draft:      mr.Draft || mr.WorkInProgress,
+	}, true
+}
+
+func (ch GitlabChanges) markedReady() bool {
+	draft := ch.Draft
+	if draft == nil {
+		draft = ch.WorkInProgress
+	}
+	return draft != nil && draft.Previous && !draft.Current
+}
+
+func processedResponse(pr domain.PullRequest) WebhookResponse {
+	return WebhookResponse{
+		Status:            webhookStatusProcessed,
+		PullRequestID:     pr.Id.String(),
+		PullRequestStatus: pr.Status.String(),
+	}
+}
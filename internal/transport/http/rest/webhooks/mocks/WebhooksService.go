@@ -0,0 +1,187 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhooksService is an autogenerated mock type for the WebhooksService type
+type WebhooksService struct {
+	mock.Mock
+}
+
+type WebhooksService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhooksService) EXPECT() *WebhooksService_Expecter {
+	return &WebhooksService_Expecter{mock: &_m.Mock}
+}
+
+// HandlePullRequestEvent provides a mock function with given fields: ctx, e
+func (_m *WebhooksService) HandlePullRequestEvent(ctx context.Context, e domain.PullRequestEvent) (domain.PullRequest, error) {
+	ret := _m.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandlePullRequestEvent")
+	}
+
+	var r0 domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PullRequestEvent) (domain.PullRequest, error)); ok {
+		return rf(ctx, e)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PullRequestEvent) domain.PullRequest); ok {
+		r0 = rf(ctx, e)
+	} else {
+		r0 = ret.Get(0).(domain.PullRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PullRequestEvent) error); ok {
+		r1 = rf(ctx, e)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhooksService_HandlePullRequestEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandlePullRequestEvent'
+type WebhooksService_HandlePullRequestEvent_Call struct {
+	*mock.Call
+}
+
+// HandlePullRequestEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e domain.PullRequestEvent
+func (_e *WebhooksService_Expecter) HandlePullRequestEvent(ctx interface{}, e interface{}) *WebhooksService_HandlePullRequestEvent_Call {
+	return &WebhooksService_HandlePullRequestEvent_Call{Call: _e.mock.On("HandlePullRequestEvent", ctx, e)}
+}
+
+func (_c *WebhooksService_HandlePullRequestEvent_Call) Run(run func(ctx context.Context, e domain.PullRequestEvent)) *WebhooksService_HandlePullRequestEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PullRequestEvent))
+	})
+	return _c
+}
+
+func (_c *WebhooksService_HandlePullRequestEvent_Call) Return(_a0 domain.PullRequest, _a1 error) *WebhooksService_HandlePullRequestEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhooksService_HandlePullRequestEvent_Call) RunAndReturn(run func(context.Context, domain.PullRequestEvent) (domain.PullRequest, error)) *WebhooksService_HandlePullRequestEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyGithubSignature provides a mock function with given fields: body, header
+func (_m *WebhooksService) VerifyGithubSignature(body []byte, header string) error {
+	ret := _m.Called(body, header)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyGithubSignature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte, string) error); ok {
+		r0 = rf(body, header)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhooksService_VerifyGithubSignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyGithubSignature'
+type WebhooksService_VerifyGithubSignature_Call struct {
+	*mock.Call
+}
+
+// VerifyGithubSignature is a helper method to define mock.On call
+//   - body []byte
+//   - header string
+func (_e *WebhooksService_Expecter) VerifyGithubSignature(body interface{}, header interface{}) *WebhooksService_VerifyGithubSignature_Call {
+	return &WebhooksService_VerifyGithubSignature_Call{Call: _e.mock.On("VerifyGithubSignature", body, header)}
+}
+
+func (_c *WebhooksService_VerifyGithubSignature_Call) Run(run func(body []byte, header string)) *WebhooksService_VerifyGithubSignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhooksService_VerifyGithubSignature_Call) Return(_a0 error) *WebhooksService_VerifyGithubSignature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhooksService_VerifyGithubSignature_Call) RunAndReturn(run func([]byte, string) error) *WebhooksService_VerifyGithubSignature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyGitlabToken provides a mock function with given fields: token
+func (_m *WebhooksService) VerifyGitlabToken(token string) error {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyGitlabToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhooksService_VerifyGitlabToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyGitlabToken'
+type WebhooksService_VerifyGitlabToken_Call struct {
+	*mock.Call
+}
+
+// VerifyGitlabToken is a helper method to define mock.On call
+//   - token string
+func (_e *WebhooksService_Expecter) VerifyGitlabToken(token interface{}) *WebhooksService_VerifyGitlabToken_Call {
+	return &WebhooksService_VerifyGitlabToken_Call{Call: _e.mock.On("VerifyGitlabToken", token)}
+}
+
+func (_c *WebhooksService_VerifyGitlabToken_Call) Run(run func(token string)) *WebhooksService_VerifyGitlabToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *WebhooksService_VerifyGitlabToken_Call) Return(_a0 error) *WebhooksService_VerifyGitlabToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhooksService_VerifyGitlabToken_Call) RunAndReturn(run func(string) error) *WebhooksService_VerifyGitlabToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhooksService creates a new instance of WebhooksService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhooksService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhooksService {
+	mock := &WebhooksService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
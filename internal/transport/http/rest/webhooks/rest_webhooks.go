@@ -0,0 +1,19 @@
+package restwebhooks
+
+import "go.uber.org/zap"
+
+type RestWebhooks struct {
+	s Service
+	l *zap.SugaredLogger
+}
+
+func New(s Service, l *zap.SugaredLogger) *RestWebhooks {
+	return &RestWebhooks{
+		s: s,
+		l: l,
+	}
+}
+
+type Service interface {
+	WebhooksService
+}
@@ -0,0 +1,158 @@
+package restwebhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrBadReqBody = echo.NewHTTPError(http.StatusBadRequest, "bad req body")
+)
+
+const (
+	HeaderGithubEvent     = "X-GitHub-Event"
+	HeaderGithubDelivery  = "X-GitHub-Delivery"
+	HeaderGithubSignature = "X-Hub-Signature-256"
+
+	HeaderGitlabEvent = "X-Gitlab-Event"
+	HeaderGitlabToken = "X-Gitlab-Token"
+
+	githubEventPing        = "ping"
+	githubEventPullRequest = "pull_request"
+
+	gitlabEventMergeRequest = "Merge Request Hook"
+
+	webhookStatusProcessed = "processed"
+	webhookStatusIgnored   = "ignored"
+	webhookStatusPong      = "pong"
+
+	// maxPayloadBytes bounds the body read before the signature is checked.
+	maxPayloadBytes = 5 << 20
+)
+
+type WebhooksService interface {
+	VerifyGithubSignature(body []byte, header string) error
+	VerifyGitlabToken(token string) error
+	HandlePullRequestEvent(ctx context.Context, e domain.PullRequestEvent) (domain.PullRequest, error)
+}
+
+// GithubWebhook accepts deliveries of a GitHub repository or organization webhook.
+// Events and actions the service does not track are acknowledged with 202 so GitHub does not retry them.
+func (wt *RestWebhooks) GithubWebhook(c echo.Context) error {
+	event := c.Request().Header.Get(HeaderGithubEvent)
+
+	l := wt.l.With("event", event, "delivery", c.Request().Header.Get(HeaderGithubDelivery))
+	l.Infof("GithubWebhook called")
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxPayloadBytes))
+	if err != nil {
+		l.Errorf("failed to read body: %v", err)
+		return ErrBadReqBody
+	}
+
+	if err := wt.s.VerifyGithubSignature(body, c.Request().Header.Get(HeaderGithubSignature)); err != nil {
+		l.Errorf("failed to verify signature: %v", err)
+		return domain.HttpErrBadSignature()
+	}
+
+	switch event {
+	case githubEventPing:
+		return c.JSON(http.StatusOK, WebhookResponse{Status: webhookStatusPong})
+	case githubEventPullRequest:
+	default:
+		l.Infof("event ignored")
+		return c.JSON(http.StatusAccepted, WebhookResponse{Status: webhookStatusIgnored})
+	}
+
+	var payload GithubPullRequestEvent
+	if err := json.Unmarshal(body, &payload); err != nil {
+		l.Errorf("failed to decode payload: %v", err)
+		return ErrBadReqBody
+	}
+
+	e, ok := payload.domain()
+	if !ok {
+		l.With("action", payload.Action).Infof("action ignored")
+		return c.JSON(http.StatusAccepted, WebhookResponse{Status: webhookStatusIgnored})
+	}
+
+	return wt.handlePullRequestEvent(c, l, e)
+}
+
+// GitlabWebhook accepts Merge Request Hook deliveries of a GitLab project or group webhook.
+// Other hooks and untracked actions are acknowledged with 202.
+func (wt *RestWebhooks) GitlabWebhook(c echo.Context) error {
+	event := c.Request().Header.Get(HeaderGitlabEvent)
+
+	l := wt.l.With("event", event)
+	l.Infof("GitlabWebhook called")
+
+	if err := wt.s.VerifyGitlabToken(c.Request().Header.Get(HeaderGitlabToken)); err != nil {
+		l.Errorf("failed to verify token: %v", err)
+		return domain.HttpErrBadSignature()
+	}
+
+	if event != gitlabEventMergeRequest {
+		l.Infof("event ignored")
+		return c.JSON(http.StatusAccepted, WebhookResponse{Status: webhookStatusIgnored})
+	}
+
+	var payload GitlabMergeRequestEvent
+	if err := json.NewDecoder(io.LimitReader(c.Request().Body, maxPayloadBytes)).Decode(&payload); err != nil {
+		l.Errorf("failed to decode payload: %v", err)
+		return ErrBadReqBody
+	}
+
+	e, ok := payload.domain()
+	if !ok {
+		l.With("action", payload.ObjectAttributes.Action).Infof("action ignored")
+		return c.JSON(http.StatusAccepted, WebhookResponse{Status: webhookStatusIgnored})
+	}
+
+	return wt.handlePullRequestEvent(c, l, e)
+}
+
+func (wt *RestWebhooks) handlePullRequestEvent(c echo.Context, l *zap.SugaredLogger, e domain.PullRequestEvent) error {
+	l = l.With("action", e.Action, "pr_id", e.PrId().String())
+
+	pr, err := wt.s.HandlePullRequestEvent(c.Request().Context(), e)
+	if err != nil {
+		l.Errorf("failed to handle pull request event: %v", err)
+		return pullRequestEventError(err, e)
+	}
+
+	l.Infof("pull request event processed successfully")
+
+	return c.JSON(http.StatusOK, processedResponse(pr))
+}
+
+func pullRequestEventError(err error, e domain.PullRequestEvent) error {
+	var blocked *domain.MergeBlockedError
+	if errors.As(err, &blocked) {
+		return domain.HttpErrMergeBlocked(blocked.Unmet)
+	}
+
+	if errors.Is(err, domain.ErrValidation) {
+		return ErrBadReqBody
+	}
+	if errors.Is(err, domain.ErrUnknownIdentity) {
+		return domain.HttpErrUnknownIdentity(e.AuthorIdentity().String())
+	}
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.HttpErrNotFound()
+	}
+	if errors.Is(err, domain.ErrCapacityExceeded) {
+		return domain.HttpErrNoCapacity()
+	}
+	if errors.Is(err, domain.ErrInvalidPrState) {
+		return domain.HttpErrPRState()
+	}
+	return domain.ErrInternal
+}
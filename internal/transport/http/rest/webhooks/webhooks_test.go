@@ -0,0 +1,438 @@
+package restwebhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/eragon-mdi/pr-reviewer-service/internal/domain"
+	"github.com/eragon-mdi/pr-reviewer-service/internal/transport/http/rest/webhooks/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+const testSignature = "sha256=recorded"
+
+func fixture(t *testing.T, provider, name string) []byte {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("testdata", provider, name))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", name, err)
+	}
+	return body
+}
+
+func TestRestWebhooks_GithubWebhook(t *testing.T) {
+	prId := domain.ExternalPrId(domain.ProviderGithub, "acme/api", 42)
+	base := domain.PullRequestEvent{
+		Provider:   domain.ProviderGithub,
+		Repository: "acme/api",
+		Number:     42,
+		Title:      "Add full text search",
+		Author:     "octocat",
+		Sender:     "octocat",
+	}
+	withAction := func(action domain.PrEventAction, patch func(*domain.PullRequestEvent)) domain.PullRequestEvent {
+		e := base
+		e.Action = action
+		if patch != nil {
+			patch(&e)
+		}
+		return e
+	}
+	bySender := func(e *domain.PullRequestEvent) { e.Sender = "hubot" }
+
+	tests := []struct {
+		name         string
+		event        string
+		fixture      string
+		serviceSetup func(*mocks.WebhooksService)
+		wantStatus   int
+		wantResp     WebhookResponse
+		wantErr      error
+	}{
+		{
+			name:    "opened",
+			event:   "pull_request",
+			fixture: "pull_request_opened.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventOpened, nil)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusOpen}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "OPEN"},
+		},
+		{
+			name:    "opened draft",
+			event:   "pull_request",
+			fixture: "pull_request_opened_draft.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything,
+					withAction(domain.PrEventOpened, func(e *domain.PullRequestEvent) { e.Draft = true })).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusDraft}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "DRAFT"},
+		},
+		{
+			name:    "ready for review",
+			event:   "pull_request",
+			fixture: "pull_request_ready_for_review.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventReady, nil)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusOpen}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "OPEN"},
+		},
+		{
+			name:    "closed without merge",
+			event:   "pull_request",
+			fixture: "pull_request_closed.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventClosed, bySender)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusClosed}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "CLOSED"},
+		},
+		{
+			name:    "merged",
+			event:   "pull_request",
+			fixture: "pull_request_merged.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventMerged, bySender)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusMerged}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "MERGED"},
+		},
+		{
+			name:    "reopened",
+			event:   "pull_request",
+			fixture: "pull_request_reopened.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventReopened, bySender)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusOpen}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "OPEN"},
+		},
+		{
+			name:         "untracked action",
+			event:        "pull_request",
+			fixture:      "pull_request_labeled.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {},
+			wantStatus:   http.StatusAccepted,
+			wantResp:     WebhookResponse{Status: "ignored"},
+		},
+		{
+			name:         "ping",
+			event:        "ping",
+			fixture:      "ping.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {},
+			wantStatus:   http.StatusOK,
+			wantResp:     WebhookResponse{Status: "pong"},
+		},
+		{
+			name:         "untracked event",
+			event:        "push",
+			fixture:      "ping.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {},
+			wantStatus:   http.StatusAccepted,
+			wantResp:     WebhookResponse{Status: "ignored"},
+		},
+		{
+			name:    "unknown author",
+			event:   "pull_request",
+			fixture: "pull_request_opened.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, mock.Anything).
+					Return(domain.PullRequest{}, domain.ErrUnknownIdentity)
+			},
+			wantErr: domain.HttpErrUnknownIdentity("github:octocat"),
+		},
+		{
+			name:    "merge of an unknown pull request",
+			event:   "pull_request",
+			fixture: "pull_request_merged.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, mock.Anything).
+					Return(domain.PullRequest{}, domain.ErrNotFound)
+			},
+			wantErr: domain.HttpErrNotFound(),
+		},
+		{
+			name:    "merge blocked by policy",
+			event:   "pull_request",
+			fixture: "pull_request_merged.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, mock.Anything).
+					Return(domain.PullRequest{}, &domain.MergeBlockedError{Unmet: []string{"approvals"}})
+			},
+			wantErr: domain.HttpErrMergeBlocked([]string{"approvals"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockService := mocks.NewWebhooksService(t)
+			body := fixture(t, "github", tt.fixture)
+			mockService.On("VerifyGithubSignature", body, testSignature).Return(nil)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(HeaderGithubEvent, tt.event)
+			req.Header.Set(HeaderGithubSignature, testSignature)
+			rec := httptest.NewRecorder()
+
+			err := handler.GithubWebhook(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				var customErr *domain.CustomHttpError
+				if assert.True(t, errors.As(err, &customErr)) {
+					assert.Equal(t, tt.wantErr, customErr)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp WebhookResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestWebhooks_GithubWebhook_BadSignature(t *testing.T) {
+	e := echo.New()
+	mockService := mocks.NewWebhooksService(t)
+	body := fixture(t, "github", "pull_request_opened.json")
+	mockService.On("VerifyGithubSignature", body, "sha256=forged").Return(domain.ErrBadSignature)
+
+	handler := New(mockService, zap.NewNop().Sugar())
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewReader(body))
+	req.Header.Set(HeaderGithubEvent, "pull_request")
+	req.Header.Set(HeaderGithubSignature, "sha256=forged")
+	rec := httptest.NewRecorder()
+
+	err := handler.GithubWebhook(e.NewContext(req, rec))
+
+	var customErr *domain.CustomHttpError
+	if assert.True(t, errors.As(err, &customErr)) {
+		assert.Equal(t, domain.HttpErrBadSignature(), customErr)
+	}
+}
+
+func TestRestWebhooks_GitlabWebhook(t *testing.T) {
+	prId := domain.ExternalPrId(domain.ProviderGitlab, "1873", 17)
+	base := domain.PullRequestEvent{
+		Provider:   domain.ProviderGitlab,
+		Repository: "1873",
+		Number:     17,
+		Title:      "Use decimal for invoice totals",
+		Author:     "jdoe",
+		Sender:     "jdoe",
+	}
+	withAction := func(action domain.PrEventAction, patch func(*domain.PullRequestEvent)) domain.PullRequestEvent {
+		e := base
+		e.Action = action
+		if patch != nil {
+			patch(&e)
+		}
+		return e
+	}
+	byMaintainer := func(e *domain.PullRequestEvent) { e.Author, e.Sender = "rroe", "rroe" }
+
+	tests := []struct {
+		name         string
+		event        string
+		fixture      string
+		serviceSetup func(*mocks.WebhooksService)
+		wantStatus   int
+		wantResp     WebhookResponse
+		wantErr      error
+	}{
+		{
+			name:    "open",
+			event:   "Merge Request Hook",
+			fixture: "merge_request_open.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventOpened, nil)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusOpen}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "OPEN"},
+		},
+		{
+			name:    "open draft",
+			event:   "Merge Request Hook",
+			fixture: "merge_request_open_draft.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything,
+					withAction(domain.PrEventOpened, func(e *domain.PullRequestEvent) { e.Draft = true })).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusDraft}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "DRAFT"},
+		},
+		{
+			name:    "update marks ready",
+			event:   "Merge Request Hook",
+			fixture: "merge_request_update_ready.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventReady, nil)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusOpen}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "OPEN"},
+		},
+		{
+			name:    "update marks ready before GitLab 14",
+			event:   "Merge Request Hook",
+			fixture: "merge_request_update_ready_legacy.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventReady, nil)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusOpen}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "OPEN"},
+		},
+		{
+			name:         "other update",
+			event:        "Merge Request Hook",
+			fixture:      "merge_request_update_title.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {},
+			wantStatus:   http.StatusAccepted,
+			wantResp:     WebhookResponse{Status: "ignored"},
+		},
+		{
+			name:    "merge",
+			event:   "Merge Request Hook",
+			fixture: "merge_request_merge.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventMerged, byMaintainer)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusMerged}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "MERGED"},
+		},
+		{
+			name:    "close",
+			event:   "Merge Request Hook",
+			fixture: "merge_request_close.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventClosed, byMaintainer)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusClosed}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "CLOSED"},
+		},
+		{
+			name:    "reopen",
+			event:   "Merge Request Hook",
+			fixture: "merge_request_reopen.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, withAction(domain.PrEventReopened, byMaintainer)).
+					Return(domain.PullRequest{Id: prId, Status: domain.PrStatusOpen}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantResp:   WebhookResponse{Status: "processed", PullRequestID: prId.String(), PullRequestStatus: "OPEN"},
+		},
+		{
+			name:         "untracked action",
+			event:        "Merge Request Hook",
+			fixture:      "merge_request_approved.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {},
+			wantStatus:   http.StatusAccepted,
+			wantResp:     WebhookResponse{Status: "ignored"},
+		},
+		{
+			name:         "untracked hook",
+			event:        "Push Hook",
+			fixture:      "merge_request_open.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {},
+			wantStatus:   http.StatusAccepted,
+			wantResp:     WebhookResponse{Status: "ignored"},
+		},
+		{
+			name:    "unknown author",
+			event:   "Merge Request Hook",
+			fixture: "merge_request_open.json",
+			serviceSetup: func(mockService *mocks.WebhooksService) {
+				mockService.On("HandlePullRequestEvent", mock.Anything, mock.Anything).
+					Return(domain.PullRequest{}, domain.ErrUnknownIdentity)
+			},
+			wantErr: domain.HttpErrUnknownIdentity("gitlab:jdoe"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockService := mocks.NewWebhooksService(t)
+			mockService.On("VerifyGitlabToken", "recorded-token").Return(nil)
+			tt.serviceSetup(mockService)
+
+			handler := New(mockService, zap.NewNop().Sugar())
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", bytes.NewReader(fixture(t, "gitlab", tt.fixture)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(HeaderGitlabEvent, tt.event)
+			req.Header.Set(HeaderGitlabToken, "recorded-token")
+			rec := httptest.NewRecorder()
+
+			err := handler.GitlabWebhook(e.NewContext(req, rec))
+
+			if tt.wantErr != nil {
+				var customErr *domain.CustomHttpError
+				if assert.True(t, errors.As(err, &customErr)) {
+					assert.Equal(t, tt.wantErr, customErr)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var resp WebhookResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
+
+func TestRestWebhooks_GitlabWebhook_BadToken(t *testing.T) {
+	e := echo.New()
+	mockService := mocks.NewWebhooksService(t)
+	mockService.On("VerifyGitlabToken", "forged").Return(domain.ErrBadSignature)
+
+	handler := New(mockService, zap.NewNop().Sugar())
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", bytes.NewReader(fixture(t, "gitlab", "merge_request_open.json")))
+	req.Header.Set(HeaderGitlabEvent, "Merge Request Hook")
+	req.Header.Set(HeaderGitlabToken, "forged")
+	rec := httptest.NewRecorder()
+
+	err := handler.GitlabWebhook(e.NewContext(req, rec))
+
+	var customErr *domain.CustomHttpError
+	if assert.True(t, errors.As(err, &customErr)) {
+		assert.Equal(t, domain.HttpErrBadSignature(), customErr)
+	}
+}
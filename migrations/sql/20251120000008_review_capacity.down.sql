@@ -0,0 +1,3 @@
+ALTER TABLE teams DROP COLUMN IF EXISTS default_review_capacity;
+
+ALTER TABLE members DROP COLUMN IF EXISTS review_capacity;
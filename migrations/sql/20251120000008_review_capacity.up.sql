@@ -0,0 +1,7 @@
+ALTER TABLE members
+    ADD COLUMN IF NOT EXISTS review_capacity INT
+        CONSTRAINT chk_members_review_capacity CHECK (review_capacity >= 0);
+
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS default_review_capacity INT
+        CONSTRAINT chk_teams_default_review_capacity CHECK (default_review_capacity >= 0);
@@ -0,0 +1,3 @@
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS required_reviewers INT NOT NULL DEFAULT 2
+        CONSTRAINT chk_teams_required_reviewers CHECK (required_reviewers >= 0);
@@ -0,0 +1,9 @@
+UPDATE pr_members
+SET role_id = (SELECT id FROM roles WHERE role = 'reviewer')
+WHERE role_id = (SELECT id FROM roles WHERE role = 'approver');
+
+ALTER TABLE pr_members
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS review_state_id;
+
+DROP TABLE IF EXISTS review_states;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS review_states (
+    id SERIAL PRIMARY KEY,
+    state VARCHAR(20) NOT NULL UNIQUE
+);
+
+INSERT INTO review_states (state) VALUES ('APPROVED'), ('CHANGES_REQUESTED'), ('COMMENTED')
+ON CONFLICT (state) DO NOTHING;
+
+ALTER TABLE pr_members
+    ADD COLUMN IF NOT EXISTS review_state_id INT
+        CONSTRAINT fk_pr_members_review_state REFERENCES review_states(id) ON DELETE RESTRICT,
+    ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMPTZ;
@@ -0,0 +1,4 @@
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS merge_require_active_reviewers,
+    DROP COLUMN IF EXISTS merge_block_on_changes_requested,
+    DROP COLUMN IF EXISTS merge_min_approvals;
@@ -0,0 +1,5 @@
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS merge_min_approvals INT
+        CONSTRAINT chk_teams_merge_min_approvals CHECK (merge_min_approvals >= 0),
+    ADD COLUMN IF NOT EXISTS merge_block_on_changes_requested BOOLEAN,
+    ADD COLUMN IF NOT EXISTS merge_require_active_reviewers BOOLEAN;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_pull_requests_team_id;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS team_id;
+
+DROP INDEX IF EXISTS uq_members_teams_primary;
+
+ALTER TABLE members_teams DROP COLUMN IF EXISTS is_primary;
@@ -0,0 +1,25 @@
+ALTER TABLE members_teams
+    ADD COLUMN IF NOT EXISTS is_primary BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE members_teams mt
+SET is_primary = true
+WHERE mt.team_id = (
+    SELECT MIN(mt2.team_id)
+    FROM members_teams mt2
+    WHERE mt2.member_id = mt.member_id
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_members_teams_primary
+    ON members_teams(member_id) WHERE is_primary;
+
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS team_id INT
+        CONSTRAINT fk_pull_requests_team REFERENCES teams(id) ON DELETE SET NULL;
+
+UPDATE pull_requests pr
+SET team_id = mt.team_id
+FROM members_teams mt
+WHERE mt.member_id = pr.author_id
+  AND mt.is_primary;
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_team_id ON pull_requests(team_id);
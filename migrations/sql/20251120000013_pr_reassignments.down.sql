@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pr_members_assigned_at;
+
+DROP TABLE IF EXISTS pr_reassignments;
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS pr_reassignments (
+    id SERIAL PRIMARY KEY,
+    pr_id INT NOT NULL,
+    old_member_id INT NOT NULL,
+    new_member_id INT NOT NULL,
+    reassigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_pr_reassignments_pr
+        FOREIGN KEY (pr_id) REFERENCES pull_requests(id) ON DELETE CASCADE,
+    CONSTRAINT fk_pr_reassignments_old_member
+        FOREIGN KEY (old_member_id) REFERENCES members(id) ON DELETE CASCADE,
+    CONSTRAINT fk_pr_reassignments_new_member
+        FOREIGN KEY (new_member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_pr_reassignments_pr_id ON pr_reassignments(pr_id);
+
+CREATE INDEX IF NOT EXISTS idx_pr_reassignments_old_member_id ON pr_reassignments(old_member_id);
+
+CREATE INDEX IF NOT EXISTS idx_pr_reassignments_reassigned_at ON pr_reassignments(reassigned_at);
+
+INSERT INTO pr_reassignments (pr_id, old_member_id, new_member_id, reassigned_at)
+SELECT e.pr_id, e.old_member_id, e.new_member_id, e.created_at
+FROM pr_assignment_events e
+WHERE e.event_type = 'reassigned'
+  AND e.old_member_id IS NOT NULL
+  AND e.new_member_id IS NOT NULL;
+
+DROP TABLE IF EXISTS pr_assignment_events;
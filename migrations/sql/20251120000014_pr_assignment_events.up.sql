@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS pr_assignment_events (
+    id BIGSERIAL PRIMARY KEY,
+    pr_id INT NOT NULL,
+    event_type VARCHAR(20) NOT NULL,
+    old_member_id INT,
+    new_member_id INT,
+    actor VARCHAR(255) NOT NULL DEFAULT 'system',
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_pr_assignment_events_pr
+        FOREIGN KEY (pr_id) REFERENCES pull_requests(id) ON DELETE CASCADE,
+    CONSTRAINT fk_pr_assignment_events_old_member
+        FOREIGN KEY (old_member_id) REFERENCES members(id) ON DELETE SET NULL,
+    CONSTRAINT fk_pr_assignment_events_new_member
+        FOREIGN KEY (new_member_id) REFERENCES members(id) ON DELETE SET NULL,
+    CONSTRAINT chk_pr_assignment_events_type
+        CHECK (event_type IN ('assigned', 'unassigned', 'reassigned'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_pr_assignment_events_pr_id ON pr_assignment_events(pr_id);
+
+CREATE INDEX IF NOT EXISTS idx_pr_assignment_events_old_member_id ON pr_assignment_events(old_member_id);
+
+CREATE INDEX IF NOT EXISTS idx_pr_assignment_events_created_at ON pr_assignment_events(created_at);
+
+INSERT INTO pr_assignment_events (pr_id, event_type, old_member_id, new_member_id, reason, created_at)
+SELECT ra.pr_id, 'reassigned', ra.old_member_id, ra.new_member_id, 'backfill', ra.reassigned_at
+FROM pr_reassignments ra;
+
+INSERT INTO pr_assignment_events (pr_id, event_type, new_member_id, reason, created_at)
+SELECT pm.pr_id, 'assigned', pm.member_id, 'backfill', pm.assigned_at
+FROM pr_members pm
+INNER JOIN roles r ON pm.role_id = r.id
+WHERE r.role IN ('reviewer', 'approver')
+  AND NOT EXISTS (
+    SELECT 1
+    FROM pr_reassignments ra
+    WHERE ra.pr_id = pm.pr_id
+      AND ra.new_member_id = pm.member_id
+  );
+
+DROP TABLE IF EXISTS pr_reassignments;
@@ -0,0 +1,5 @@
+UPDATE pull_requests
+SET status_id = (SELECT id FROM statuses WHERE status = 'OPEN')
+WHERE status_id IN (SELECT id FROM statuses WHERE status IN ('DRAFT', 'CLOSED'));
+
+DELETE FROM statuses WHERE status IN ('DRAFT', 'CLOSED');
@@ -0,0 +1,2 @@
+INSERT INTO statuses (status) VALUES ('DRAFT'), ('CLOSED')
+ON CONFLICT (status) DO NOTHING;
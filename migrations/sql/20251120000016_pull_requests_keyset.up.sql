@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_pull_requests_created_at_uuid ON pull_requests(created_at DESC, uuid DESC);
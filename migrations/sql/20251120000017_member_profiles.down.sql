@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_members_name_uuid;
+
+ALTER TABLE members
+    DROP COLUMN IF EXISTS title,
+    DROP COLUMN IF EXISTS email;
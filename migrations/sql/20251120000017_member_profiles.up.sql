@@ -0,0 +1,5 @@
+ALTER TABLE members
+    ADD COLUMN IF NOT EXISTS email VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS title VARCHAR(100);
+
+CREATE INDEX IF NOT EXISTS idx_members_name_uuid ON members(name, uuid);
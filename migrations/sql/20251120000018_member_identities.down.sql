@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_member_identities_member_id;
+DROP INDEX IF EXISTS idx_member_identities_provider_external_id;
+
+DROP TABLE IF EXISTS member_identities;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS member_identities (
+    id SERIAL PRIMARY KEY,
+    member_id INTEGER NOT NULL REFERENCES members(id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    external_id VARCHAR(255) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_member_identities_provider_external_id
+    ON member_identities(provider, lower(external_id));
+
+CREATE INDEX IF NOT EXISTS idx_member_identities_member_id ON member_identities(member_id);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_pull_requests_external_key;
+
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS chk_pull_requests_external_key;
+
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS external_number,
+    DROP COLUMN IF EXISTS external_repository,
+    DROP COLUMN IF EXISTS external_provider;
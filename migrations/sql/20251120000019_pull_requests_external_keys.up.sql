@@ -0,0 +1,15 @@
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS external_provider VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS external_repository VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS external_number INT;
+
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS chk_pull_requests_external_key;
+ALTER TABLE pull_requests
+    ADD CONSTRAINT chk_pull_requests_external_key CHECK (
+        (external_provider IS NULL AND external_repository IS NULL AND external_number IS NULL)
+        OR (external_provider IS NOT NULL AND external_repository IS NOT NULL AND external_number > 0)
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pull_requests_external_key
+    ON pull_requests(external_provider, lower(external_repository), external_number)
+    WHERE external_provider IS NOT NULL;
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id SERIAL PRIMARY KEY,
+    uuid UUID NOT NULL UNIQUE,
+    url TEXT NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    events VARCHAR(50)[] NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    uuid UUID NOT NULL UNIQUE,
+    subscription_id INT NOT NULL,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSON NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    response_code INT,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_webhook_deliveries_subscription
+        FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    CONSTRAINT chk_webhook_deliveries_status
+        CHECK (status IN ('pending', 'succeeded', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id
+    ON webhook_deliveries(subscription_id, created_at DESC);
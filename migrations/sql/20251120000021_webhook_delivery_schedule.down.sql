@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_pending;
+
+ALTER TABLE webhook_deliveries
+    DROP COLUMN IF EXISTS next_attempt_at;
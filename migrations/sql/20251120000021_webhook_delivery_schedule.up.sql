@@ -0,0 +1,6 @@
+ALTER TABLE webhook_deliveries
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pending
+    ON webhook_deliveries(next_attempt_at)
+    WHERE status = 'pending';
@@ -0,0 +1,71 @@
+package netguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"syscall"
+)
+
+var ErrForbiddenAddress = errors.New("netguard: address is not public")
+
+// sharedAddressSpace is the carrier-grade NAT range, not covered by netip.Addr.IsPrivate.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublic rejects loopback, private, link-local, multicast and unspecified addresses.
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// CheckURL resolves the host of the url and requires every address of it to be public.
+func CheckURL(ctx context.Context, r *net.Resolver, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := u.Hostname()
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return check(addr)
+	}
+
+	addrs, err := r.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if err := check(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Control is a net.Dialer control refusing connections to non public addresses,
+// it checks the address actually dialed, so a host resolving differently later is caught too.
+func Control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	return check(addrPort.Addr())
+}
+
+func check(addr netip.Addr) error {
+	if !IsPublic(addr) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+	return nil
+}
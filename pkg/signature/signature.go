@@ -0,0 +1,41 @@
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+const prefixSHA256 = "sha256="
+
+// SignSHA256 returns the HMAC-SHA256 of the body in the `sha256=<hex>` form used by webhook headers.
+func SignSHA256(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return prefixSHA256 + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySHA256 compares the header with the body signature in constant time, an empty secret matches nothing.
+func VerifySHA256(secret, body []byte, header string) bool {
+	if len(secret) == 0 || !strings.HasPrefix(header, prefixSHA256) {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(header, prefixSHA256))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// EqualToken compares a shared secret token in constant time, an empty secret matches nothing.
+func EqualToken(secret, token string) bool {
+	if secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
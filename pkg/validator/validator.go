@@ -6,6 +6,7 @@ import (
 	"regexp"
 
 	"github.com/go-playground/validator"
+	"github.com/google/uuid"
 )
 
 func init() {
@@ -35,11 +36,16 @@ var mapValidationErrors = map[string]string{
 	"gte":      ErrFieldBelowMinVal,
 	"uuid":     "Invalid UUID format",
 	"oneof":    "Invalid value, must be one of allowed options",
+
+	"member_ref": "Invalid user reference, must be a UUID or provider:external_id",
+	"pr_ref":     "Invalid pull request reference, must be a UUID or provider:repository#number",
 }
 
 func New() *validator.Validate {
 	v := validator.New()
 	_ = v.RegisterValidation("tag", validateTag)
+	_ = v.RegisterValidation("member_ref", validateMemberRef)
+	_ = v.RegisterValidation("pr_ref", validatePrRef)
 
 	return v
 }
@@ -57,6 +63,22 @@ func validateTag(fl validator.FieldLevel) bool {
 	return re.MatchString(fl.Field().String())
 }
 
+var memberIdentityRef = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}:.*\S`)
+
+// validateMemberRef accepts a member UUID or an external identity as provider:external_id.
+func validateMemberRef(fl validator.FieldLevel) bool {
+	ref := fl.Field().String()
+	return uuid.Validate(ref) == nil || memberIdentityRef.MatchString(ref)
+}
+
+var prExternalRef = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,49}:.*\S.*#[0-9]+$`)
+
+// validatePrRef accepts a pull request UUID or its external key as provider:repository#number.
+func validatePrRef(fl validator.FieldLevel) bool {
+	ref := fl.Field().String()
+	return uuid.Validate(ref) == nil || prExternalRef.MatchString(ref)
+}
+
 func Validate(ctx context.Context, structure any) error {
 	return parseValidationErrors(Validator().StructCtx(ctx, structure))
 }
@@ -2,9 +2,17 @@ package e2e
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/eragon-mdi/pr-reviewer-service/pkg/signature"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -507,3 +515,2203 @@ func TestPullRequests_Merge_Idempotent(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "MERGED", result.PR.Status)
 }
+
+// ============================================================================
+// Review Capacity Tests
+// ============================================================================
+
+// TestReviewCapacity_AllSaturated проверяет ошибку NO_CAPACITY, когда все кандидаты на пределе
+func TestReviewCapacity_AllSaturated(t *testing.T) {
+	// Подготовка: создаем команду с автором и одним ревьювером
+	teamName := "e2e-team-capacity-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+	reviewerID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	// Запрос 1: POST /teams/setReviewCapacity (лимит команды 1)
+	one := 1
+	resp2, err := SetTeamReviewCapacity(SetTeamReviewCapacityRequest{TeamName: teamName, DefaultReviewCapacity: &one})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+
+	// Запрос 2: первый PR занимает единственный слот ревьювера
+	resp3, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   uuid.New().String(),
+		PullRequestName: "First PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	resp3.Body.Close()
+	require.Equal(t, http.StatusCreated, resp3.StatusCode)
+
+	// Запрос 3: лимит виден в GET /users/getReview/:id
+	resp4, err := GetUserReviews(reviewerID)
+	require.NoError(t, err)
+	var reviews UserReviewsResponse
+	require.NoError(t, ParseJSONResponse(resp4, &reviews))
+	resp4.Body.Close()
+	require.NotNil(t, reviews.ReviewCapacity)
+	assert.Equal(t, 1, *reviews.ReviewCapacity)
+	assert.Equal(t, 1, reviews.OpenReviews)
+
+	// Запрос 4: второй PR - все кандидаты на пределе
+	resp5, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   uuid.New().String(),
+		PullRequestName: "Second PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	defer resp5.Body.Close()
+
+	// Проверка: статус 409 и код NO_CAPACITY
+	assert.Equal(t, http.StatusConflict, resp5.StatusCode)
+	errResp, err := ParseErrorResponse(resp5)
+	require.NoError(t, err)
+	assert.Equal(t, "NO_CAPACITY", errResp.Error.Code)
+}
+
+// ============================================================================
+// Required Reviewers Tests
+// ============================================================================
+
+// TestRequiredReviewers_PerTeam проверяет, что число назначаемых ревьюверов берется из настроек команды
+func TestRequiredReviewers_PerTeam(t *testing.T) {
+	// Подготовка: создаем команду с автором и тремя ревьюверами
+	teamName := "e2e-team-required-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: uuid.New().String(), Username: "Reviewer1", IsActive: true},
+			{UserID: uuid.New().String(), Username: "Reviewer2", IsActive: true},
+			{UserID: uuid.New().String(), Username: "Reviewer3", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	// Запрос 1: POST /teams/setRequiredReviewers (один ревьювер на PR)
+	one := 1
+	resp2, err := SetTeamRequiredReviewers(SetTeamRequiredReviewersRequest{TeamName: teamName, RequiredReviewers: &one})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+
+	// Запрос 2: создаем PR
+	resp3, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   uuid.New().String(),
+		PullRequestName: "Single reviewer PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	defer resp3.Body.Close()
+	require.Equal(t, http.StatusCreated, resp3.StatusCode)
+
+	// Проверка: назначен ровно один ревьювер
+	var result CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp3, &result))
+	assert.Len(t, result.PR.AssignedReviewers, 1)
+}
+
+// ============================================================================
+// Review Tests
+// ============================================================================
+
+// TestPullRequests_Review_Approve проверяет отправку вердикта и его отображение в PR
+func TestPullRequests_Review_Approve(t *testing.T) {
+	// Подготовка: команда из автора и одного ревьювера, PR с назначенным ревьювером
+	teamName := "e2e-team-review-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+	reviewerID := uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Review PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp2, &created))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+	require.Len(t, created.PR.Reviews, 1)
+	assert.Equal(t, "PENDING", created.PR.Reviews[0].State)
+
+	// Запрос 1: автор не назначен ревьювером - NOT_ASSIGNED
+	resp3, err := ReviewPullRequest(ReviewPullRequestRequest{PullRequestID: prID, UserID: authorID, State: "APPROVED"})
+	require.NoError(t, err)
+	errResp, err := ParseErrorResponse(resp3)
+	resp3.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, resp3.StatusCode)
+	assert.Equal(t, "NOT_ASSIGNED", errResp.Error.Code)
+
+	// Запрос 2: ревьювер одобряет PR
+	resp4, err := ReviewPullRequest(ReviewPullRequestRequest{PullRequestID: prID, UserID: reviewerID, State: "APPROVED"})
+	require.NoError(t, err)
+	defer resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+
+	// Проверка: вердикт и время ревью возвращаются в PR, ревьювер остается назначенным
+	var reviewed CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp4, &reviewed))
+	assert.Equal(t, []string{reviewerID}, reviewed.PR.AssignedReviewers)
+	require.Len(t, reviewed.PR.Reviews, 1)
+	assert.Equal(t, reviewerID, reviewed.PR.Reviews[0].UserID)
+	assert.Equal(t, "APPROVED", reviewed.PR.Reviews[0].State)
+	assert.NotNil(t, reviewed.PR.Reviews[0].ReviewedAt)
+}
+
+// ============================================================================
+// Merge Policy Tests
+// ============================================================================
+
+// TestMergePolicy_BlockedUntilApproved проверяет MERGE_BLOCKED до одобрения и мерж после него
+func TestMergePolicy_BlockedUntilApproved(t *testing.T) {
+	// Подготовка: команда из автора и одного ревьювера, политика требует одно одобрение
+	teamName := "e2e-team-merge-policy-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+	reviewerID := uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	one := 1
+	resp2, err := SetTeamMergePolicy(SetTeamMergePolicyRequest{TeamName: teamName, MinApprovals: &one})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+
+	resp3, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Gated PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	resp3.Body.Close()
+	require.Equal(t, http.StatusCreated, resp3.StatusCode)
+
+	// Запрос 1: мерж без одобрения - MERGE_BLOCKED с перечнем условий
+	resp4, err := MergePullRequest(MergePullRequestRequest{PullRequestID: prID})
+	require.NoError(t, err)
+	errResp, err := ParseErrorResponse(resp4)
+	resp4.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, resp4.StatusCode)
+	assert.Equal(t, "MERGE_BLOCKED", errResp.Error.Code)
+	assert.Equal(t, []string{"approvals: 0 of 1 required"}, errResp.Error.Details)
+
+	// Запрос 2: ревьювер одобряет PR
+	resp5, err := ReviewPullRequest(ReviewPullRequestRequest{PullRequestID: prID, UserID: reviewerID, State: "APPROVED"})
+	require.NoError(t, err)
+	resp5.Body.Close()
+	require.Equal(t, http.StatusOK, resp5.StatusCode)
+
+	// Запрос 3 и 4: мерж проходит, повторный мерж идемпотентен
+	for range 2 {
+		resp, err := MergePullRequest(MergePullRequestRequest{PullRequestID: prID})
+		require.NoError(t, err)
+		var result MergePullRequestResponse
+		require.NoError(t, ParseJSONResponse(resp, &result))
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "MERGED", result.PR.Status)
+	}
+}
+
+// ============================================================================
+// Reassign Concurrency Tests
+// ============================================================================
+
+// TestPullRequests_Reassign_Concurrent проверяет, что параллельные переназначения одного ревьювера дают ровно одну замену
+func TestPullRequests_Reassign_Concurrent(t *testing.T) {
+	// Подготовка: команда из автора и шести ревьюверов, PR с двумя назначенными ревьюверами
+	teamName := "e2e-team-reassign-concurrent-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+	prID := uuid.New().String()
+
+	members := []TeamMember{{UserID: authorID, Username: "Author", IsActive: true}}
+	for range 6 {
+		members = append(members, TeamMember{UserID: uuid.New().String(), Username: "Reviewer", IsActive: true})
+	}
+
+	resp1, err := AddTeam(AddTeamRequest{TeamName: teamName, Members: members})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Concurrent reassign PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp2, &created))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+	require.NotEmpty(t, created.PR.AssignedReviewers)
+	oldReviewerID := created.PR.AssignedReviewers[0]
+
+	// Запрос: параллельные переназначения одного и того же ревьювера
+	const parallel = 8
+	var wg sync.WaitGroup
+	statuses := make([]int, parallel)
+	results := make([]ReassignUserForPullRequestResponse, parallel)
+	for i := range parallel {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, err := ReassignUserForPullRequest(ReassignUserForPullRequestRequest{
+				PullRequestID: prID,
+				OldUserID:     oldReviewerID,
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer resp.Body.Close()
+
+			statuses[i] = resp.StatusCode
+			if resp.StatusCode == http.StatusOK {
+				assert.NoError(t, ParseJSONResponse(resp, &results[i]))
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Проверка: ровно одна успешная замена, остальные получают конфликт
+	succeeded := -1
+	for i, status := range statuses {
+		if status == http.StatusOK {
+			assert.Equal(t, -1, succeeded, "more than one reassign succeeded")
+			succeeded = i
+			continue
+		}
+		assert.Equal(t, http.StatusConflict, status)
+	}
+	require.NotEqual(t, -1, succeeded, "no reassign succeeded")
+
+	reviewers := results[succeeded].PR.AssignedReviewers
+	assert.Len(t, reviewers, len(created.PR.AssignedReviewers))
+	assert.NotContains(t, reviewers, oldReviewerID)
+}
+
+// TestPullRequests_Reassign_Rejected проверяет NOT_ASSIGNED и PR_MERGED при переназначении
+func TestPullRequests_Reassign_Rejected(t *testing.T) {
+	// Подготовка: команда из автора и двух ревьюверов, PR с назначенными ревьюверами
+	teamName := "e2e-team-reassign-rejected-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: uuid.New().String(), Username: "Reviewer1", IsActive: true},
+			{UserID: uuid.New().String(), Username: "Reviewer2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Rejected reassign PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp2, &created))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+	require.NotEmpty(t, created.PR.AssignedReviewers)
+
+	// Запрос: переназначение автора, который не является ревьювером
+	resp3, err := ReassignUserForPullRequest(ReassignUserForPullRequestRequest{
+		PullRequestID: prID,
+		OldUserID:     authorID,
+	})
+	require.NoError(t, err)
+	errResp, err := ParseErrorResponse(resp3)
+	resp3.Body.Close()
+	require.NoError(t, err)
+
+	// Проверка: NOT_ASSIGNED
+	assert.Equal(t, http.StatusConflict, resp3.StatusCode)
+	assert.Equal(t, "NOT_ASSIGNED", errResp.Error.Code)
+
+	// Запрос: переназначение после мержа
+	resp4, err := MergePullRequest(MergePullRequestRequest{PullRequestID: prID})
+	require.NoError(t, err)
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+
+	resp5, err := ReassignUserForPullRequest(ReassignUserForPullRequestRequest{
+		PullRequestID: prID,
+		OldUserID:     created.PR.AssignedReviewers[0],
+	})
+	require.NoError(t, err)
+	errResp, err = ParseErrorResponse(resp5)
+	resp5.Body.Close()
+	require.NoError(t, err)
+
+	// Проверка: PR_MERGED
+	assert.Equal(t, http.StatusConflict, resp5.StatusCode)
+	assert.Equal(t, "PR_MERGED", errResp.Error.Code)
+}
+
+// TestPullRequests_History проверяет журнал назначений PR: создание и ручное переназначение
+func TestPullRequests_History(t *testing.T) {
+	// Подготовка: команда из автора и трех ревьюверов, чтобы для замены оставался свободный кандидат
+	teamName := "e2e-team-history-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: uuid.New().String(), Username: "Reviewer1", IsActive: true},
+			{UserID: uuid.New().String(), Username: "Reviewer2", IsActive: true},
+			{UserID: uuid.New().String(), Username: "Reviewer3", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "History PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp2, &created))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+	require.Len(t, created.PR.AssignedReviewers, 2)
+
+	oldReviewer := created.PR.AssignedReviewers[0]
+	resp3, err := ReassignUserForPullRequest(ReassignUserForPullRequestRequest{
+		PullRequestID: prID,
+		OldUserID:     oldReviewer,
+		Actor:         "lead",
+		Reason:        "vacation",
+	})
+	require.NoError(t, err)
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+
+	// Запрос: журнал назначений
+	resp4, err := GetPullRequestHistory(prID)
+	require.NoError(t, err)
+	var history PullRequestHistoryResponse
+	require.NoError(t, ParseJSONResponse(resp4, &history))
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+
+	// Проверка: два назначения при создании и одна замена с указанными автором и причиной
+	assert.Equal(t, prID, history.PullRequestID)
+	require.Len(t, history.Events, 3)
+	for _, e := range history.Events[:2] {
+		assert.Equal(t, "assigned", e.Type)
+		assert.Equal(t, "system", e.Actor)
+		assert.Equal(t, "pr_created", e.Reason)
+		assert.Contains(t, created.PR.AssignedReviewers, e.NewReviewerID)
+	}
+	reassigned := history.Events[2]
+	assert.Equal(t, "reassigned", reassigned.Type)
+	assert.Equal(t, oldReviewer, reassigned.OldReviewerID)
+	assert.NotEmpty(t, reassigned.NewReviewerID)
+	assert.Equal(t, "lead", reassigned.Actor)
+	assert.Equal(t, "vacation", reassigned.Reason)
+
+	// Проверка: неизвестный PR
+	resp5, err := GetPullRequestHistory(uuid.New().String())
+	require.NoError(t, err)
+	resp5.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp5.StatusCode)
+}
+
+// TestPullRequests_Lifecycle проверяет переходы DRAFT -> OPEN -> CLOSED -> OPEN и журнал назначений
+func TestPullRequests_Lifecycle(t *testing.T) {
+	// Подготовка: команда из автора и двух ревьюверов, PR создается черновиком
+	teamName := "e2e-team-lifecycle-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+	reviewerID := uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer1", IsActive: true},
+			{UserID: uuid.New().String(), Username: "Reviewer2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Lifecycle PR",
+		AuthorID:        authorID,
+		Draft:           true,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp2, &created))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	// Проверка: черновик без ревьюверов
+	assert.Equal(t, "DRAFT", created.PR.Status)
+	assert.Empty(t, created.PR.AssignedReviewers)
+
+	// Запрос: мерж черновика запрещен
+	resp3, err := MergePullRequest(MergePullRequestRequest{PullRequestID: prID})
+	require.NoError(t, err)
+	errResp, err := ParseErrorResponse(resp3)
+	resp3.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, resp3.StatusCode)
+	assert.Equal(t, "PR_INVALID_STATE", errResp.Error.Code)
+
+	// Запрос: черновик готов к ревью
+	resp4, err := ReadyPullRequest(PullRequestStatusRequest{PullRequestID: prID})
+	require.NoError(t, err)
+	var ready CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp4, &ready))
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+	assert.Equal(t, "OPEN", ready.PR.Status)
+	assert.Len(t, ready.PR.AssignedReviewers, 2)
+
+	// Запрос: закрытие освобождает ревьюверов
+	resp5, err := ClosePullRequest(PullRequestStatusRequest{PullRequestID: prID, Actor: "author", Reason: "abandoned"})
+	require.NoError(t, err)
+	var closed CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp5, &closed))
+	resp5.Body.Close()
+	require.Equal(t, http.StatusOK, resp5.StatusCode)
+	assert.Equal(t, "CLOSED", closed.PR.Status)
+	assert.Empty(t, closed.PR.AssignedReviewers)
+
+	// Запрос: повторное открытие назначает ревьюверов заново
+	resp6, err := ReopenPullRequest(PullRequestStatusRequest{PullRequestID: prID})
+	require.NoError(t, err)
+	var reopened CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp6, &reopened))
+	resp6.Body.Close()
+	require.Equal(t, http.StatusOK, resp6.StatusCode)
+	assert.Equal(t, "OPEN", reopened.PR.Status)
+	assert.Len(t, reopened.PR.AssignedReviewers, 2)
+
+	// Проверка: журнал назначений
+	resp7, err := GetPullRequestHistory(prID)
+	require.NoError(t, err)
+	var history PullRequestHistoryResponse
+	require.NoError(t, ParseJSONResponse(resp7, &history))
+	resp7.Body.Close()
+	require.Len(t, history.Events, 6)
+	reasons := make([]string, 0, len(history.Events))
+	for _, e := range history.Events {
+		reasons = append(reasons, e.Type+"/"+e.Reason)
+	}
+	assert.Equal(t, []string{
+		"assigned/pr_ready", "assigned/pr_ready",
+		"unassigned/abandoned", "unassigned/abandoned",
+		"assigned/pr_reopened", "assigned/pr_reopened",
+	}, reasons)
+
+	// Проверка: фильтр ревью пользователя по статусу PR
+	resp8, err := GetUserReviewsByStatus(reviewerID, "OPEN")
+	require.NoError(t, err)
+	var openReviews UserReviewsResponse
+	require.NoError(t, ParseJSONResponse(resp8, &openReviews))
+	resp8.Body.Close()
+	require.Equal(t, http.StatusOK, resp8.StatusCode)
+	require.Len(t, openReviews.PullRequests, 1)
+	assert.Equal(t, prID, openReviews.PullRequests[0].PullRequestID)
+
+	resp9, err := GetUserReviewsByStatus(reviewerID, "MERGED")
+	require.NoError(t, err)
+	var mergedReviews UserReviewsResponse
+	require.NoError(t, ParseJSONResponse(resp9, &mergedReviews))
+	resp9.Body.Close()
+	assert.Empty(t, mergedReviews.PullRequests)
+	assert.Equal(t, 1, mergedReviews.OpenReviews)
+}
+
+// TestPullRequests_ListAndGet проверяет получение PR по id, фильтры списка PR и постраничный обход по курсору
+func TestPullRequests_ListAndGet(t *testing.T) {
+	// Подготовка: команда и три PR одного автора, один из них черновик
+	teamName := "e2e-team-list-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+	reviewerID := uuid.New().String()
+	prIDs := []string{uuid.New().String(), uuid.New().String(), uuid.New().String()}
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	for i, prID := range prIDs {
+		resp, err := CreatePullRequest(CreatePullRequestRequest{
+			PullRequestID:   prID,
+			PullRequestName: fmt.Sprintf("List PR %d", i),
+			AuthorID:        authorID,
+			Draft:           i == 0,
+		})
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	// Запрос: PR по id
+	resp2, err := GetPullRequest(prIDs[1])
+	require.NoError(t, err)
+	var got CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp2, &got))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+	assert.Equal(t, prIDs[1], got.PR.PullRequestID)
+	assert.Equal(t, []string{reviewerID}, got.PR.AssignedReviewers)
+
+	resp3, err := GetPullRequest(uuid.New().String())
+	require.NoError(t, err)
+	resp3.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp3.StatusCode)
+
+	// Запрос: обход открытых PR команды по одному на страницу, новые первыми
+	query := url.Values{"team_name": {teamName}, "status": {"OPEN"}, "limit": {"1"}}
+	seen := make([]string, 0, 2)
+	for {
+		resp, err := ListPullRequests(query)
+		require.NoError(t, err)
+		var page ListPullRequestsResponse
+		require.NoError(t, ParseJSONResponse(resp, &page))
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, page.PullRequests, 1)
+
+		seen = append(seen, page.PullRequests[0].PullRequestID)
+		if page.NextCursor == "" {
+			break
+		}
+		query.Set("cursor", page.NextCursor)
+	}
+	assert.Equal(t, []string{prIDs[2], prIDs[1]}, seen)
+
+	// Запрос: фильтр по ревьюверу и автору
+	resp4, err := ListPullRequests(url.Values{"reviewer_id": {reviewerID}, "author_id": {authorID}})
+	require.NoError(t, err)
+	var byReviewer ListPullRequestsResponse
+	require.NoError(t, ParseJSONResponse(resp4, &byReviewer))
+	resp4.Body.Close()
+	assert.Len(t, byReviewer.PullRequests, 2)
+	assert.Empty(t, byReviewer.NextCursor)
+
+	// Запрос: ревью пользователя постранично, open_reviews считает все открытые
+	resp5, err := GetUserReviewsPage(reviewerID, url.Values{"limit": {"1"}})
+	require.NoError(t, err)
+	var reviews UserReviewsResponse
+	require.NoError(t, ParseJSONResponse(resp5, &reviews))
+	resp5.Body.Close()
+	require.Len(t, reviews.PullRequests, 1)
+	assert.Equal(t, prIDs[2], reviews.PullRequests[0].PullRequestID)
+	assert.Equal(t, 2, reviews.OpenReviews)
+	assert.NotEmpty(t, reviews.NextCursor)
+
+	// Запрос: некорректные параметры
+	resp6, err := ListPullRequests(url.Values{"limit": {"1000"}})
+	require.NoError(t, err)
+	resp6.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp6.StatusCode)
+}
+
+// TestPullRequests_IfMatch проверяет ETag версии PR и отказ мутаций с устаревшим If-Match
+func TestPullRequests_IfMatch(t *testing.T) {
+	// Подготовка: команда с автором и ревьювером, открытый PR
+	teamName := "e2e-team-etag-" + uuid.New().String()[:8]
+	authorID := uuid.New().String()
+	reviewerID := uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "ETag PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	// Запрос: ETag совпадает с версией в теле ответа
+	resp3, err := GetPullRequest(prID)
+	require.NoError(t, err)
+	var got CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp3, &got))
+	resp3.Body.Close()
+	etag := resp3.Header.Get("ETag")
+	require.Equal(t, fmt.Sprintf("%q", fmt.Sprint(got.PR.Version)), etag)
+
+	// Запрос: ревью меняет версию, старый ETag устаревает
+	resp4, err := ReviewPullRequest(ReviewPullRequestRequest{PullRequestID: prID, UserID: reviewerID, State: "APPROVED"})
+	require.NoError(t, err)
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+	freshETag := resp4.Header.Get("ETag")
+	assert.NotEqual(t, etag, freshETag)
+
+	resp5, err := ClosePullRequestIfMatch(PullRequestStatusRequest{PullRequestID: prID}, etag)
+	require.NoError(t, err)
+	var errResp ErrorResponse
+	require.NoError(t, ParseJSONResponse(resp5, &errResp))
+	resp5.Body.Close()
+	assert.Equal(t, http.StatusPreconditionFailed, resp5.StatusCode)
+	assert.Equal(t, "PR_VERSION_MISMATCH", errResp.Error.Code)
+
+	resp6, err := MergePullRequestIfMatch(MergePullRequestRequest{PullRequestID: prID}, etag)
+	require.NoError(t, err)
+	resp6.Body.Close()
+	assert.Equal(t, http.StatusPreconditionFailed, resp6.StatusCode)
+
+	// Проверка: с актуальным ETag мерж проходит
+	resp7, err := MergePullRequestIfMatch(MergePullRequestRequest{PullRequestID: prID}, freshETag)
+	require.NoError(t, err)
+	var merged MergePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp7, &merged))
+	resp7.Body.Close()
+	require.Equal(t, http.StatusOK, resp7.StatusCode)
+	assert.Equal(t, "MERGED", merged.PR.Status)
+	assert.Equal(t, fmt.Sprintf("%q", fmt.Sprint(merged.PR.Version)), resp7.Header.Get("ETag"))
+}
+
+// TestTeams_MultiTeamMembership проверяет выбор команды PR, замену из команды ревьювера и основную команду пользователя
+func TestTeams_MultiTeamMembership(t *testing.T) {
+	// Подготовка: автор состоит в двух командах, в каждой по одному ревьюверу
+	suffix := uuid.New().String()[:8]
+	firstTeam := "e2e-team-multi-first-" + suffix
+	secondTeam := "e2e-team-multi-second-" + suffix
+	authorID := uuid.New().String()
+	firstReviewerID := uuid.New().String()
+	secondReviewerIDs := []string{uuid.New().String(), uuid.New().String()}
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: firstTeam,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: firstReviewerID, Username: "FirstReviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := AddTeam(AddTeamRequest{
+		TeamName: secondTeam,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: secondReviewerIDs[0], Username: "SecondReviewer1", IsActive: true},
+			{UserID: secondReviewerIDs[1], Username: "SecondReviewer2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	one := 1
+	respSettings, err := SetTeamRequiredReviewers(SetTeamRequiredReviewersRequest{TeamName: secondTeam, RequiredReviewers: &one})
+	require.NoError(t, err)
+	respSettings.Body.Close()
+	require.Equal(t, http.StatusOK, respSettings.StatusCode)
+
+	// Запрос: PR в явно указанной второй команде
+	resp3, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Multi team PR",
+		AuthorID:        authorID,
+		TeamName:        secondTeam,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp3, &created))
+	resp3.Body.Close()
+	require.Equal(t, http.StatusCreated, resp3.StatusCode)
+
+	// Проверка: ревьювер и команда PR из второй команды
+	assert.Equal(t, secondTeam, created.PR.TeamName)
+	require.Len(t, created.PR.AssignedReviewers, 1)
+	oldReviewerID := created.PR.AssignedReviewers[0]
+	assert.Contains(t, secondReviewerIDs, oldReviewerID)
+
+	// Запрос: переназначение — замена из команды заменяемого ревьювера
+	resp4, err := ReassignUserForPullRequest(ReassignUserForPullRequestRequest{
+		PullRequestID: prID,
+		OldUserID:     oldReviewerID,
+	})
+	require.NoError(t, err)
+	var reassigned ReassignUserForPullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp4, &reassigned))
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+
+	require.Len(t, reassigned.PR.AssignedReviewers, 1)
+	assert.Contains(t, secondReviewerIDs, reassigned.PR.AssignedReviewers[0])
+	assert.NotEqual(t, oldReviewerID, reassigned.PR.AssignedReviewers[0])
+
+	// Запрос: PR в команде, где автора нет
+	resp5, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   uuid.New().String(),
+		PullRequestName: "Foreign team PR",
+		AuthorID:        firstReviewerID,
+		TeamName:        secondTeam,
+	})
+	require.NoError(t, err)
+	resp5.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp5.StatusCode)
+
+	// Запрос: смена основной команды автора
+	resp6, err := SetPrimaryTeam(SetPrimaryTeamRequest{UserID: authorID, TeamName: secondTeam})
+	require.NoError(t, err)
+	var user UserResponse
+	require.NoError(t, ParseJSONResponse(resp6, &user))
+	resp6.Body.Close()
+	require.Equal(t, http.StatusOK, resp6.StatusCode)
+
+	// Проверка: все членства возвращаются, основная — вторая команда
+	assert.Equal(t, secondTeam, user.User.TeamName)
+	assert.ElementsMatch(t, []TeamMembership{
+		{TeamName: secondTeam, IsPrimary: true},
+		{TeamName: firstTeam, IsPrimary: false},
+	}, user.User.Teams)
+}
+
+// TestTeams_Deactivate_ReassignsOpenReviews проверяет деактивацию команды с переназначением открытых ревью
+func TestTeams_Deactivate_ReassignsOpenReviews(t *testing.T) {
+	// Подготовка: ревьюверы r1, r2 состоят в отдельной команде и в команде автора вместе с r3
+	suffix := uuid.New().String()[:8]
+	reviewersTeam := "e2e-team-deactivate-reviewers-" + suffix
+	authorTeam := "e2e-team-deactivate-authors-" + suffix
+	authorID := uuid.New().String()
+	r1, r2, r3 := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: reviewersTeam,
+		Members: []TeamMember{
+			{UserID: r1, Username: "Reviewer1", IsActive: true},
+			{UserID: r2, Username: "Reviewer2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := AddTeam(AddTeamRequest{
+		TeamName: authorTeam,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: r1, Username: "Reviewer1", IsActive: true},
+			{UserID: r2, Username: "Reviewer2", IsActive: true},
+			{UserID: r3, Username: "Reviewer3", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	// r3 временно без свободных слотов, чтобы на PR назначились r1 и r2
+	zero := 0
+	resp3, err := SetReviewCapacity(SetReviewCapacityRequest{UserID: r3, ReviewCapacity: &zero})
+	require.NoError(t, err)
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+
+	resp4, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Deactivation PR",
+		AuthorID:        authorID,
+		TeamName:        authorTeam,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp4, &created))
+	resp4.Body.Close()
+	require.Equal(t, http.StatusCreated, resp4.StatusCode)
+	require.ElementsMatch(t, []string{r1, r2}, created.PR.AssignedReviewers)
+
+	resp5, err := SetReviewCapacity(SetReviewCapacityRequest{UserID: r3})
+	require.NoError(t, err)
+	resp5.Body.Close()
+	require.Equal(t, http.StatusOK, resp5.StatusCode)
+
+	// Запрос: POST /teams/deactivate
+	resp6, err := DeactivateTeam(DeactivateTeamRequest{TeamName: reviewersTeam})
+	require.NoError(t, err)
+	var report DeactivationReport
+	require.NoError(t, ParseJSONResponse(resp6, &report))
+	resp6.Body.Close()
+	require.Equal(t, http.StatusOK, resp6.StatusCode)
+
+	// Проверка: оба ревьювера деактивированы, одного заменил r3, второго заменить некем
+	assert.ElementsMatch(t, []string{r1, r2}, report.DeactivatedUsers)
+	require.Len(t, report.Reassigned, 1)
+	assert.Equal(t, prID, report.Reassigned[0].PullRequestID)
+	assert.Equal(t, r3, report.Reassigned[0].NewReviewerID)
+	require.Len(t, report.Unfilled, 1)
+	assert.Equal(t, prID, report.Unfilled[0].PullRequestID)
+
+	resp7, err := GetUserReviews(r3)
+	require.NoError(t, err)
+	var reviews UserReviewsResponse
+	require.NoError(t, ParseJSONResponse(resp7, &reviews))
+	resp7.Body.Close()
+	assert.Equal(t, 1, reviews.OpenReviews)
+
+	// Запрос: несуществующая команда
+	resp8, err := DeactivateTeam(DeactivateTeamRequest{TeamName: "e2e-team-missing-" + suffix})
+	require.NoError(t, err)
+	resp8.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp8.StatusCode)
+}
+
+// TestTeams_Deactivate_SkipsInactiveMembers проверяет, что уже неактивные участники не попадают в отчёт и события деактивации
+func TestTeams_Deactivate_SkipsInactiveMembers(t *testing.T) {
+	// Подготовка: подписчик на member.deactivated, в команде активный r1 и уже неактивный r2
+	var (
+		mu       sync.Mutex
+		received []SubscriptionEvent
+	)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event SubscriptionEvent
+		if json.NewDecoder(r.Body).Decode(&event) == nil {
+			mu.Lock()
+			received = append(received, event)
+			mu.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	deactivatedOf := func(userID string) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, e := range received {
+			if e.Type == "member.deactivated" && e.Data.UserID == userID {
+				return true
+			}
+		}
+		return false
+	}
+
+	resp1, err := CreateSubscription(CreateSubscriptionRequest{URL: receiver.URL, Events: []string{"member.deactivated"}})
+	require.NoError(t, err)
+	var created CreateSubscriptionResponse
+	require.NoError(t, ParseJSONResponse(resp1, &created))
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-team-deactivate-inactive-" + suffix
+	r1, r2 := uuid.New().String(), uuid.New().String()
+
+	resp2, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: r1, Username: "Active", IsActive: true},
+			{UserID: r2, Username: "Inactive", IsActive: false},
+		},
+	})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	// Запрос: POST /teams/deactivate
+	resp3, err := DeactivateTeam(DeactivateTeamRequest{TeamName: teamName})
+	require.NoError(t, err)
+	var report DeactivationReport
+	require.NoError(t, ParseJSONResponse(resp3, &report))
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+
+	// Проверка: деактивирован и анонсирован только r1
+	assert.Equal(t, []string{r1}, report.DeactivatedUsers)
+	require.Eventually(t, func() bool { return deactivatedOf(r1) }, 10*time.Second, 100*time.Millisecond)
+	assert.False(t, deactivatedOf(r2))
+
+	resp4, err := DeleteSubscription(created.Subscription.ID)
+	require.NoError(t, err)
+	resp4.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp4.StatusCode)
+}
+
+// TestTeams_Management проверяет добавление и исключение участников, переименование и удаление команды
+func TestTeams_Management(t *testing.T) {
+	// Подготовка: команда из автора и ревьювера r1, открытый PR на r1
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-team-manage-" + suffix
+	renamed := "e2e-team-manage-renamed-" + suffix
+	authorID := uuid.New().String()
+	r1, r2 := uuid.New().String(), uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: r1, Username: "Reviewer1", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Team management PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp2, &created))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+	require.Equal(t, []string{r1}, created.PR.AssignedReviewers)
+
+	// Запрос: POST /teams/addMembers
+	resp3, err := AddTeamMembers(AddTeamMembersRequest{
+		TeamName: teamName,
+		Members:  []TeamMember{{UserID: r2, Username: "Reviewer2", IsActive: true}},
+	})
+	require.NoError(t, err)
+	var team AddTeamResponse
+	require.NoError(t, ParseJSONResponse(resp3, &team))
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+	assert.Len(t, team.Members, 3)
+
+	// Запрос: исключение r1 с отказом при открытых ревью
+	resp4, err := RemoveTeamMember(RemoveTeamMemberRequest{TeamName: teamName, UserID: r1, OpenReviews: "reject"})
+	require.NoError(t, err)
+	var errResp ErrorResponse
+	require.NoError(t, ParseJSONResponse(resp4, &errResp))
+	resp4.Body.Close()
+	assert.Equal(t, http.StatusConflict, resp4.StatusCode)
+	assert.Equal(t, "OPEN_REVIEWS", errResp.Error.Code)
+
+	// Запрос: исключение r1 с переназначением его ревью
+	resp5, err := RemoveTeamMember(RemoveTeamMemberRequest{TeamName: teamName, UserID: r1, OpenReviews: "reassign"})
+	require.NoError(t, err)
+	var removal TeamRemovalReport
+	require.NoError(t, ParseJSONResponse(resp5, &removal))
+	resp5.Body.Close()
+	require.Equal(t, http.StatusOK, resp5.StatusCode)
+	assert.Equal(t, []string{r1}, removal.RemovedUsers)
+	require.Len(t, removal.Reassigned, 1)
+	assert.Equal(t, r2, removal.Reassigned[0].NewReviewerID)
+
+	// Запрос: переименование команды
+	resp6, err := RenameTeam(RenameTeamRequest{TeamName: teamName, NewTeamName: renamed})
+	require.NoError(t, err)
+	resp6.Body.Close()
+	require.Equal(t, http.StatusOK, resp6.StatusCode)
+
+	resp7, err := GetTeamByName(teamName)
+	require.NoError(t, err)
+	resp7.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp7.StatusCode)
+
+	resp8, err := GetTeamByName(renamed)
+	require.NoError(t, err)
+	var got AddTeamResponse
+	require.NoError(t, ParseJSONResponse(resp8, &got))
+	resp8.Body.Close()
+	require.Equal(t, http.StatusOK, resp8.StatusCode)
+	assert.Len(t, got.Members, 2)
+
+	// Запрос: удаление команды по умолчанию отклоняется, пока r2 ревьюит PR команды
+	resp9, err := DeleteTeam(DeleteTeamRequest{TeamName: renamed})
+	require.NoError(t, err)
+	resp9.Body.Close()
+	assert.Equal(t, http.StatusConflict, resp9.StatusCode)
+
+	resp10, err := DeleteTeam(DeleteTeamRequest{TeamName: renamed, OpenReviews: "keep"})
+	require.NoError(t, err)
+	var deleted TeamRemovalReport
+	require.NoError(t, ParseJSONResponse(resp10, &deleted))
+	resp10.Body.Close()
+	require.Equal(t, http.StatusOK, resp10.StatusCode)
+	assert.ElementsMatch(t, []string{authorID, r2}, deleted.RemovedUsers)
+	require.Len(t, deleted.Unfilled, 1)
+
+	// Проверка: команды больше нет, ревью r2 осталось на месте
+	resp11, err := GetTeamByName(renamed)
+	require.NoError(t, err)
+	resp11.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp11.StatusCode)
+
+	resp12, err := GetPullRequest(prID)
+	require.NoError(t, err)
+	var pr CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp12, &pr))
+	resp12.Body.Close()
+	assert.Equal(t, []string{r2}, pr.PR.AssignedReviewers)
+}
+
+// TestUsers_SetIsActive_ReassignsOpenReviews проверяет перераспределение открытых ревью при деактивации пользователя
+func TestUsers_SetIsActive_ReassignsOpenReviews(t *testing.T) {
+	// Подготовка: PR автора с одним ревьювером, второй участник команды пока без свободных слотов
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-team-user-deactivate-" + suffix
+	authorID := uuid.New().String()
+	r1, r2 := uuid.New().String(), uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: r1, Username: "Reviewer1", IsActive: true},
+			{UserID: r2, Username: "Reviewer2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	zero := 0
+	resp2, err := SetReviewCapacity(SetReviewCapacityRequest{UserID: r2, ReviewCapacity: &zero})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+
+	resp3, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "User Deactivation PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp3, &created))
+	resp3.Body.Close()
+	require.Equal(t, http.StatusCreated, resp3.StatusCode)
+	require.Equal(t, []string{r1}, created.PR.AssignedReviewers)
+
+	resp4, err := SetReviewCapacity(SetReviewCapacityRequest{UserID: r2})
+	require.NoError(t, err)
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+
+	// Запрос: POST /users/setIsActive с reassign_open_reviews
+	reassign := true
+	resp5, err := SetIsActive(SetIsActiveRequest{UserID: r1, IsActive: false, ReassignOpenReviews: &reassign})
+	require.NoError(t, err)
+	var result SetIsActiveResponse
+	require.NoError(t, ParseJSONResponse(resp5, &result))
+	resp5.Body.Close()
+	require.Equal(t, http.StatusOK, resp5.StatusCode)
+
+	// Проверка: пользователь деактивирован, его ревью перешло к r2
+	assert.False(t, result.User.IsActive)
+	require.Len(t, result.Reassigned, 1)
+	assert.Equal(t, prID, result.Reassigned[0].PullRequestID)
+	assert.Equal(t, r1, result.Reassigned[0].OldReviewerID)
+	assert.Equal(t, r2, result.Reassigned[0].NewReviewerID)
+	assert.Empty(t, result.Unfilled)
+
+	resp6, err := GetUserReviews(r1)
+	require.NoError(t, err)
+	var reviews UserReviewsResponse
+	require.NoError(t, ParseJSONResponse(resp6, &reviews))
+	resp6.Body.Close()
+	assert.Equal(t, 0, reviews.OpenReviews)
+}
+
+// ============================================================================
+// Stats Tests
+// ============================================================================
+
+// TestStats_Assignments проверяет статистику назначений по команде с учётом переназначений
+func TestStats_Assignments(t *testing.T) {
+	// Подготовка: команда из автора и трёх ревьюверов, PR с двумя назначенными
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-team-stats-" + suffix
+	authorID := uuid.New().String()
+	reviewers := []string{uuid.New().String(), uuid.New().String(), uuid.New().String()}
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewers[0], Username: "Reviewer1", IsActive: true},
+			{UserID: reviewers[1], Username: "Reviewer2", IsActive: true},
+			{UserID: reviewers[2], Username: "Reviewer3", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Stats PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp2, &created))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+	require.Len(t, created.PR.AssignedReviewers, 2)
+	oldReviewer := created.PR.AssignedReviewers[0]
+
+	resp3, err := ReassignUserForPullRequest(ReassignUserForPullRequestRequest{
+		PullRequestID: prID,
+		OldUserID:     oldReviewer,
+	})
+	require.NoError(t, err)
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+
+	// Запрос: GET /stats/assignments с фильтром по команде
+	resp4, err := GetAssignmentStats(url.Values{"team_name": {teamName}})
+	require.NoError(t, err)
+	var stats AssignmentStatsResponse
+	require.NoError(t, ParseJSONResponse(resp4, &stats))
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+
+	// Проверка: у PR два ревьювера и одно переназначение, у заменённого ревьювера одно снятие
+	require.Len(t, stats.PullRequests, 1)
+	assert.Equal(t, prID, stats.PullRequests[0].PullRequestID)
+	assert.Equal(t, teamName, stats.PullRequests[0].TeamName)
+	assert.Equal(t, 2, stats.PullRequests[0].Reviewers)
+	assert.Equal(t, 1, stats.PullRequests[0].Reassignments)
+
+	require.Len(t, stats.Users, 3)
+	for _, u := range stats.Users {
+		if u.UserID == oldReviewer {
+			assert.Equal(t, 0, u.TotalReviews)
+			assert.Equal(t, 1, u.ReassignedAway)
+			continue
+		}
+		assert.Equal(t, 1, u.TotalReviews)
+		assert.Equal(t, 1, u.OpenReviews)
+		assert.Equal(t, 0, u.ReassignedAway)
+	}
+
+	// Запрос: пользователи постранично по одному
+	seen := map[string]bool{}
+	cursor := ""
+	for i := 0; i < 3; i++ {
+		resp, err := GetAssignmentStats(url.Values{"team_name": {teamName}, "limit": {"1"}, "users_cursor": {cursor}})
+		require.NoError(t, err)
+		var page AssignmentStatsResponse
+		require.NoError(t, ParseJSONResponse(resp, &page))
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, page.Users, 1)
+		seen[page.Users[0].UserID] = true
+		cursor = page.NextUsersCursor
+	}
+
+	// Проверка: три разных пользователя, третья страница последняя
+	assert.Len(t, seen, 3)
+	assert.Empty(t, cursor)
+
+	// Запрос: диапазон дат в будущем не содержит данных
+	resp5, err := GetAssignmentStats(url.Values{
+		"team_name": {teamName},
+		"from":      {time.Now().Add(time.Hour).UTC().Format(time.RFC3339)},
+	})
+	require.NoError(t, err)
+	var empty AssignmentStatsResponse
+	require.NoError(t, ParseJSONResponse(resp5, &empty))
+	resp5.Body.Close()
+	assert.Equal(t, http.StatusOK, resp5.StatusCode)
+	assert.Empty(t, empty.Users)
+	assert.Empty(t, empty.PullRequests)
+
+	// Запрос: перепутанные границы диапазона
+	resp6, err := GetAssignmentStats(url.Values{
+		"from": {"2025-12-01T00:00:00Z"},
+		"to":   {"2025-11-01T00:00:00Z"},
+	})
+	require.NoError(t, err)
+	resp6.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp6.StatusCode)
+}
+
+// TestTeams_Fairness проверяет отчёт о нагрузке ревью участников команды
+func TestTeams_Fairness(t *testing.T) {
+	// Подготовка: команда из автора и двух ревьюверов, на PR назначаются оба ревьювера
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-team-fairness-" + suffix
+	authorID := uuid.New().String()
+	r1, r2 := uuid.New().String(), uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: r1, Username: "Reviewer1", IsActive: true},
+			{UserID: r2, Username: "Reviewer2", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	prID := uuid.New().String()
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Fairness PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	// Запрос: GET /teams/:team_name/fairness
+	resp3, err := GetTeamFairness(teamName, url.Values{"window_days": {"7"}})
+	require.NoError(t, err)
+	var report FairnessReportResponse
+	require.NoError(t, ParseJSONResponse(resp3, &report))
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+
+	// Проверка: у ревьюверов по одному ревью, автор недогружен
+	assert.Equal(t, teamName, report.TeamName)
+	assert.Equal(t, 0, report.MinLoad)
+	assert.Equal(t, 1, report.MaxLoad)
+	assert.InDelta(t, 2.0/3.0, report.MeanLoad, 1e-9)
+	assert.InDelta(t, 1.0/3.0, report.Gini, 1e-9)
+	require.Len(t, report.Members, 3)
+	for _, m := range report.Members {
+		if m.UserID == authorID {
+			assert.Equal(t, 0, m.Load)
+			assert.Equal(t, "underloaded", m.Flag)
+			continue
+		}
+		assert.Equal(t, 1, m.Load)
+		assert.Empty(t, m.Flag)
+	}
+
+	// Запрос: третий участник и переназначение ревью на него
+	r3 := uuid.New().String()
+	resp6, err := AddTeamMembers(AddTeamMembersRequest{
+		TeamName: teamName,
+		Members:  []TeamMember{{UserID: r3, Username: "Reviewer3", IsActive: true}},
+	})
+	require.NoError(t, err)
+	resp6.Body.Close()
+	require.Equal(t, http.StatusOK, resp6.StatusCode)
+
+	resp7, err := ReassignUserForPullRequest(ReassignUserForPullRequestRequest{PullRequestID: prID, OldUserID: r1})
+	require.NoError(t, err)
+	resp7.Body.Close()
+	require.Equal(t, http.StatusOK, resp7.StatusCode)
+
+	resp8, err := GetTeamFairness(teamName, url.Values{"window_days": {"7"}})
+	require.NoError(t, err)
+	var reassigned FairnessReportResponse
+	require.NoError(t, ParseJSONResponse(resp8, &reassigned))
+	resp8.Body.Close()
+	require.Equal(t, http.StatusOK, resp8.StatusCode)
+
+	// Проверка: переназначенное ревью считается только у нового ревьювера
+	loads := map[string]int{}
+	for _, m := range reassigned.Members {
+		loads[m.UserID] = m.Load
+	}
+	assert.Equal(t, map[string]int{authorID: 0, r1: 0, r2: 1, r3: 1}, loads)
+
+	// Запрос: несуществующая команда
+	resp4, err := GetTeamFairness("e2e-team-missing-"+suffix, nil)
+	require.NoError(t, err)
+	resp4.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp4.StatusCode)
+
+	// Запрос: некорректное окно
+	resp5, err := GetTeamFairness(teamName, url.Values{"window_days": {"0"}})
+	require.NoError(t, err)
+	resp5.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp5.StatusCode)
+}
+
+// TestUsers_Directory проверяет получение, поиск и изменение профиля пользователей
+func TestUsers_Directory(t *testing.T) {
+	// Подготовка: две команды, u1 состоит в обеих, u2 неактивен
+	suffix := uuid.New().String()[:8]
+	backend := "e2e-users-backend-" + suffix
+	platform := "e2e-users-platform-" + suffix
+	u1, u2 := uuid.New().String(), uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: backend,
+		Members: []TeamMember{
+			{UserID: u1, Username: "Dir-" + suffix + "-Alice", IsActive: true},
+			{UserID: u2, Username: "Dir-" + suffix + "-Bob", IsActive: false},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := AddTeam(AddTeamRequest{
+		TeamName: platform,
+		Members:  []TeamMember{{UserID: u1, Username: "Dir-" + suffix + "-Alice", IsActive: true}},
+	})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	// Запрос: GET /users/:id
+	resp3, err := GetUser(u1)
+	require.NoError(t, err)
+	var user UserResponse
+	require.NoError(t, ParseJSONResponse(resp3, &user))
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+	assert.Equal(t, backend, user.User.TeamName)
+	assert.Len(t, user.User.Teams, 2)
+
+	// Запрос: PATCH /users/:id меняет только переданные поля
+	name, email := "Dir-"+suffix+"-Alice Smith", "alice-"+suffix+"@example.com"
+	resp4, err := UpdateUser(u1, UpdateUserRequest{Username: &name, Email: &email})
+	require.NoError(t, err)
+	var updated UserResponse
+	require.NoError(t, ParseJSONResponse(resp4, &updated))
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+	assert.Equal(t, name, updated.User.Username)
+	assert.Equal(t, email, updated.User.Email)
+	assert.Empty(t, updated.User.Title)
+	assert.Len(t, updated.User.Teams, 2)
+
+	// Запрос: пустое имя отклоняется
+	blank := " "
+	resp5, err := UpdateUser(u1, UpdateUserRequest{Username: &blank})
+	require.NoError(t, err)
+	resp5.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp5.StatusCode)
+
+	// Запрос: поиск по имени с постраничным выводом
+	resp6, err := ListUsers(url.Values{"username": {"dir-" + suffix}, "limit": {"1"}})
+	require.NoError(t, err)
+	var first ListUsersResponse
+	require.NoError(t, ParseJSONResponse(resp6, &first))
+	resp6.Body.Close()
+	require.Equal(t, http.StatusOK, resp6.StatusCode)
+	require.Len(t, first.Users, 1)
+	assert.Equal(t, u1, first.Users[0].UserID)
+	require.NotEmpty(t, first.NextCursor)
+
+	resp7, err := ListUsers(url.Values{"username": {"dir-" + suffix}, "limit": {"1"}, "cursor": {first.NextCursor}})
+	require.NoError(t, err)
+	var second ListUsersResponse
+	require.NoError(t, ParseJSONResponse(resp7, &second))
+	resp7.Body.Close()
+	require.Len(t, second.Users, 1)
+	assert.Equal(t, u2, second.Users[0].UserID)
+	assert.Empty(t, second.NextCursor)
+
+	// Проверка: фильтры по команде и активности
+	resp8, err := ListUsers(url.Values{"team_name": {platform}})
+	require.NoError(t, err)
+	var byTeam ListUsersResponse
+	require.NoError(t, ParseJSONResponse(resp8, &byTeam))
+	resp8.Body.Close()
+	require.Len(t, byTeam.Users, 1)
+	assert.Equal(t, u1, byTeam.Users[0].UserID)
+
+	resp9, err := ListUsers(url.Values{"team_name": {backend}, "is_active": {"false"}})
+	require.NoError(t, err)
+	var inactive ListUsersResponse
+	require.NoError(t, ParseJSONResponse(resp9, &inactive))
+	resp9.Body.Close()
+	require.Len(t, inactive.Users, 1)
+	assert.Equal(t, u2, inactive.Users[0].UserID)
+
+	// Запрос: несуществующий пользователь
+	resp10, err := GetUser(uuid.New().String())
+	require.NoError(t, err)
+	resp10.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp10.StatusCode)
+}
+
+// TestAdmin_Reconcile проверяет план и применение декларативного описания команд
+func TestAdmin_Reconcile(t *testing.T) {
+	// Подготовка: команда из автора и ревьювера r1, открытый PR на r1
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-reconcile-" + suffix
+	newTeam := "e2e-reconcile-new-" + suffix
+	authorID, r1, r2 := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: r1, Username: "Reviewer1", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Reconcile PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	// Документ: r1 уходит, r2 добавляется, автор деактивируется и переходит в новую команду
+	inactive := false
+	doc := ReconcileRequest{Teams: []ReconcileTeam{
+		{TeamName: teamName, Members: []ReconcileMember{
+			{UserID: authorID, Username: "Author", IsActive: &inactive},
+			{UserID: r2, Username: "Reviewer2"},
+		}},
+		{TeamName: newTeam, Members: []ReconcileMember{
+			{UserID: authorID, Username: "Author", IsActive: &inactive},
+		}},
+	}}
+
+	// Запрос: dry-run
+	resp3, err := Reconcile(doc)
+	require.NoError(t, err)
+	var plan ReconcilePlanResponse
+	require.NoError(t, ParseJSONResponse(resp3, &plan))
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+
+	// Проверка: план без применения
+	assert.False(t, plan.Applied)
+	assert.Equal(t, []string{newTeam}, plan.CreateTeams)
+	assert.Equal(t, []string{r2}, plan.CreateUsers)
+	assert.Equal(t, []MembershipChange{{TeamName: teamName, UserID: r1}}, plan.RemoveMembers)
+	assert.Equal(t, []string{authorID}, plan.DeactivateUsers)
+	assert.Equal(t, []AffectedReview{{PullRequestID: prID, TeamName: teamName, ReviewerID: r1}}, plan.AffectedPullRequests)
+
+	resp4, err := GetTeamByName(teamName)
+	require.NoError(t, err)
+	var before AddTeamResponse
+	require.NoError(t, ParseJSONResponse(resp4, &before))
+	resp4.Body.Close()
+	assert.Len(t, before.Members, 2)
+
+	// Запрос: применение
+	doc.Apply = true
+	resp5, err := Reconcile(doc)
+	require.NoError(t, err)
+	var applied ReconcilePlanResponse
+	require.NoError(t, ParseJSONResponse(resp5, &applied))
+	resp5.Body.Close()
+	require.Equal(t, http.StatusOK, resp5.StatusCode)
+	assert.True(t, applied.Applied)
+
+	// Проверка: состав команд совпадает с документом
+	resp6, err := GetTeamByName(teamName)
+	require.NoError(t, err)
+	var after AddTeamResponse
+	require.NoError(t, ParseJSONResponse(resp6, &after))
+	resp6.Body.Close()
+	ids := make([]string, 0, len(after.Members))
+	for _, m := range after.Members {
+		ids = append(ids, m.UserID)
+	}
+	assert.ElementsMatch(t, []string{authorID, r2}, ids)
+
+	resp7, err := GetUser(authorID)
+	require.NoError(t, err)
+	var author UserResponse
+	require.NoError(t, ParseJSONResponse(resp7, &author))
+	resp7.Body.Close()
+	assert.False(t, author.User.IsActive)
+	assert.Len(t, author.User.Teams, 2)
+
+	// Проверка: повторный dry-run не содержит изменений
+	doc.Apply = false
+	resp8, err := Reconcile(doc)
+	require.NoError(t, err)
+	var again ReconcilePlanResponse
+	require.NoError(t, ParseJSONResponse(resp8, &again))
+	resp8.Body.Close()
+	assert.Empty(t, again.CreateTeams)
+	assert.Empty(t, again.AddMembers)
+	assert.Empty(t, again.RemoveMembers)
+	assert.Empty(t, again.DeactivateUsers)
+
+	// Запрос: противоречивый документ
+	resp9, err := Reconcile(ReconcileRequest{Teams: []ReconcileTeam{{TeamName: teamName}, {TeamName: teamName}}})
+	require.NoError(t, err)
+	resp9.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp9.StatusCode)
+}
+
+// TestAdmin_Reconcile_Deactivation проверяет переназначение ревью участника, деактивированного через reconcile
+func TestAdmin_Reconcile_Deactivation(t *testing.T) {
+	// Подготовка: команда из автора и ревьювера r1, открытый PR на r1
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-reconcile-deact-" + suffix
+	authorID, r1, r2 := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	prID := uuid.New().String()
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: r1, Username: "Reviewer1", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Reconcile Deactivation PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	// Документ: r1 деактивируется, r2 добавляется
+	inactive := false
+	doc := ReconcileRequest{Apply: true, Teams: []ReconcileTeam{
+		{TeamName: teamName, Members: []ReconcileMember{
+			{UserID: authorID, Username: "Author"},
+			{UserID: r1, Username: "Reviewer1", IsActive: &inactive},
+			{UserID: r2, Username: "Reviewer2"},
+		}},
+	}}
+
+	// Запрос: применение
+	resp3, err := Reconcile(doc)
+	require.NoError(t, err)
+	var applied ReconcilePlanResponse
+	require.NoError(t, ParseJSONResponse(resp3, &applied))
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+
+	// Проверка: ревью r1 в плане и передано r2
+	assert.True(t, applied.Applied)
+	assert.Equal(t, []string{r1}, applied.DeactivateUsers)
+	assert.Equal(t, []AffectedReview{{PullRequestID: prID, TeamName: teamName, ReviewerID: r1}}, applied.AffectedPullRequests)
+	assert.Equal(t, []DeactivationReassign{{PullRequestID: prID, OldReviewerID: r1, NewReviewerID: r2}}, applied.Reassigned)
+	assert.Empty(t, applied.Unfilled)
+
+	resp4, err := GetPullRequest(prID)
+	require.NoError(t, err)
+	var got CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp4, &got))
+	resp4.Body.Close()
+	assert.Equal(t, []string{r2}, got.PR.AssignedReviewers)
+}
+
+// TestWebhooks_Github проверяет создание и слияние PR по событиям pull_request GitHub
+func TestWebhooks_Github(t *testing.T) {
+	// Подготовка: команда из автора и ревьювера, GitHub логин автора привязан к пользователю
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-github-" + suffix
+	authorID, reviewerID := uuid.New().String(), uuid.New().String()
+	login := "octocat-" + suffix
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := LinkIdentity(LinkIdentityRequest{UserID: authorID, Provider: "github", ExternalID: login})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+
+	// Проверка: тот же логин нельзя привязать к другому пользователю
+	resp3, err := LinkIdentity(LinkIdentityRequest{UserID: reviewerID, Provider: "github", ExternalID: strings.ToUpper(login)})
+	require.NoError(t, err)
+	resp3.Body.Close()
+	assert.Equal(t, http.StatusConflict, resp3.StatusCode)
+
+	var payload GithubPullRequestPayload
+	payload.Action = "opened"
+	payload.Number = 42
+	payload.PullRequest.Title = "Webhook PR"
+	payload.PullRequest.User.Login = login
+	payload.Repository.FullName = "acme/" + suffix
+	payload.Sender.Login = login
+
+	// Запрос: подпись другим секретом
+	resp4, err := GithubWebhook("pull_request", payload, "wrong-secret")
+	require.NoError(t, err)
+	resp4.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp4.StatusCode)
+
+	// Запрос: opened
+	resp5, err := GithubWebhook("pull_request", payload, githubWebhookSecret)
+	require.NoError(t, err)
+	var opened WebhookResponse
+	require.NoError(t, ParseJSONResponse(resp5, &opened))
+	resp5.Body.Close()
+	require.Equal(t, http.StatusOK, resp5.StatusCode)
+
+	// Проверка: PR создан от автора с ревьювером команды
+	assert.Equal(t, "processed", opened.Status)
+	assert.Equal(t, "OPEN", opened.PullRequestStatus)
+
+	resp6, err := GetPullRequest(opened.PullRequestID)
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp6, &created))
+	resp6.Body.Close()
+	assert.Equal(t, authorID, created.PR.AuthorID)
+	assert.Equal(t, "Webhook PR", created.PR.PullRequestName)
+	assert.Equal(t, []string{reviewerID}, created.PR.AssignedReviewers)
+
+	// Проверка: повторная доставка не создает второй PR
+	resp7, err := GithubWebhook("pull_request", payload, githubWebhookSecret)
+	require.NoError(t, err)
+	var redelivered WebhookResponse
+	require.NoError(t, ParseJSONResponse(resp7, &redelivered))
+	resp7.Body.Close()
+	assert.Equal(t, http.StatusOK, resp7.StatusCode)
+	assert.Equal(t, opened.PullRequestID, redelivered.PullRequestID)
+
+	// Подготовка: политика команды не пропустила бы мерж без одобрений
+	one := 1
+	respPolicy, err := SetTeamMergePolicy(SetTeamMergePolicyRequest{TeamName: teamName, MinApprovals: &one})
+	require.NoError(t, err)
+	respPolicy.Body.Close()
+	require.Equal(t, http.StatusOK, respPolicy.StatusCode)
+
+	// Запрос: closed с merged, мерж уже выполнен в GitHub и записывается без политики
+	payload.Action = "closed"
+	payload.PullRequest.Merged = true
+	resp8, err := GithubWebhook("pull_request", payload, githubWebhookSecret)
+	require.NoError(t, err)
+	var merged WebhookResponse
+	require.NoError(t, ParseJSONResponse(resp8, &merged))
+	resp8.Body.Close()
+	require.Equal(t, http.StatusOK, resp8.StatusCode)
+	assert.Equal(t, opened.PullRequestID, merged.PullRequestID)
+	assert.Equal(t, "MERGED", merged.PullRequestStatus)
+
+	// Запрос: PR автора без привязанного логина
+	payload.Action = "opened"
+	payload.Number = 43
+	payload.PullRequest.User.Login = "stranger-" + suffix
+	resp9, err := GithubWebhook("pull_request", payload, githubWebhookSecret)
+	require.NoError(t, err)
+	resp9.Body.Close()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp9.StatusCode)
+}
+
+// TestWebhooks_Gitlab проверяет жизненный цикл PR по Merge Request Hook GitLab
+func TestWebhooks_Gitlab(t *testing.T) {
+	// Подготовка: команда из автора и ревьювера, GitLab логин автора привязан к пользователю
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-gitlab-" + suffix
+	authorID, reviewerID := uuid.New().String(), uuid.New().String()
+	login := "jdoe-" + suffix
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := LinkIdentity(LinkIdentityRequest{UserID: authorID, Provider: "gitlab", ExternalID: login})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+
+	var payload GitlabMergeRequestPayload
+	payload.ObjectKind = "merge_request"
+	payload.User.Username = login
+	payload.Project.ID = int(uuid.New().ID())
+	payload.Project.PathWithNamespace = "acme/" + suffix
+	payload.ObjectAttributes.Iid = 17
+	payload.ObjectAttributes.Title = "GitLab MR"
+	payload.ObjectAttributes.Action = "open"
+	payload.ObjectAttributes.Draft = true
+
+	// Запрос: неверный токен
+	resp3, err := GitlabWebhook("Merge Request Hook", payload, "wrong-token")
+	require.NoError(t, err)
+	resp3.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp3.StatusCode)
+
+	// Запрос: open черновика
+	resp4, err := GitlabWebhook("Merge Request Hook", payload, gitlabWebhookToken)
+	require.NoError(t, err)
+	var opened WebhookResponse
+	require.NoError(t, ParseJSONResponse(resp4, &opened))
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+	assert.Equal(t, "DRAFT", opened.PullRequestStatus)
+
+	// Запрос: update, снимающий черновик
+	payload.ObjectAttributes.Action = "update"
+	payload.ObjectAttributes.Draft = false
+	payload.Changes = map[string]any{"draft": map[string]bool{"previous": true, "current": false}}
+	resp5, err := GitlabWebhook("Merge Request Hook", payload, gitlabWebhookToken)
+	require.NoError(t, err)
+	var ready WebhookResponse
+	require.NoError(t, ParseJSONResponse(resp5, &ready))
+	resp5.Body.Close()
+	require.Equal(t, http.StatusOK, resp5.StatusCode)
+
+	// Проверка: тот же PR открыт и получил ревьювера
+	assert.Equal(t, opened.PullRequestID, ready.PullRequestID)
+	assert.Equal(t, "OPEN", ready.PullRequestStatus)
+
+	resp6, err := GetPullRequest(ready.PullRequestID)
+	require.NoError(t, err)
+	var got CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp6, &got))
+	resp6.Body.Close()
+	assert.Equal(t, authorID, got.PR.AuthorID)
+	assert.Equal(t, []string{reviewerID}, got.PR.AssignedReviewers)
+
+	// Подготовка: политика команды не пропустила бы мерж без одобрений
+	one := 1
+	respPolicy, err := SetTeamMergePolicy(SetTeamMergePolicyRequest{TeamName: teamName, MinApprovals: &one})
+	require.NoError(t, err)
+	respPolicy.Body.Close()
+	require.Equal(t, http.StatusOK, respPolicy.StatusCode)
+
+	// Запрос: close, reopen и merge, мерж из GitLab записывается без политики
+	payload.Changes = nil
+	for _, step := range []struct{ action, status string }{
+		{"close", "CLOSED"},
+		{"reopen", "OPEN"},
+		{"merge", "MERGED"},
+	} {
+		payload.ObjectAttributes.Action = step.action
+		resp, err := GitlabWebhook("Merge Request Hook", payload, gitlabWebhookToken)
+		require.NoError(t, err)
+		var res WebhookResponse
+		require.NoError(t, ParseJSONResponse(resp, &res))
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode, step.action)
+		assert.Equal(t, opened.PullRequestID, res.PullRequestID, step.action)
+		assert.Equal(t, step.status, res.PullRequestStatus, step.action)
+	}
+
+	// Запрос: другой хук игнорируется
+	resp7, err := GitlabWebhook("Push Hook", payload, gitlabWebhookToken)
+	require.NoError(t, err)
+	resp7.Body.Close()
+	assert.Equal(t, http.StatusAccepted, resp7.StatusCode)
+}
+
+// TestIdentities проверяет обращение к пользователю по внешнему аккаунту вместо UUID
+func TestIdentities(t *testing.T) {
+	// Подготовка: команда из автора и ревьювера, у автора два внешних аккаунта
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-identities-" + suffix
+	authorID, reviewerID := uuid.New().String(), uuid.New().String()
+	githubRef, gitlabRef := "github:author-"+suffix, "gitlab:author-"+suffix
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	resp2, err := LinkIdentity(LinkIdentityRequest{UserID: authorID, Provider: "github", ExternalID: "author-" + suffix})
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+
+	// Запрос: вторая привязка ссылается на пользователя по первой
+	resp3, err := LinkIdentity(LinkIdentityRequest{UserID: githubRef, Provider: "gitlab", ExternalID: "author-" + suffix})
+	require.NoError(t, err)
+	resp3.Body.Close()
+	require.Equal(t, http.StatusOK, resp3.StatusCode)
+
+	// Проверка: пользователь находится по любому из аккаунтов
+	resp4, err := GetUser(gitlabRef)
+	require.NoError(t, err)
+	var user UserResponse
+	require.NoError(t, ParseJSONResponse(resp4, &user))
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+	assert.Equal(t, authorID, user.User.UserID)
+
+	resp5, err := GetUserIdentities(authorID)
+	require.NoError(t, err)
+	var identities IdentitiesResponse
+	require.NoError(t, ParseJSONResponse(resp5, &identities))
+	resp5.Body.Close()
+	assert.Len(t, identities.Identities, 2)
+
+	// Проверка: PR создается с автором, указанным через внешний аккаунт
+	prID := uuid.New().String()
+	resp6, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Identity PR",
+		AuthorID:        githubRef,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp6, &created))
+	resp6.Body.Close()
+	require.Equal(t, http.StatusCreated, resp6.StatusCode)
+	assert.Equal(t, authorID, created.PR.AuthorID)
+
+	// Запрос: отвязка аккаунта
+	resp7, err := UnlinkIdentity(LinkIdentityRequest{UserID: authorID, Provider: "gitlab", ExternalID: "author-" + suffix})
+	require.NoError(t, err)
+	var left IdentitiesResponse
+	require.NoError(t, ParseJSONResponse(resp7, &left))
+	resp7.Body.Close()
+	require.Equal(t, http.StatusOK, resp7.StatusCode)
+	assert.Len(t, left.Identities, 1)
+
+	// Проверка: отвязанный аккаунт больше не находит пользователя
+	resp8, err := GetUser(gitlabRef)
+	require.NoError(t, err)
+	resp8.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp8.StatusCode)
+}
+
+// TestPullRequests_ExternalKey проверяет PR с внешним ключом provider:repository#number
+func TestPullRequests_ExternalKey(t *testing.T) {
+	// Подготовка: команда из автора и ревьювера
+	suffix := uuid.New().String()[:8]
+	teamName := "e2e-external-pr-" + suffix
+	authorID, reviewerID := uuid.New().String(), uuid.New().String()
+	key := "github:acme/" + suffix + "#7"
+
+	resp1, err := AddTeam(AddTeamRequest{
+		TeamName: teamName,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+
+	// Запрос: создание PR по внешнему ключу
+	resp2, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   key,
+		PullRequestName: "External PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	var created CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp2, &created))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusCreated, resp2.StatusCode)
+
+	// Проверка: PR получил UUID и хранит ключ
+	_, err = uuid.Parse(created.PR.PullRequestID)
+	assert.NoError(t, err)
+	assert.Equal(t, key, created.PR.ExternalID)
+
+	// Проверка: ключ в другом регистре репозитория занят
+	resp3, err := CreatePullRequest(CreatePullRequestRequest{
+		PullRequestID:   "github:ACME/" + suffix + "#7",
+		PullRequestName: "External PR",
+		AuthorID:        authorID,
+	})
+	require.NoError(t, err)
+	resp3.Body.Close()
+	assert.Equal(t, http.StatusConflict, resp3.StatusCode)
+
+	// Проверка: PR находится и по ключу, и по UUID
+	resp4, err := GetPullRequest(key)
+	require.NoError(t, err)
+	var byKey CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp4, &byKey))
+	resp4.Body.Close()
+	require.Equal(t, http.StatusOK, resp4.StatusCode)
+	assert.Equal(t, created.PR.PullRequestID, byKey.PR.PullRequestID)
+
+	resp5, err := GetPullRequest(created.PR.PullRequestID)
+	require.NoError(t, err)
+	resp5.Body.Close()
+	assert.Equal(t, http.StatusOK, resp5.StatusCode)
+
+	// Запрос: ревью и мерж по ключу
+	resp6, err := ReviewPullRequest(ReviewPullRequestRequest{PullRequestID: key, UserID: reviewerID, State: "APPROVED"})
+	require.NoError(t, err)
+	resp6.Body.Close()
+	require.Equal(t, http.StatusOK, resp6.StatusCode)
+
+	resp7, err := MergePullRequest(MergePullRequestRequest{PullRequestID: key})
+	require.NoError(t, err)
+	var merged CreatePullRequestResponse
+	require.NoError(t, ParseJSONResponse(resp7, &merged))
+	resp7.Body.Close()
+	require.Equal(t, http.StatusOK, resp7.StatusCode)
+	assert.Equal(t, "MERGED", merged.PR.Status)
+
+	// Проверка: неизвестный ключ — 404
+	resp8, err := GetPullRequest("github:acme/" + suffix + "#8")
+	require.NoError(t, err)
+	resp8.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp8.StatusCode)
+}
+
+// TestWebhookSubscriptions проверяет доставку событий подписчику с подписью, повторами и журналом доставок
+func TestWebhookSubscriptions(t *testing.T) {
+	// Подготовка: получатель отвечает 500 на первую доставку и 200 на остальные
+	const secret = "e2e-subscription-secret"
+	var (
+		mu       sync.Mutex
+		calls    int
+		received []SubscriptionEvent
+	)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil || !signature.VerifySHA256([]byte(secret), body, r.Header.Get("X-Signature-256")) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var event SubscriptionEvent
+		if json.Unmarshal(body, &event) == nil {
+			received = append(received, event)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	eventsOf := func(prID string) []SubscriptionEvent {
+		mu.Lock()
+		defer mu.Unlock()
+		var events []SubscriptionEvent
+		for _, e := range received {
+			if e.Data.PullRequestID == prID {
+				events = append(events, e)
+			}
+		}
+		return events
+	}
+
+	// Проверка: неизвестное событие — 400
+	resp0, err := CreateSubscription(CreateSubscriptionRequest{URL: receiver.URL, Events: []string{"pr.unknown"}})
+	require.NoError(t, err)
+	resp0.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp0.StatusCode)
+
+	// Запрос: подписка на создание, назначение ревьюверов и мерж
+	resp1, err := CreateSubscription(CreateSubscriptionRequest{
+		URL:    receiver.URL,
+		Secret: secret,
+		Events: []string{"pr.created", "reviewer.assigned", "pr.merged"},
+	})
+	require.NoError(t, err)
+	var created CreateSubscriptionResponse
+	require.NoError(t, ParseJSONResponse(resp1, &created))
+	resp1.Body.Close()
+	require.Equal(t, http.StatusCreated, resp1.StatusCode)
+	subID := created.Subscription.ID
+	assert.Equal(t, secret, created.Subscription.Secret)
+
+	// Проверка: подписка в списке без секрета
+	resp2, err := ListSubscriptions()
+	require.NoError(t, err)
+	var list SubscriptionsResponse
+	require.NoError(t, ParseJSONResponse(resp2, &list))
+	resp2.Body.Close()
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+	found := false
+	for _, s := range list.Subscriptions {
+		if s.ID == subID {
+			found = true
+			assert.Empty(t, s.Secret)
+		}
+	}
+	assert.True(t, found)
+
+	// Подготовка: команда из автора и ревьювера
+	suffix := uuid.New().String()[:8]
+	authorID, reviewerID := uuid.New().String(), uuid.New().String()
+	resp3, err := AddTeam(AddTeamRequest{
+		TeamName: "e2e-subscriptions-" + suffix,
+		Members: []TeamMember{
+			{UserID: authorID, Username: "Author", IsActive: true},
+			{UserID: reviewerID, Username: "Reviewer", IsActive: true},
+		},
+	})
+	require.NoError(t, err)
+	resp3.Body.Close()
+	require.Equal(t, http.StatusCreated, resp3.StatusCode)
+
+	// Запрос: создание и мерж PR
+	prID := uuid.New().String()
+	resp4, err := CreatePullRequest(CreatePullRequestRequest{PullRequestID: prID, PullRequestName: "Subscribed PR", AuthorID: authorID})
+	require.NoError(t, err)
+	resp4.Body.Close()
+	require.Equal(t, http.StatusCreated, resp4.StatusCode)
+
+	resp5, err := ReviewPullRequest(ReviewPullRequestRequest{PullRequestID: prID, UserID: reviewerID, State: "APPROVED"})
+	require.NoError(t, err)
+	resp5.Body.Close()
+	require.Equal(t, http.StatusOK, resp5.StatusCode)
+
+	resp6, err := MergePullRequest(MergePullRequestRequest{PullRequestID: prID})
+	require.NoError(t, err)
+	resp6.Body.Close()
+	require.Equal(t, http.StatusOK, resp6.StatusCode)
+
+	// Проверка: все три события доставлены, первое — со второй попытки
+	require.Eventually(t, func() bool { return len(eventsOf(prID)) == 3 }, 10*time.Second, 100*time.Millisecond)
+	types := map[string]SubscriptionEvent{}
+	for _, e := range eventsOf(prID) {
+		types[e.Type] = e
+	}
+	assert.Contains(t, types, "pr.created")
+	assert.Contains(t, types, "pr.merged")
+	require.Contains(t, types, "reviewer.assigned")
+	assert.Equal(t, []string{reviewerID}, types["reviewer.assigned"].Data.AssignedReviewers)
+
+	// Проверка: журнал доставок
+	var deliveries DeliveriesResponse
+	require.Eventually(t, func() bool {
+		resp, err := GetSubscriptionDeliveries(subID)
+		if err != nil {
+			return false
+		}
+		deliveries = DeliveriesResponse{}
+		if ParseJSONResponse(resp, &deliveries) != nil || resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return false
+		}
+		resp.Body.Close()
+		for _, d := range deliveries.Deliveries {
+			if d.Status != "succeeded" {
+				return false
+			}
+		}
+		return len(deliveries.Deliveries) >= 3
+	}, 10*time.Second, 100*time.Millisecond)
+
+	retried := false
+	for _, d := range deliveries.Deliveries {
+		assert.Equal(t, http.StatusNoContent, d.ResponseCode)
+		if d.Attempts == 2 {
+			retried = true
+		}
+	}
+	assert.True(t, retried)
+
+	// Запрос: повторная доставка того же события
+	first := deliveries.Deliveries[0]
+	resp7, err := RedeliverWebhook(first.ID)
+	require.NoError(t, err)
+	var redelivered RedeliverResponse
+	require.NoError(t, ParseJSONResponse(resp7, &redelivered))
+	resp7.Body.Close()
+	require.Equal(t, http.StatusOK, resp7.StatusCode)
+	assert.NotEqual(t, first.ID, redelivered.Delivery.ID)
+	assert.Equal(t, first.EventID, redelivered.Delivery.EventID)
+	assert.Equal(t, "succeeded", redelivered.Delivery.Status)
+	assert.Len(t, eventsOf(prID), 4)
+
+	// Запрос: отписка
+	resp8, err := DeleteSubscription(subID)
+	require.NoError(t, err)
+	resp8.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp8.StatusCode)
+
+	// Проверка: журнал удалённой подписки — 404
+	resp9, err := GetSubscriptionDeliveries(subID)
+	require.NoError(t, err)
+	resp9.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp9.StatusCode)
+}
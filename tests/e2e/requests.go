@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+
+	"github.com/eragon-mdi/pr-reviewer-service/pkg/signature"
 )
 
 var baseURL string
@@ -79,8 +82,16 @@ func GetTeamByName(teamName string) (*http.Response, error) {
 
 // SetIsActiveRequest представляет запрос на установку активности пользователя
 type SetIsActiveRequest struct {
-	UserID   string `json:"user_id"`
-	IsActive bool   `json:"is_active"`
+	UserID              string `json:"user_id"`
+	IsActive            bool   `json:"is_active"`
+	ReassignOpenReviews *bool  `json:"reassign_open_reviews,omitempty"`
+}
+
+// SetIsActiveResponse представляет ответ на установку активности с перераспределёнными ревью
+type SetIsActiveResponse struct {
+	UserResponse
+	Reassigned []DeactivationReassign `json:"reassigned"`
+	Unfilled   []DeactivationReassign `json:"unfilled"`
 }
 
 // SetIsActive выполняет POST запрос к /users/setIsActive
@@ -104,6 +115,243 @@ func GetUserReviews(userID string) (*http.Response, error) {
 	return http.Get(baseURL + "/users/getReview/" + userID)
 }
 
+// GetUserReviewsByStatus выполняет GET запрос к /users/getReview/:id с фильтром по статусам PR
+func GetUserReviewsByStatus(userID, statuses string) (*http.Response, error) {
+	return http.Get(baseURL + "/users/getReview/" + userID + "?status=" + url.QueryEscape(statuses))
+}
+
+// GetUserReviewsPage выполняет GET запрос к /users/getReview/:id с параметрами фильтра и пагинации
+func GetUserReviewsPage(userID string, query url.Values) (*http.Response, error) {
+	return http.Get(baseURL + "/users/getReview/" + userID + "?" + query.Encode())
+}
+
+// SetReviewCapacityRequest представляет запрос на установку лимита ревью пользователя
+type SetReviewCapacityRequest struct {
+	UserID         string `json:"user_id"`
+	ReviewCapacity *int   `json:"review_capacity"`
+}
+
+// SetReviewCapacity выполняет POST запрос к /users/setReviewCapacity
+func SetReviewCapacity(req SetReviewCapacityRequest) (*http.Response, error) {
+	return postJSON("/users/setReviewCapacity", req)
+}
+
+// SetTeamReviewCapacityRequest представляет запрос на установку лимита ревью по умолчанию для команды
+type SetTeamReviewCapacityRequest struct {
+	TeamName              string `json:"team_name"`
+	DefaultReviewCapacity *int   `json:"default_review_capacity"`
+}
+
+// SetTeamReviewCapacity выполняет POST запрос к /teams/setReviewCapacity
+func SetTeamReviewCapacity(req SetTeamReviewCapacityRequest) (*http.Response, error) {
+	return postJSON("/teams/setReviewCapacity", req)
+}
+
+// SetTeamMergePolicyRequest представляет запрос на установку политики мержа команды
+type SetTeamMergePolicyRequest struct {
+	TeamName                string `json:"team_name"`
+	MinApprovals            *int   `json:"min_approvals"`
+	BlockOnChangesRequested *bool  `json:"block_on_changes_requested"`
+	RequireActiveReviewers  *bool  `json:"require_active_reviewers"`
+}
+
+// SetTeamMergePolicy выполняет POST запрос к /teams/setMergePolicy
+func SetTeamMergePolicy(req SetTeamMergePolicyRequest) (*http.Response, error) {
+	return postJSON("/teams/setMergePolicy", req)
+}
+
+// DeactivateTeamRequest представляет запрос на деактивацию команды
+type DeactivateTeamRequest struct {
+	TeamName string `json:"team_name"`
+}
+
+// DeactivationReport представляет отчет о деактивации команды
+type DeactivationReport struct {
+	TeamName         string                 `json:"team_name"`
+	DeactivatedUsers []string               `json:"deactivated_user_ids"`
+	Reassigned       []DeactivationReassign `json:"reassigned"`
+	Unfilled         []DeactivationReassign `json:"unfilled"`
+}
+
+// DeactivationReassign представляет замену ревьювера в отчете о деактивации
+type DeactivationReassign struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+// DeactivateTeam выполняет POST запрос к /teams/deactivate
+func DeactivateTeam(req DeactivateTeamRequest) (*http.Response, error) {
+	return postJSON("/teams/deactivate", req)
+}
+
+// AddTeamMembersRequest представляет запрос на добавление участников в команду
+type AddTeamMembersRequest struct {
+	TeamName string       `json:"team_name"`
+	Members  []TeamMember `json:"members"`
+}
+
+// AddTeamMembers выполняет POST запрос к /teams/addMembers
+func AddTeamMembers(req AddTeamMembersRequest) (*http.Response, error) {
+	return postJSON("/teams/addMembers", req)
+}
+
+// RemoveTeamMemberRequest представляет запрос на исключение участника из команды
+type RemoveTeamMemberRequest struct {
+	TeamName    string `json:"team_name"`
+	UserID      string `json:"user_id"`
+	OpenReviews string `json:"open_reviews,omitempty"`
+}
+
+// TeamRemovalReport представляет отчет об исключении участников из команды
+type TeamRemovalReport struct {
+	TeamName     string                 `json:"team_name"`
+	RemovedUsers []string               `json:"removed_user_ids"`
+	Reassigned   []DeactivationReassign `json:"reassigned"`
+	Unfilled     []DeactivationReassign `json:"unfilled"`
+}
+
+// RemoveTeamMember выполняет POST запрос к /teams/removeMember
+func RemoveTeamMember(req RemoveTeamMemberRequest) (*http.Response, error) {
+	return postJSON("/teams/removeMember", req)
+}
+
+// RenameTeamRequest представляет запрос на переименование команды
+type RenameTeamRequest struct {
+	TeamName    string `json:"team_name"`
+	NewTeamName string `json:"new_team_name"`
+}
+
+// RenameTeam выполняет POST запрос к /teams/rename
+func RenameTeam(req RenameTeamRequest) (*http.Response, error) {
+	return postJSON("/teams/rename", req)
+}
+
+// DeleteTeamRequest представляет запрос на удаление команды
+type DeleteTeamRequest struct {
+	TeamName    string `json:"team_name"`
+	OpenReviews string `json:"open_reviews,omitempty"`
+}
+
+// DeleteTeam выполняет POST запрос к /teams/delete
+func DeleteTeam(req DeleteTeamRequest) (*http.Response, error) {
+	return postJSON("/teams/delete", req)
+}
+
+// SetTeamRequiredReviewersRequest представляет запрос на установку числа ревьюверов команды
+type SetTeamRequiredReviewersRequest struct {
+	TeamName          string `json:"team_name"`
+	RequiredReviewers *int   `json:"required_reviewers"`
+}
+
+// SetTeamRequiredReviewers выполняет POST запрос к /teams/setRequiredReviewers
+func SetTeamRequiredReviewers(req SetTeamRequiredReviewersRequest) (*http.Response, error) {
+	return postJSON("/teams/setRequiredReviewers", req)
+}
+
+// SetPrimaryTeamRequest представляет запрос на смену основной команды пользователя
+type SetPrimaryTeamRequest struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+}
+
+// SetPrimaryTeam выполняет POST запрос к /users/setPrimaryTeam
+func SetPrimaryTeam(req SetPrimaryTeamRequest) (*http.Response, error) {
+	return postJSON("/users/setPrimaryTeam", req)
+}
+
+// UserResponse представляет ответ с пользователем
+type UserResponse struct {
+	User User `json:"user"`
+}
+
+// User представляет пользователя со всеми членствами и профилем
+type User struct {
+	UserID   string           `json:"user_id"`
+	Username string           `json:"username"`
+	TeamName string           `json:"team_name"`
+	Teams    []TeamMembership `json:"teams"`
+	IsActive bool             `json:"is_active"`
+	Email    string           `json:"email"`
+	Title    string           `json:"title"`
+}
+
+// ListUsersResponse представляет страницу списка пользователей
+type ListUsersResponse struct {
+	Users      []User `json:"users"`
+	NextCursor string `json:"next_cursor"`
+}
+
+// UpdateUserRequest представляет запрос на изменение профиля, nil поля не меняются
+type UpdateUserRequest struct {
+	Username *string `json:"username,omitempty"`
+	Email    *string `json:"email,omitempty"`
+	Title    *string `json:"title,omitempty"`
+}
+
+// GetUser выполняет GET запрос к /users/:id
+func GetUser(userID string) (*http.Response, error) {
+	return http.Get(baseURL + "/users/" + url.PathEscape(userID))
+}
+
+// ListUsers выполняет GET запрос к /users с параметрами фильтра и пагинации
+func ListUsers(query url.Values) (*http.Response, error) {
+	return http.Get(baseURL + "/users?" + query.Encode())
+}
+
+// UpdateUser выполняет PATCH запрос к /users/:id
+func UpdateUser(userID string, req UpdateUserRequest) (*http.Response, error) {
+	return sendJSON(http.MethodPatch, "/users/"+url.PathEscape(userID), req, "")
+}
+
+// LinkIdentityRequest представляет запрос на привязку внешнего аккаунта к пользователю
+type LinkIdentityRequest struct {
+	UserID     string `json:"user_id"`
+	Provider   string `json:"provider"`
+	ExternalID string `json:"external_id"`
+}
+
+// LinkIdentity выполняет POST запрос к /users/linkIdentity
+func LinkIdentity(req LinkIdentityRequest) (*http.Response, error) {
+	return postJSON("/users/linkIdentity", req)
+}
+
+// UnlinkIdentity выполняет POST запрос к /users/unlinkIdentity
+func UnlinkIdentity(req LinkIdentityRequest) (*http.Response, error) {
+	return postJSON("/users/unlinkIdentity", req)
+}
+
+// GetUserIdentities выполняет GET запрос к /users/:id/identities
+func GetUserIdentities(userRef string) (*http.Response, error) {
+	return http.Get(baseURL + "/users/" + url.PathEscape(userRef) + "/identities")
+}
+
+// IdentitiesResponse представляет список внешних аккаунтов пользователя
+type IdentitiesResponse struct {
+	Identities []LinkIdentityRequest `json:"identities"`
+}
+
+// TeamMembership представляет членство пользователя в команде
+type TeamMembership struct {
+	TeamName  string `json:"team_name"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+// UserReviewsResponse представляет ответ со списком ревью пользователя
+type UserReviewsResponse struct {
+	UserID         string             `json:"user_id"`
+	ReviewCapacity *int               `json:"review_capacity"`
+	OpenReviews    int                `json:"open_reviews"`
+	PullRequests   []PullRequestShort `json:"pull_requests"`
+	NextCursor     string             `json:"next_cursor"`
+}
+
+// PullRequestShort представляет PR в списке ревью пользователя
+type PullRequestShort struct {
+	PullRequestID string `json:"pull_request_id"`
+	Status        string `json:"status"`
+}
+
 // ============================================================================
 // Pull Requests Requests
 // ============================================================================
@@ -113,6 +361,8 @@ type CreatePullRequestRequest struct {
 	PullRequestID   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
 	AuthorID        string `json:"author_id"`
+	TeamName        string `json:"team_name,omitempty"`
+	Draft           bool   `json:"draft,omitempty"`
 }
 
 // CreatePullRequestResponse представляет ответ на создание PR
@@ -122,11 +372,22 @@ type CreatePullRequestResponse struct {
 
 // PullRequest представляет PR
 type PullRequest struct {
-	PullRequestID     string   `json:"pull_request_id"`
-	PullRequestName   string   `json:"pull_request_name"`
-	AuthorID          string   `json:"author_id"`
-	Status            string   `json:"status"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
+	PullRequestID     string         `json:"pull_request_id"`
+	ExternalID        string         `json:"external_id"`
+	PullRequestName   string         `json:"pull_request_name"`
+	AuthorID          string         `json:"author_id"`
+	TeamName          string         `json:"team_name"`
+	Status            string         `json:"status"`
+	AssignedReviewers []string       `json:"assigned_reviewers"`
+	Reviews           []ReviewResult `json:"reviews"`
+	Version           int            `json:"version"`
+}
+
+// ReviewResult представляет вердикт ревьювера в ответе PR
+type ReviewResult struct {
+	UserID     string  `json:"user_id"`
+	State      string  `json:"state"`
+	ReviewedAt *string `json:"reviewed_at"`
 }
 
 // CreatePullRequest выполняет POST запрос к /pullRequest/create
@@ -171,10 +432,22 @@ func MergePullRequest(req MergePullRequestRequest) (*http.Response, error) {
 	return http.DefaultClient.Do(httpReq)
 }
 
+// MergePullRequestIfMatch выполняет POST запрос к /pullRequest/merge с заголовком If-Match
+func MergePullRequestIfMatch(req MergePullRequestRequest, etag string) (*http.Response, error) {
+	return postJSONIfMatch("/pullRequest/merge", req, etag)
+}
+
+// ClosePullRequestIfMatch выполняет POST запрос к /pullRequest/close с заголовком If-Match
+func ClosePullRequestIfMatch(req PullRequestStatusRequest, etag string) (*http.Response, error) {
+	return postJSONIfMatch("/pullRequest/close", req, etag)
+}
+
 // ReassignUserForPullRequestRequest представляет запрос на переназначение ревьювера
 type ReassignUserForPullRequestRequest struct {
 	PullRequestID string `json:"pull_request_id"`
 	OldUserID     string `json:"old_reviewer_id"`
+	Actor         string `json:"actor,omitempty"`
+	Reason        string `json:"reason,omitempty"`
 }
 
 // ReassignUserForPullRequestResponse представляет ответ на переназначение ревьювера
@@ -198,10 +471,402 @@ func ReassignUserForPullRequest(req ReassignUserForPullRequestRequest) (*http.Re
 	return http.DefaultClient.Do(httpReq)
 }
 
+// ReviewPullRequestRequest представляет запрос на отправку вердикта ревью
+type ReviewPullRequestRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	UserID        string `json:"user_id"`
+	State         string `json:"state"`
+}
+
+// ReviewPullRequest выполняет POST запрос к /pullRequest/review
+func ReviewPullRequest(req ReviewPullRequestRequest) (*http.Response, error) {
+	return postJSON("/pullRequest/review", req)
+}
+
+// PullRequestHistoryResponse представляет журнал назначений ревьюверов PR
+type PullRequestHistoryResponse struct {
+	PullRequestID string            `json:"pull_request_id"`
+	Events        []AssignmentEvent `json:"events"`
+}
+
+// AssignmentEvent представляет событие назначения, снятия или замены ревьювера
+type AssignmentEvent struct {
+	Type          string `json:"type"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+	Actor         string `json:"actor"`
+	Reason        string `json:"reason"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+// GetPullRequestHistory выполняет GET запрос к /pullRequest/:id/history
+func GetPullRequestHistory(prID string) (*http.Response, error) {
+	return http.Get(baseURL + "/pullRequest/" + url.PathEscape(prID) + "/history")
+}
+
+// GetPullRequest выполняет GET запрос к /pullRequest/:id
+func GetPullRequest(prID string) (*http.Response, error) {
+	return http.Get(baseURL + "/pullRequest/" + url.PathEscape(prID))
+}
+
+// ListPullRequestsResponse представляет страницу списка PR
+type ListPullRequestsResponse struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+	NextCursor   string        `json:"next_cursor"`
+}
+
+// ListPullRequests выполняет GET запрос к /pullRequests с параметрами фильтра и пагинации
+func ListPullRequests(query url.Values) (*http.Response, error) {
+	return http.Get(baseURL + "/pullRequests?" + query.Encode())
+}
+
+// PullRequestStatusRequest представляет запрос на смену статуса PR
+type PullRequestStatusRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+	Actor         string `json:"actor,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// ReadyPullRequest выполняет POST запрос к /pullRequest/ready
+func ReadyPullRequest(req PullRequestStatusRequest) (*http.Response, error) {
+	return postJSON("/pullRequest/ready", req)
+}
+
+// ClosePullRequest выполняет POST запрос к /pullRequest/close
+func ClosePullRequest(req PullRequestStatusRequest) (*http.Response, error) {
+	return postJSON("/pullRequest/close", req)
+}
+
+// ReopenPullRequest выполняет POST запрос к /pullRequest/reopen
+func ReopenPullRequest(req PullRequestStatusRequest) (*http.Response, error) {
+	return postJSON("/pullRequest/reopen", req)
+}
+
+// ============================================================================
+// Admin Requests
+// ============================================================================
+
+// ReconcileRequest представляет желаемое состояние команд, план применяется только при apply
+type ReconcileRequest struct {
+	Teams []ReconcileTeam `json:"teams"`
+	Apply bool            `json:"apply"`
+}
+
+// ReconcileTeam представляет команду в документе reconcile
+type ReconcileTeam struct {
+	TeamName string            `json:"team_name"`
+	Members  []ReconcileMember `json:"members"`
+}
+
+// ReconcileMember представляет участника в документе reconcile, is_active по умолчанию true
+type ReconcileMember struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive *bool  `json:"is_active,omitempty"`
+}
+
+// ReconcilePlanResponse представляет план изменений команд
+type ReconcilePlanResponse struct {
+	Applied              bool                   `json:"applied"`
+	CreateTeams          []string               `json:"create_teams"`
+	CreateUsers          []string               `json:"create_user_ids"`
+	AddMembers           []MembershipChange     `json:"add_members"`
+	RemoveMembers        []MembershipChange     `json:"remove_members"`
+	ActivateUsers        []string               `json:"activate_user_ids"`
+	DeactivateUsers      []string               `json:"deactivate_user_ids"`
+	AffectedPullRequests []AffectedReview       `json:"affected_pull_requests"`
+	Reassigned           []DeactivationReassign `json:"reassigned"`
+	Unfilled             []DeactivationReassign `json:"unfilled"`
+}
+
+// MembershipChange представляет добавление или исключение участника команды
+type MembershipChange struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+}
+
+// AffectedReview представляет открытое ревью исключаемого или деактивируемого участника
+type AffectedReview struct {
+	PullRequestID string `json:"pull_request_id"`
+	TeamName      string `json:"team_name"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+// Reconcile выполняет POST запрос к /admin/reconcile
+func Reconcile(req ReconcileRequest) (*http.Response, error) {
+	return postJSON("/admin/reconcile", req)
+}
+
+// ============================================================================
+// Webhook Requests
+// ============================================================================
+
+// githubWebhookSecret передается сервису в BUSSINES_LOGIC_GITHUB_WEBHOOK_SECRET
+const githubWebhookSecret = "e2e-github-secret"
+
+// GithubPullRequestPayload представляет минимальное тело события pull_request GitHub
+type GithubPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Draft  bool   `json:"draft"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Sender struct {
+		Login string `json:"login"`
+	} `json:"sender"`
+}
+
+// WebhookResponse представляет результат обработки доставки вебхука
+type WebhookResponse struct {
+	Status            string `json:"status"`
+	PullRequestID     string `json:"pull_request_id"`
+	PullRequestStatus string `json:"pull_request_status"`
+}
+
+// GithubWebhook выполняет POST запрос к /webhooks/github с подписью X-Hub-Signature-256 от secret
+func GithubWebhook(event string, payload any, secret string) (*http.Response, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, baseURL+"/webhooks/github", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("X-GitHub-Event", event)
+	httpReq.Header.Set("X-Hub-Signature-256", signature.SignSHA256([]byte(secret), body))
+
+	return http.DefaultClient.Do(httpReq)
+}
+
+// gitlabWebhookToken передается сервису в BUSSINES_LOGIC_GITLAB_WEBHOOK_TOKEN
+const gitlabWebhookToken = "e2e-gitlab-token"
+
+// GitlabMergeRequestPayload представляет минимальное тело Merge Request Hook GitLab
+type GitlabMergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		ID                int    `json:"id"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		Iid    int    `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+		Draft  bool   `json:"draft"`
+	} `json:"object_attributes"`
+	Changes map[string]any `json:"changes,omitempty"`
+}
+
+// GitlabWebhook выполняет POST запрос к /webhooks/gitlab с заголовком X-Gitlab-Token
+func GitlabWebhook(event string, payload any, token string) (*http.Response, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, baseURL+"/webhooks/gitlab", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("X-Gitlab-Event", event)
+	httpReq.Header.Set("X-Gitlab-Token", token)
+
+	return http.DefaultClient.Do(httpReq)
+}
+
+// ============================================================================
+// Webhook Subscription Requests
+// ============================================================================
+
+// CreateSubscriptionRequest представляет запрос на подписку на события
+type CreateSubscriptionRequest struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret,omitempty"`
+	Events []string `json:"events"`
+}
+
+// SubscriptionResponse представляет подписку, секрет возвращается только при создании
+type SubscriptionResponse struct {
+	ID     string   `json:"id"`
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Events []string `json:"events"`
+}
+
+// CreateSubscriptionResponse представляет ответ на создание подписки
+type CreateSubscriptionResponse struct {
+	Subscription SubscriptionResponse `json:"subscription"`
+}
+
+// SubscriptionsResponse представляет список подписок
+type SubscriptionsResponse struct {
+	Subscriptions []SubscriptionResponse `json:"subscriptions"`
+}
+
+// DeliveryResponse представляет запись журнала доставок
+type DeliveryResponse struct {
+	ID           string          `json:"id"`
+	EventID      string          `json:"event_id"`
+	Event        string          `json:"event"`
+	Status       string          `json:"status"`
+	Attempts     int             `json:"attempts"`
+	ResponseCode int             `json:"response_code"`
+	Payload      json.RawMessage `json:"payload"`
+}
+
+// DeliveriesResponse представляет журнал доставок подписки
+type DeliveriesResponse struct {
+	Deliveries []DeliveryResponse `json:"deliveries"`
+}
+
+// RedeliverResponse представляет результат повторной доставки
+type RedeliverResponse struct {
+	Delivery DeliveryResponse `json:"delivery"`
+}
+
+// SubscriptionEvent представляет тело доставленного события
+type SubscriptionEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		PullRequestID     string   `json:"pull_request_id"`
+		AssignedReviewers []string `json:"assigned_reviewers"`
+		OldReviewerID     string   `json:"old_reviewer_id"`
+		NewReviewerID     string   `json:"new_reviewer_id"`
+		UserID            string   `json:"user_id"`
+	} `json:"data"`
+}
+
+// CreateSubscription выполняет POST запрос к /webhooks/subscriptions
+func CreateSubscription(req CreateSubscriptionRequest) (*http.Response, error) {
+	return postJSON("/webhooks/subscriptions", req)
+}
+
+// ListSubscriptions выполняет GET запрос к /webhooks/subscriptions
+func ListSubscriptions() (*http.Response, error) {
+	return http.Get(baseURL + "/webhooks/subscriptions")
+}
+
+// DeleteSubscription выполняет DELETE запрос к /webhooks/subscriptions/:id
+func DeleteSubscription(subscriptionID string) (*http.Response, error) {
+	httpReq, err := http.NewRequest(http.MethodDelete, baseURL+"/webhooks/subscriptions/"+subscriptionID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	return http.DefaultClient.Do(httpReq)
+}
+
+// GetSubscriptionDeliveries выполняет GET запрос к /webhooks/subscriptions/:id/deliveries
+func GetSubscriptionDeliveries(subscriptionID string) (*http.Response, error) {
+	return http.Get(baseURL + "/webhooks/subscriptions/" + subscriptionID + "/deliveries")
+}
+
+// RedeliverWebhook выполняет POST запрос к /webhooks/deliveries/:id/redeliver
+func RedeliverWebhook(deliveryID string) (*http.Response, error) {
+	return postJSON("/webhooks/deliveries/"+deliveryID+"/redeliver", nil)
+}
+
 // ============================================================================
 // Helper Functions
 // ============================================================================
 
+// postJSON выполняет POST запрос с JSON телом
+func postJSON(path string, req any) (*http.Response, error) {
+	return postJSONIfMatch(path, req, "")
+}
+
+// postJSONIfMatch выполняет POST запрос с JSON телом и заголовком If-Match, пустой etag не отправляется
+func postJSONIfMatch(path string, req any, etag string) (*http.Response, error) {
+	return sendJSON(http.MethodPost, path, req, etag)
+}
+
+// sendJSON выполняет запрос с JSON телом и заголовком If-Match, пустой etag не отправляется
+func sendJSON(method, path string, req any, etag string) (*http.Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(method, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if etag != "" {
+		httpReq.Header.Set("If-Match", etag)
+	}
+
+	return http.DefaultClient.Do(httpReq)
+}
+
+// AssignmentStatsResponse представляет статистику назначений по пользователям и PR
+type AssignmentStatsResponse struct {
+	Users                  []UserStats        `json:"users"`
+	PullRequests           []PullRequestStats `json:"pull_requests"`
+	NextUsersCursor        string             `json:"next_users_cursor"`
+	NextPullRequestsCursor string             `json:"next_pull_requests_cursor"`
+}
+
+// UserStats представляет статистику ревью пользователя
+type UserStats struct {
+	UserID         string `json:"user_id"`
+	TotalReviews   int    `json:"total_reviews"`
+	OpenReviews    int    `json:"open_reviews"`
+	MergedReviews  int    `json:"merged_reviews"`
+	ReassignedAway int    `json:"reassigned_away"`
+}
+
+// PullRequestStats представляет статистику назначений PR
+type PullRequestStats struct {
+	PullRequestID string `json:"pull_request_id"`
+	TeamName      string `json:"team_name"`
+	Reviewers     int    `json:"reviewers"`
+	Reassignments int    `json:"reassignments"`
+}
+
+// GetAssignmentStats выполняет GET запрос к /stats/assignments
+func GetAssignmentStats(query url.Values) (*http.Response, error) {
+	return http.Get(baseURL + "/stats/assignments?" + query.Encode())
+}
+
+// FairnessReportResponse представляет отчёт о равномерности нагрузки ревью в команде
+type FairnessReportResponse struct {
+	TeamName string       `json:"team_name"`
+	MinLoad  int          `json:"min_load"`
+	MaxLoad  int          `json:"max_load"`
+	MeanLoad float64      `json:"mean_load"`
+	StdDev   float64      `json:"stddev"`
+	Gini     float64      `json:"gini"`
+	Members  []MemberLoad `json:"members"`
+}
+
+// MemberLoad представляет нагрузку участника команды
+type MemberLoad struct {
+	UserID string `json:"user_id"`
+	Load   int    `json:"load"`
+	Flag   string `json:"flag"`
+}
+
+// GetTeamFairness выполняет GET запрос к /teams/:team_name/fairness
+func GetTeamFairness(teamName string, query url.Values) (*http.Response, error) {
+	return http.Get(baseURL + "/teams/" + url.PathEscape(teamName) + "/fairness?" + query.Encode())
+}
+
 // ParseJSONResponse парсит JSON ответ в указанную структуру
 // ВАЖНО: не закрывает resp.Body, вызывающий код должен закрыть его сам
 func ParseJSONResponse(resp *http.Response, v interface{}) error {
@@ -215,8 +880,9 @@ func ParseJSONResponse(resp *http.Response, v interface{}) error {
 // ParseErrorResponse парсит ошибку из ответа
 type ErrorResponse struct {
 	Error struct {
-		Code    string `json:"code"`
-		Message string `json:"message"`
+		Code    string   `json:"code"`
+		Message string   `json:"message"`
+		Details []string `json:"details"`
 	} `json:"error"`
 }
 
@@ -94,13 +94,18 @@ func startServer(dsn string) error {
 	env = append(env, "BUSSINES_LOGIC_ALLOWED_REUSE_TO_REASIGN=true")
 	env = append(env, "BUSSINES_LOGIC_ALLOWE_STATUSES_TO_REASIGN=active")
 	env = append(env, "BUSSINES_LOGIC_ALLOWED_ROLES_TO_REASIGN=default")
+	env = append(env, "BUSSINES_LOGIC_GITHUB_WEBHOOK_SECRET="+githubWebhookSecret)
+	env = append(env, "BUSSINES_LOGIC_GITLAB_WEBHOOK_TOKEN="+gitlabWebhookToken)
+	env = append(env, "BUSSINES_LOGIC_SUBSCRIPTION_BACKOFF=100ms")
+	env = append(env, "BUSSINES_LOGIC_SUBSCRIPTION_POLL_INTERVAL=100ms")
+	env = append(env, "BUSSINES_LOGIC_SUBSCRIPTION_ALLOW_PRIVATE_NETWORKS=true")
 	env = append(env, "SERVERS_REST_READ_TIMEOUT=5s")
 	env = append(env, "SERVERS_REST_WRITE_TIMEOUT=5s")
 	env = append(env, "SERVERS_REST_READ_HEADER_TIMEOUT=5s")
 	env = append(env, "SERVERS_REST_IDLE_TIMEOUT=5s")
 	env = append(env, "SERVERS_REST_HEALTH_CHECK_ROUTE=health")
 
-	serverProcess = exec.Command("go", "run", "./cmd/pr-reviewer-service/main.go")
+	serverProcess = exec.Command("go", "run", "./cmd/pr-reviewer-service")
 	serverProcess.Dir = projectRoot
 	serverProcess.Env = env
 	serverProcess.Stdout = os.Stdout